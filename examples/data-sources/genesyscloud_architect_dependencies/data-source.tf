@@ -0,0 +1,15 @@
+data "genesyscloud_architect_dependencies" "support_queue" {
+  object_id   = genesyscloud_routing_queue.example_queue.id
+  object_type = "QUEUE"
+}
+
+output "support_queue_consumers" {
+  value = [for consumer in data.genesyscloud_architect_dependencies.support_queue.consumers : consumer.name]
+}
+
+# Lists the flows consuming the data table that are not managed in this configuration
+data "genesyscloud_architect_dependencies" "holidays_table" {
+  object_id        = genesyscloud_architect_datatable.holidays.id
+  object_type      = "DATATABLE"
+  managed_flow_ids = [genesyscloud_flow.inbound_call_flow.id]
+}
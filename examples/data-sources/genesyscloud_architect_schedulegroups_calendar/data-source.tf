@@ -0,0 +1,13 @@
+data "genesyscloud_architect_schedulegroups_calendar" "christmas" {
+  schedule_group_id = genesyscloud_architect_schedulegroups.sample_schedule_groups.id
+  start             = "2024-12-25T00:00:00"
+  end               = "2024-12-26T00:00:00"
+  time_zone         = "America/New_York"
+}
+
+check "closed_on_christmas" {
+  assert {
+    condition     = alltrue([for interval in data.genesyscloud_architect_schedulegroups_calendar.christmas.intervals : interval.state != "open"])
+    error_message = "The schedule group must not be open on Christmas Day."
+  }
+}
@@ -0,0 +1,16 @@
+data "genesyscloud_outbound_callabletimeset_preview" "next_week" {
+  callable_time_set_id = genesyscloud_outbound_callabletimeset.example_callable_time_set.id
+  start_date           = "2024-03-11"
+  end_date             = "2024-03-17"
+  contact_time_zones   = ["America/New_York", "America/Chicago", "America/Los_Angeles"]
+}
+
+check "calling_hours" {
+  assert {
+    condition = alltrue([
+      for time_zone in data.genesyscloud_outbound_callabletimeset_preview.next_week.time_zones :
+      time_zone.earliest_start_time == "" || (time_zone.earliest_start_time >= "08:00:00" && time_zone.latest_end_time <= "21:00:00")
+    ])
+    error_message = "Contacts must only be dialed between 8am and 9pm in their local time."
+  }
+}
@@ -0,0 +1,20 @@
+data "genesyscloud_outbound_digitalruleset_evaluation" "opted_out_contact" {
+  rules_json = jsonencode(genesyscloud_outbound_digitalruleset.example_outbound_digitalruleset.rules)
+  category   = "PreContact"
+  media_type = "Email"
+  contact = {
+    opt_in = "false"
+    email  = "user@example.com"
+  }
+  contact_address_column = "email"
+}
+
+check "opted_out_contacts_are_skipped" {
+  assert {
+    condition = anytrue([
+      for action in data.genesyscloud_outbound_digitalruleset_evaluation.opted_out_contact.actions :
+      action.type == "do_not_send"
+    ])
+    error_message = "Contacts that have not opted in must never be messaged."
+  }
+}
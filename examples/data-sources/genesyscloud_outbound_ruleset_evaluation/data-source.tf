@@ -0,0 +1,17 @@
+data "genesyscloud_outbound_ruleset_evaluation" "vip_contact" {
+  rules_json      = jsonencode(genesyscloud_outbound_ruleset.example_outbound_ruleset.rules)
+  category        = "DIALER_PRECALL"
+  evaluation_time = "2024-06-10T12:00:00Z"
+  contact = {
+    balance     = "2500"
+    state       = "NY"
+    last_called = "2024-06-01T09:00:00Z"
+  }
+}
+
+check "vip_rule" {
+  assert {
+    condition     = contains(data.genesyscloud_outbound_ruleset_evaluation.vip_contact.fired_rule_names, "Mark VIP")
+    error_message = "Contacts with a balance over 1000 must be marked as VIP before they are dialed."
+  }
+}
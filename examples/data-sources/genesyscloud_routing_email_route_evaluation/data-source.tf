@@ -0,0 +1,12 @@
+data "genesyscloud_routing_email_route_evaluation" "support_email" {
+  to      = "support@example.com"
+  from    = "customer@example.org"
+  subject = "Order 12345 has not arrived"
+}
+
+check "support_email_route" {
+  assert {
+    condition     = data.genesyscloud_routing_email_route_evaluation.support_email.flow_id == genesyscloud_flow.inbound_email_flow.id
+    error_message = "Emails to support@example.com must be processed by the inbound email flow."
+  }
+}
@@ -0,0 +1,13 @@
+data "genesyscloud_routing_queue_simulation" "billing_after_one_minute" {
+  queue_id        = genesyscloud_routing_queue.example_queue.id
+  skill_ids       = [genesyscloud_routing_skill.billing.id]
+  language_ids    = [genesyscloud_routing_language.spanish.id]
+  elapsed_seconds = 60
+}
+
+check "billing_coverage" {
+  assert {
+    condition     = length(data.genesyscloud_routing_queue_simulation.billing_after_one_minute.eligible_user_ids) >= 2
+    error_message = "At least two agents must be eligible for Spanish billing interactions after one minute in the queue."
+  }
+}
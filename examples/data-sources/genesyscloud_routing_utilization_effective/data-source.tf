@@ -0,0 +1,13 @@
+data "genesyscloud_routing_utilization_effective" "agent" {
+  user_id = genesyscloud_user.example_user.id
+}
+
+check "agent_chat_capacity" {
+  assert {
+    condition = alltrue([
+      for media in data.genesyscloud_routing_utilization_effective.agent.media_utilizations :
+      media.maximum_capacity <= 3 if media.media_type == "chat"
+    ])
+    error_message = "Agents must not handle more than three chats at a time."
+  }
+}
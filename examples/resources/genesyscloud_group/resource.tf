@@ -13,3 +13,14 @@ resource "genesyscloud_group" "sample_group" {
   roles_enabled = true
   calls_enabled = false
 }
+
+resource "genesyscloud_group" "sales_managers" {
+  name = "Sales Managers"
+  membership_rules {
+    departments = ["Sales"]
+    titles      = ["Manager", "Team Lead"]
+  }
+  membership_rules {
+    group_ids = [genesyscloud_group.sample_group.id]
+  }
+}
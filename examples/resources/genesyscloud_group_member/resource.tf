@@ -0,0 +1,4 @@
+resource "genesyscloud_group_member" "support_agents" {
+  group_id   = genesyscloud_group.sample_group.id
+  member_ids = [genesyscloud_user.test-user.id]
+}
@@ -8,4 +8,7 @@ resource "genesyscloud_outbound_wrapupcodemappings" "example_mappings" {
     wrapup_code_id = genesyscloud_routing_wrapupcode.wrapup_code_2.id
     flags          = ["Number_UnCallable", "Right_Party_Contact"]
   }
+  auto_include {
+    flags = ["Business_Neutral"]
+  }
 }  
\ No newline at end of file
@@ -0,0 +1,38 @@
+resource "genesyscloud_routing_queue_blueprint" "support" {
+  queue_flow_id = data.genesyscloud_flow.queue-flow.id
+  media_settings_call {
+    alerting_timeout_sec      = 30
+    service_level_percentage  = 0.8
+    service_level_duration_ms = 20000
+  }
+  routing_rules {
+    operator     = "MEETS_THRESHOLD"
+    threshold    = 9
+    wait_seconds = 300
+  }
+  default_script_ids = {
+    CALL = data.genesyscloud_script.call_script.id
+  }
+  wrapup_codes = [genesyscloud_routing_wrapupcode.example-code.id]
+}
+
+resource "genesyscloud_routing_queue" "support_emea" {
+  name        = "Support EMEA"
+  division_id = genesyscloud_auth_division.emea.id
+  blueprint   = genesyscloud_routing_queue_blueprint.support.settings
+  members {
+    user_id = genesyscloud_user.emea_agent.id
+  }
+}
+
+resource "genesyscloud_routing_queue" "support_apac" {
+  name        = "Support APAC"
+  division_id = genesyscloud_auth_division.apac.id
+  blueprint   = genesyscloud_routing_queue_blueprint.support.settings
+  # Overrides the call media settings of the blueprint
+  media_settings_call {
+    alerting_timeout_sec      = 20
+    service_level_percentage  = 0.9
+    service_level_duration_ms = 15000
+  }
+}
@@ -0,0 +1,5 @@
+resource "genesyscloud_routing_queue_member" "example_member" {
+  queue_id = genesyscloud_routing_queue.example_queue.id
+  user_id  = genesyscloud_user.example_user.id
+  ring_num = 2
+}
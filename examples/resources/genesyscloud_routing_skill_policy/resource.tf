@@ -0,0 +1,21 @@
+resource "genesyscloud_routing_skill_policy" "support_skills" {
+  name       = "Support skills"
+  group_ids  = [genesyscloud_group.support.id]
+  team_ids   = [genesyscloud_team.escalations.id]
+  precedence = "highest"
+
+  user_filter {
+    departments = ["Support"]
+    titles      = ["Agent", "Senior Agent"]
+  }
+
+  skills {
+    skill_id    = genesyscloud_routing_skill.billing.id
+    proficiency = 3
+  }
+
+  skills {
+    skill_id    = genesyscloud_routing_skill.returns.id
+    proficiency = 2.5
+  }
+}
@@ -0,0 +1,6 @@
+resource "genesyscloud_routing_wrapupcode_assignment" "support_codes" {
+  wrapupcode_ids     = [genesyscloud_routing_wrapupcode.resolved.id, genesyscloud_routing_wrapupcode.escalated.id]
+  queue_ids          = [genesyscloud_routing_queue.vip_queue.id]
+  queue_division_ids = [genesyscloud_auth_division.support.id]
+  queue_name_regex   = "^Support "
+}
@@ -74,4 +74,10 @@ resource "genesyscloud_user" "example_user" {
       interrupting_label_ids = [genesyscloud_routing_utilization_label.red_label.id]
     }
   }
+  offboarding {
+    remove_roles  = true
+    remove_skills = true
+    remove_phones = false
+    retention_id  = genesyscloud_user_offboarding_retention.offboarded_users.id
+  }
 }
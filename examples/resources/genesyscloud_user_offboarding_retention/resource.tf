@@ -0,0 +1,4 @@
+resource "genesyscloud_user_offboarding_retention" "offboarded_users" {
+  name           = "Offboarded users"
+  retention_days = 90
+}
@@ -0,0 +1,6 @@
+resource "genesyscloud_users_bulk" "agents" {
+  source_filepath          = "${path.module}/agents.csv"
+  deactivate_removed_users = true
+  chunk_size               = 25
+  report_filepath          = "${path.module}/agents-report.csv"
+}
@@ -0,0 +1,81 @@
+package architect_dependencies
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/dependent_consumers"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/lists"
+	"terraform-provider-genesyscloud/genesyscloud/util/resourcedata"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v154/platformclientv2"
+)
+
+/*
+   The data_source_genesyscloud_architect_dependencies.go contains the data source implementation
+   for the architect dependency tracking graph.
+*/
+
+// dataSourceArchitectDependenciesRead reads the consumers and consumed resources of an Architect object
+func dataSourceArchitectDependenciesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getArchitectDependenciesProxy(sdkConfig)
+
+	objectId := d.Get("object_id").(string)
+	objectType := d.Get("object_type").(string)
+
+	consumers, resp, err := proxy.getConsumingResources(ctx, objectId, objectType)
+	if err != nil {
+		return util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("failed to read consumers of %s %s | error: %s", objectType, objectId, err), resp)
+	}
+
+	var consumed *[]platformclientv2.Dependency
+	if isFlowObjectType(objectType) {
+		version := d.Get("version").(string)
+		if version == "" {
+			version, resp, err = proxy.getFlowPublishedVersion(ctx, objectId)
+			if err != nil {
+				return util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("failed to read published version of flow %s | error: %s", objectId, err), resp)
+			}
+		}
+		if version != "" {
+			consumed, resp, err = proxy.getConsumedResources(ctx, objectId, objectType, version)
+			if err != nil {
+				return util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("failed to read resources consumed by %s %s | error: %s", objectType, objectId, err), resp)
+			}
+		}
+	}
+
+	managedFlowIds := d.Get("managed_flow_ids")
+	unmanaged := getUnmanagedFlowConsumers(consumers, *lists.SetToStringList(managedFlowIds.(*schema.Set)))
+
+	d.SetId(fmt.Sprintf("%s/%s", objectType, objectId))
+	_ = d.Set("consumers", flattenDependencies(consumers))
+	_ = d.Set("consumed_resources", flattenDependencies(consumed))
+	_ = d.Set("unmanaged_flow_consumers", flattenDependencies(&unmanaged))
+	return nil
+}
+
+func flattenDependencies(dependencies *[]platformclientv2.Dependency) []interface{} {
+	if dependencies == nil {
+		return []interface{}{}
+	}
+
+	resourceTypes := dependent_consumers.SetDependentObjectMaps()
+	flattened := make([]interface{}, 0, len(*dependencies))
+	for _, dependency := range *dependencies {
+		dependencyMap := make(map[string]interface{})
+		resourcedata.SetMapValueIfNotNil(dependencyMap, "id", dependency.Id)
+		resourcedata.SetMapValueIfNotNil(dependencyMap, "name", dependency.Name)
+		resourcedata.SetMapValueIfNotNil(dependencyMap, "type", dependency.VarType)
+		resourcedata.SetMapValueIfNotNil(dependencyMap, "version", dependency.Version)
+		if dependency.VarType != nil {
+			dependencyMap["resource_type"] = resourceTypes[*dependency.VarType]
+		}
+		flattened = append(flattened, dependencyMap)
+	}
+	return flattened
+}
@@ -0,0 +1,113 @@
+package architect_dependencies
+
+import (
+	"context"
+	"net/http"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v154/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func buildTestDependency(id, name, varType string) platformclientv2.Dependency {
+	return platformclientv2.Dependency{
+		Id:      platformclientv2.String(id),
+		Name:    platformclientv2.String(name),
+		VarType: platformclientv2.String(varType),
+	}
+}
+
+func TestUnitDataSourceArchitectDependenciesRead(t *testing.T) {
+	flowId := uuid.NewString()
+	versionId := "3.0"
+	queueId := uuid.NewString()
+
+	testProxy := &architectDependenciesProxy{}
+	testProxy.getConsumingResourcesAttr = func(ctx context.Context, p *architectDependenciesProxy, id, objectType string) (*[]platformclientv2.Dependency, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, flowId, id)
+		assert.Equal(t, "INBOUNDCALLFLOW", objectType)
+		consumers := []platformclientv2.Dependency{buildTestDependency(uuid.NewString(), "Main IVR", "IVRCONFIGURATION")}
+		return &consumers, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	testProxy.getFlowPublishedVersionAttr = func(ctx context.Context, p *architectDependenciesProxy, id string) (string, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, flowId, id)
+		return versionId, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	testProxy.getConsumedResourcesAttr = func(ctx context.Context, p *architectDependenciesProxy, id, objectType, version string) (*[]platformclientv2.Dependency, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, versionId, version)
+		consumed := []platformclientv2.Dependency{buildTestDependency(queueId, "Support", "QUEUE")}
+		return &consumed, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	internalProxy = testProxy
+	defer func() { internalProxy = nil }()
+
+	ctx := context.Background()
+	gcloud := &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}
+
+	d := schema.TestResourceDataRaw(t, DataSourceArchitectDependencies().Schema, map[string]interface{}{
+		"object_id":   flowId,
+		"object_type": "INBOUNDCALLFLOW",
+	})
+
+	diags := dataSourceArchitectDependenciesRead(ctx, d, gcloud)
+	assert.Equal(t, false, diags.HasError())
+	assert.Equal(t, "INBOUNDCALLFLOW/"+flowId, d.Id())
+	assert.Equal(t, 1, d.Get("consumers.#"))
+	assert.Equal(t, "genesyscloud_architect_ivr", d.Get("consumers.0.resource_type"))
+	assert.Equal(t, 1, d.Get("consumed_resources.#"))
+	assert.Equal(t, queueId, d.Get("consumed_resources.0.id"))
+	assert.Equal(t, "genesyscloud_routing_queue", d.Get("consumed_resources.0.resource_type"))
+}
+
+func TestUnitDataSourceArchitectDependenciesUnmanagedFlowConsumers(t *testing.T) {
+	queueId := uuid.NewString()
+	managedFlowId := uuid.NewString()
+	unmanagedFlowId := uuid.NewString()
+
+	testProxy := &architectDependenciesProxy{}
+	testProxy.getConsumingResourcesAttr = func(ctx context.Context, p *architectDependenciesProxy, id, objectType string) (*[]platformclientv2.Dependency, *platformclientv2.APIResponse, error) {
+		consumers := []platformclientv2.Dependency{
+			buildTestDependency(managedFlowId, "Managed flow", "INBOUNDCALLFLOW"),
+			buildTestDependency(unmanagedFlowId, "Unmanaged flow", "INQUEUECALLFLOW"),
+			buildTestDependency(uuid.NewString(), "Main IVR", "IVRCONFIGURATION"),
+		}
+		return &consumers, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	internalProxy = testProxy
+	defer func() { internalProxy = nil }()
+
+	ctx := context.Background()
+	gcloud := &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}
+
+	d := schema.TestResourceDataRaw(t, DataSourceArchitectDependencies().Schema, map[string]interface{}{
+		"object_id":        queueId,
+		"object_type":      "QUEUE",
+		"managed_flow_ids": []interface{}{managedFlowId},
+	})
+	diags := dataSourceArchitectDependenciesRead(ctx, d, gcloud)
+	assert.Empty(t, diags)
+	assert.Equal(t, 1, d.Get("unmanaged_flow_consumers.#"))
+	assert.Equal(t, unmanagedFlowId, d.Get("unmanaged_flow_consumers.0.id"))
+
+	// Once every consuming flow is managed none is reported
+	d = schema.TestResourceDataRaw(t, DataSourceArchitectDependencies().Schema, map[string]interface{}{
+		"object_id":        queueId,
+		"object_type":      "QUEUE",
+		"managed_flow_ids": []interface{}{managedFlowId, unmanagedFlowId},
+	})
+	diags = dataSourceArchitectDependenciesRead(ctx, d, gcloud)
+	assert.Empty(t, diags)
+	assert.Equal(t, 0, d.Get("unmanaged_flow_consumers.#"))
+
+	// Without managed flows every consuming flow is reported
+	d = schema.TestResourceDataRaw(t, DataSourceArchitectDependencies().Schema, map[string]interface{}{
+		"object_id":   queueId,
+		"object_type": "QUEUE",
+	})
+	diags = dataSourceArchitectDependenciesRead(ctx, d, gcloud)
+	assert.Empty(t, diags)
+	assert.Equal(t, 2, d.Get("unmanaged_flow_consumers.#"))
+}
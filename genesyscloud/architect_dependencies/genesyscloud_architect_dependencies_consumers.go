@@ -0,0 +1,155 @@
+package architect_dependencies
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/dependent_consumers"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/go-cty/cty/msgpack"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v154/platformclientv2"
+)
+
+/*
+The genesyscloud_architect_dependencies_consumers.go file finds the flows consuming an object. The data source lists those
+that are not managed in the configuration, and the provider server warns about them when a plan destroys or replaces the
+object. The SDK neither calls CustomizeDiff for destroy plans nor lets it return warnings, so the warning is added by a
+wrapper around the provider server, which sees the plan of every resource.
+*/
+
+// consumedObjectTypes maps the resources of the objects flows consume to their dependency tracking type
+var consumedObjectTypes = map[string]string{
+	"genesyscloud_routing_queue":         "QUEUE",
+	"genesyscloud_architect_datatable":   "DATATABLE",
+	"genesyscloud_architect_user_prompt": "USERPROMPT",
+}
+
+// flowConsumersWarningServer adds a warning to the plans destroying or replacing an object that flows consume
+type flowConsumersWarningServer struct {
+	tfprotov5.ProviderServer
+	provider *schema.Provider
+}
+
+// NewFlowConsumersWarningServer returns the provider server of the provider, warning when a plan destroys or replaces
+// an object that flows consume
+func NewFlowConsumersWarningServer(p *schema.Provider) tfprotov5.ProviderServer {
+	return &flowConsumersWarningServer{
+		ProviderServer: schema.NewGRPCProviderServer(p),
+		provider:       p,
+	}
+}
+
+func (s *flowConsumersWarningServer) PlanResourceChange(ctx context.Context, req *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	resp, err := s.ProviderServer.PlanResourceChange(ctx, req)
+	if err != nil || resp == nil {
+		return resp, err
+	}
+
+	objectType, ok := consumedObjectTypes[req.TypeName]
+	if !ok {
+		return resp, nil
+	}
+	objectId, action := s.getPlannedRemoval(req, resp)
+	if objectId == "" {
+		return resp, nil
+	}
+
+	// The default configuration is the one initialised when the provider is configured
+	proxy := getArchitectDependenciesProxy(platformclientv2.GetDefaultConfiguration())
+	consumers, _, err := proxy.getConsumingResources(ctx, objectId, objectType)
+	if err != nil {
+		// The lookup only informs the plan, it must not fail it
+		log.Printf("Failed to read the consumers of %s %s: %s", objectType, objectId, err)
+		return resp, nil
+	}
+
+	flows := getUnmanagedFlowConsumers(consumers, nil)
+	if warning := buildFlowConsumersWarning(objectType, objectId, action, flows); warning != nil {
+		resp.Diagnostics = append(resp.Diagnostics, warning)
+	}
+	return resp, nil
+}
+
+// getPlannedRemoval returns the ID of the object if the plan destroys or replaces it, and the planned action
+func (s *flowConsumersWarningServer) getPlannedRemoval(req *tfprotov5.PlanResourceChangeRequest, resp *tfprotov5.PlanResourceChangeResponse) (string, string) {
+	res, ok := s.provider.ResourcesMap[req.TypeName]
+	if !ok || req.PriorState == nil || req.ProposedNewState == nil {
+		return "", ""
+	}
+	stateType := res.CoreConfigSchema().ImpliedType()
+
+	prior, err := msgpack.Unmarshal(req.PriorState.MsgPack, stateType)
+	if err != nil || prior.IsNull() {
+		return "", ""
+	}
+	id := prior.GetAttr("id")
+	if id.IsNull() || !id.IsKnown() || id.Type() != cty.String {
+		return "", ""
+	}
+
+	proposed, err := msgpack.Unmarshal(req.ProposedNewState.MsgPack, stateType)
+	if err != nil {
+		return "", ""
+	}
+	if proposed.IsNull() {
+		return id.AsString(), "Destroying"
+	}
+	if len(resp.RequiresReplace) > 0 {
+		return id.AsString(), "Replacing"
+	}
+	return "", ""
+}
+
+// getUnmanagedFlowConsumers returns the flow consumers that are not in the managed flow IDs
+func getUnmanagedFlowConsumers(consumers *[]platformclientv2.Dependency, managedFlowIds []string) []platformclientv2.Dependency {
+	if consumers == nil {
+		return nil
+	}
+
+	managed := make(map[string]bool, len(managedFlowIds))
+	for _, id := range managedFlowIds {
+		managed[id] = true
+	}
+
+	resourceTypes := dependent_consumers.SetDependentObjectMaps()
+	var unmanaged []platformclientv2.Dependency
+	for _, consumer := range *consumers {
+		if consumer.Id == nil || consumer.VarType == nil {
+			continue
+		}
+		if resourceTypes[*consumer.VarType] != "genesyscloud_flow" && !isFlowObjectType(*consumer.VarType) {
+			continue
+		}
+		if managed[*consumer.Id] {
+			continue
+		}
+		unmanaged = append(unmanaged, consumer)
+	}
+	return unmanaged
+}
+
+// buildFlowConsumersWarning returns a warning listing the flows that consume an object the plan destroys or replaces
+func buildFlowConsumersWarning(objectType, objectId, action string, flows []platformclientv2.Dependency) *tfprotov5.Diagnostic {
+	if len(flows) == 0 {
+		return nil
+	}
+
+	descriptions := make([]string, 0, len(flows))
+	for _, consumer := range flows {
+		name := *consumer.Id
+		if consumer.Name != nil {
+			name = fmt.Sprintf("%s (%s)", *consumer.Name, *consumer.Id)
+		}
+		descriptions = append(descriptions, fmt.Sprintf("%s %s", *consumer.VarType, name))
+	}
+	return &tfprotov5.Diagnostic{
+		Severity: tfprotov5.DiagnosticSeverityWarning,
+		Summary:  fmt.Sprintf("%s %s is consumed by flows", objectType, objectId),
+		Detail: fmt.Sprintf("%s it will leave the following flows with a broken reference unless they are updated or destroyed in the same apply: %s",
+			action, strings.Join(descriptions, ", ")),
+	}
+}
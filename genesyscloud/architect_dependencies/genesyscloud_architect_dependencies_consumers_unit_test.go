@@ -0,0 +1,89 @@
+package architect_dependencies
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/go-cty/cty/msgpack"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v154/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitFlowConsumersWarningServerPlan(t *testing.T) {
+	queueId := uuid.NewString()
+	flowId := uuid.NewString()
+
+	lookups := 0
+	testProxy := &architectDependenciesProxy{}
+	testProxy.getConsumingResourcesAttr = func(ctx context.Context, p *architectDependenciesProxy, id, objectType string) (*[]platformclientv2.Dependency, *platformclientv2.APIResponse, error) {
+		lookups++
+		assert.Equal(t, queueId, id)
+		assert.Equal(t, "QUEUE", objectType)
+		consumers := []platformclientv2.Dependency{
+			buildTestDependency(flowId, "Support flow", "INBOUNDCALLFLOW"),
+			buildTestDependency(uuid.NewString(), "Main IVR", "IVRCONFIGURATION"),
+		}
+		return &consumers, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	internalProxy = testProxy
+	defer func() { internalProxy = nil }()
+
+	resourceSchema := map[string]*schema.Schema{
+		"name":        {Type: schema.TypeString, Required: true, ForceNew: true},
+		"description": {Type: schema.TypeString, Optional: true},
+	}
+	p := &schema.Provider{ResourcesMap: map[string]*schema.Resource{
+		"genesyscloud_routing_queue": {Schema: resourceSchema},
+		"genesyscloud_routing_skill": {Schema: resourceSchema},
+	}}
+	server := NewFlowConsumersWarningServer(p)
+	stateType := p.ResourcesMap["genesyscloud_routing_queue"].CoreConfigSchema().ImpliedType()
+
+	buildState := func(name, description string) *tfprotov5.DynamicValue {
+		state, err := msgpack.Marshal(cty.ObjectVal(map[string]cty.Value{
+			"id":          cty.StringVal(queueId),
+			"name":        cty.StringVal(name),
+			"description": cty.StringVal(description),
+		}), stateType)
+		assert.NoError(t, err)
+		return &tfprotov5.DynamicValue{MsgPack: state}
+	}
+	nullState, err := msgpack.Marshal(cty.NullVal(stateType), stateType)
+	assert.NoError(t, err)
+
+	plan := func(typeName string, proposed *tfprotov5.DynamicValue) []*tfprotov5.Diagnostic {
+		resp, err := server.PlanResourceChange(context.Background(), &tfprotov5.PlanResourceChangeRequest{
+			TypeName:         typeName,
+			PriorState:       buildState("Support", ""),
+			ProposedNewState: proposed,
+			Config:           proposed,
+		})
+		assert.NoError(t, err)
+		return resp.Diagnostics
+	}
+
+	// Destroying the queue warns about the flows consuming it
+	diags := plan("genesyscloud_routing_queue", &tfprotov5.DynamicValue{MsgPack: nullState})
+	if assert.Len(t, diags, 1) {
+		assert.Equal(t, tfprotov5.DiagnosticSeverityWarning, diags[0].Severity)
+		assert.Contains(t, diags[0].Detail, "Destroying")
+		assert.Contains(t, diags[0].Detail, flowId)
+		assert.NotContains(t, diags[0].Detail, "Main IVR")
+	}
+
+	// Replacing it warns as well
+	diags = plan("genesyscloud_routing_queue", buildState("Support renamed", ""))
+	if assert.Len(t, diags, 1) {
+		assert.Contains(t, diags[0].Detail, "Replacing")
+	}
+
+	// Updates in place and other resources are not looked up
+	assert.Empty(t, plan("genesyscloud_routing_queue", buildState("Support", "Updated")))
+	assert.Empty(t, plan("genesyscloud_routing_skill", &tfprotov5.DynamicValue{MsgPack: nullState}))
+	assert.Equal(t, 2, lookups)
+}
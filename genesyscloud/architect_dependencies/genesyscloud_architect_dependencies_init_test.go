@@ -0,0 +1,46 @@
+package architect_dependencies
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+   The genesyscloud_architect_dependencies_init_test.go file is used to initialize the data sources
+   used in testing the architect_dependencies data source.
+*/
+
+// providerDataSources holds a map of all registered datasources
+var providerDataSources map[string]*schema.Resource
+
+type registerTestInstance struct {
+	datasourceMapMutex sync.RWMutex
+}
+
+// registerTestDataSources registers all data sources used in the tests.
+func (r *registerTestInstance) registerTestDataSources() {
+	r.datasourceMapMutex.Lock()
+	defer r.datasourceMapMutex.Unlock()
+
+	providerDataSources[ResourceType] = DataSourceArchitectDependencies()
+}
+
+// initTestResources initializes all test data sources.
+func initTestResources() {
+	providerDataSources = make(map[string]*schema.Resource)
+
+	regInstance := &registerTestInstance{}
+
+	regInstance.registerTestDataSources()
+}
+
+// TestMain is a "setup" function called by the testing framework when run the test
+func TestMain(m *testing.M) {
+	// Run setup function before starting the test suite for the architect_dependencies package
+	initTestResources()
+
+	// Run the test suite for the architect_dependencies package
+	m.Run()
+}
@@ -0,0 +1,131 @@
+package architect_dependencies
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v154/platformclientv2"
+)
+
+/*
+The genesyscloud_architect_dependencies_proxy.go file contains the proxy structures and methods that interact
+with the Genesys Cloud SDK. We use composition here for each function on the proxy so individual functions can be stubbed
+out during testing.
+*/
+
+// internalProxy holds a proxy instance that can be used throughout the package
+var internalProxy *architectDependenciesProxy
+
+// Type definitions for each func on our proxy so we can easily mock them out later
+type getConsumingResourcesFunc func(ctx context.Context, p *architectDependenciesProxy, id, objectType string) (*[]platformclientv2.Dependency, *platformclientv2.APIResponse, error)
+type getConsumedResourcesFunc func(ctx context.Context, p *architectDependenciesProxy, id, objectType, version string) (*[]platformclientv2.Dependency, *platformclientv2.APIResponse, error)
+type getFlowPublishedVersionFunc func(ctx context.Context, p *architectDependenciesProxy, flowId string) (string, *platformclientv2.APIResponse, error)
+
+// architectDependenciesProxy contains all of the methods that call genesys cloud APIs.
+type architectDependenciesProxy struct {
+	clientConfig                *platformclientv2.Configuration
+	architectApi                *platformclientv2.ArchitectApi
+	getConsumingResourcesAttr   getConsumingResourcesFunc
+	getConsumedResourcesAttr    getConsumedResourcesFunc
+	getFlowPublishedVersionAttr getFlowPublishedVersionFunc
+}
+
+// newArchitectDependenciesProxy initializes the architect dependencies proxy with all of the data needed to communicate with Genesys Cloud
+func newArchitectDependenciesProxy(clientConfig *platformclientv2.Configuration) *architectDependenciesProxy {
+	api := platformclientv2.NewArchitectApiWithConfig(clientConfig)
+	return &architectDependenciesProxy{
+		clientConfig:                clientConfig,
+		architectApi:                api,
+		getConsumingResourcesAttr:   getConsumingResourcesFn,
+		getConsumedResourcesAttr:    getConsumedResourcesFn,
+		getFlowPublishedVersionAttr: getFlowPublishedVersionFn,
+	}
+}
+
+// getArchitectDependenciesProxy acts as a singleton to for the internalProxy.  It also ensures
+// that we can still proxy our tests by directly setting internalProxy package variable
+func getArchitectDependenciesProxy(clientConfig *platformclientv2.Configuration) *architectDependenciesProxy {
+	if internalProxy == nil {
+		internalProxy = newArchitectDependenciesProxy(clientConfig)
+	}
+	return internalProxy
+}
+
+// getConsumingResources returns the resources that consume an Architect object
+func (p *architectDependenciesProxy) getConsumingResources(ctx context.Context, id, objectType string) (*[]platformclientv2.Dependency, *platformclientv2.APIResponse, error) {
+	return p.getConsumingResourcesAttr(ctx, p, id, objectType)
+}
+
+// getConsumedResources returns the resources consumed by a version of an Architect object
+func (p *architectDependenciesProxy) getConsumedResources(ctx context.Context, id, objectType, version string) (*[]platformclientv2.Dependency, *platformclientv2.APIResponse, error) {
+	return p.getConsumedResourcesAttr(ctx, p, id, objectType, version)
+}
+
+// getFlowPublishedVersion returns the id of the published version of a flow, or an empty string if the flow is not published
+func (p *architectDependenciesProxy) getFlowPublishedVersion(ctx context.Context, flowId string) (string, *platformclientv2.APIResponse, error) {
+	return p.getFlowPublishedVersionAttr(ctx, p, flowId)
+}
+
+// getConsumingResourcesFn is an implementation function for reading all the consumers of an Architect object
+func getConsumingResourcesFn(_ context.Context, p *architectDependenciesProxy, id, objectType string) (*[]platformclientv2.Dependency, *platformclientv2.APIResponse, error) {
+	const pageSize = 100
+	var consumers []platformclientv2.Dependency
+
+	listing, resp, err := p.architectApi.GetArchitectDependencytrackingConsumingresources(id, objectType, nil, "", 1, pageSize, "")
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to get consumers of %s %s: %s", objectType, id, err)
+	}
+	if listing.Entities != nil {
+		consumers = append(consumers, *listing.Entities...)
+	}
+
+	for pageNum := 2; listing.PageCount != nil && pageNum <= *listing.PageCount; pageNum++ {
+		page, resp, err := p.architectApi.GetArchitectDependencytrackingConsumingresources(id, objectType, nil, "", pageNum, pageSize, "")
+		if err != nil {
+			return nil, resp, fmt.Errorf("failed to get consumers of %s %s: %s", objectType, id, err)
+		}
+		if page.Entities == nil || len(*page.Entities) == 0 {
+			break
+		}
+		consumers = append(consumers, *page.Entities...)
+	}
+	return &consumers, resp, nil
+}
+
+// getConsumedResourcesFn is an implementation function for reading all the resources consumed by an Architect object
+func getConsumedResourcesFn(_ context.Context, p *architectDependenciesProxy, id, objectType, version string) (*[]platformclientv2.Dependency, *platformclientv2.APIResponse, error) {
+	const pageSize = 100
+	var consumed []platformclientv2.Dependency
+
+	listing, resp, err := p.architectApi.GetArchitectDependencytrackingConsumedresources(id, version, objectType, nil, 1, pageSize)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to get resources consumed by %s %s: %s", objectType, id, err)
+	}
+	if listing.Entities != nil {
+		consumed = append(consumed, *listing.Entities...)
+	}
+
+	for pageNum := 2; listing.PageCount != nil && pageNum <= *listing.PageCount; pageNum++ {
+		page, resp, err := p.architectApi.GetArchitectDependencytrackingConsumedresources(id, version, objectType, nil, pageNum, pageSize)
+		if err != nil {
+			return nil, resp, fmt.Errorf("failed to get resources consumed by %s %s: %s", objectType, id, err)
+		}
+		if page.Entities == nil || len(*page.Entities) == 0 {
+			break
+		}
+		consumed = append(consumed, *page.Entities...)
+	}
+	return &consumed, resp, nil
+}
+
+// getFlowPublishedVersionFn is an implementation function for reading the published version of a flow
+func getFlowPublishedVersionFn(_ context.Context, p *architectDependenciesProxy, flowId string) (string, *platformclientv2.APIResponse, error) {
+	flow, resp, err := p.architectApi.GetFlow(flowId, false)
+	if err != nil {
+		return "", resp, fmt.Errorf("failed to get flow %s: %s", flowId, err)
+	}
+	if flow.PublishedVersion == nil || flow.PublishedVersion.Id == nil {
+		return "", resp, nil
+	}
+	return *flow.PublishedVersion.Id, resp, nil
+}
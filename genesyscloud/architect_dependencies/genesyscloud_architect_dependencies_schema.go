@@ -0,0 +1,132 @@
+package architect_dependencies
+
+import (
+	"sort"
+	"terraform-provider-genesyscloud/genesyscloud/dependent_consumers"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+genesyscloud_architect_dependencies_schema.go holds two functions within it:
+
+1.  The registration code that registers the Datasource for the package.
+2.  The datasource schema definitions for the architect_dependencies datasource.
+*/
+const ResourceType = "genesyscloud_architect_dependencies"
+
+// SetRegistrar registers all of the resources, datasources and exporters in the package
+func SetRegistrar(regInstance registrar.Registrar) {
+	regInstance.RegisterDataSource(ResourceType, DataSourceArchitectDependencies())
+}
+
+var dependencyResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"id": {
+			Description: "ID of the dependency.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"name": {
+			Description: "Name of the dependency.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"type": {
+			Description: "Architect dependency tracking type of the dependency, e.g. INBOUNDCALLFLOW or QUEUE.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"version": {
+			Description: "Version of the dependency, if it is versioned.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"resource_type": {
+			Description: "The Terraform resource type that manages the dependency. Empty if the provider has no matching resource.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	},
+}
+
+// DataSourceArchitectDependencies registers the genesyscloud_architect_dependencies data source
+func DataSourceArchitectDependencies() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for the Genesys Cloud Architect dependency tracking graph. Returns the resources that consume an object and, for flows, the resources the flow consumes. Independently of this data source, plans destroying or replacing a queue, data table or user prompt warn about the flows that consume it.",
+		ReadContext: provider.ReadWithPooledClient(dataSourceArchitectDependenciesRead),
+		Schema: map[string]*schema.Schema{
+			"object_id": {
+				Description: "ID of the object to look up, e.g. a queue, data table, user prompt or flow ID.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"object_type": {
+				Description:  "Architect dependency tracking type of the object, e.g. QUEUE, DATATABLE, USERPROMPT or INBOUNDCALLFLOW.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(getDependencyObjectTypes(), false),
+			},
+			"version": {
+				Description: "Version of a flow to read the consumed resources of. Defaults to the published version.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"managed_flow_ids": {
+				Description: "IDs of the flows managed in the configuration. The flows consuming the object that are not listed are returned in `unmanaged_flow_consumers`.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"consumers": {
+				Description: "Resources that consume the object.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        dependencyResource,
+			},
+			"unmanaged_flow_consumers": {
+				Description: "Flows that consume the object and are not listed in `managed_flow_ids`.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        dependencyResource,
+			},
+			"consumed_resources": {
+				Description: "Resources consumed by the object. Only populated for flows.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        dependencyResource,
+			},
+		},
+	}
+}
+
+// getDependencyObjectTypes returns every dependency tracking type known to the exporter's dependency resolution
+func getDependencyObjectTypes() []string {
+	types := make(map[string]bool)
+	for objectType := range dependent_consumers.SetDependentObjectMaps() {
+		types[objectType] = true
+	}
+	for _, objectType := range dependent_consumers.SetFlowTypeObjectMaps() {
+		types[objectType] = true
+	}
+
+	objectTypes := make([]string, 0, len(types))
+	for objectType := range types {
+		objectTypes = append(objectTypes, objectType)
+	}
+	sort.Strings(objectTypes)
+	return objectTypes
+}
+
+// isFlowObjectType returns true if the dependency tracking type is a flow type
+func isFlowObjectType(objectType string) bool {
+	for _, flowObjectType := range dependent_consumers.SetFlowTypeObjectMaps() {
+		if flowObjectType == objectType {
+			return true
+		}
+	}
+	return false
+}
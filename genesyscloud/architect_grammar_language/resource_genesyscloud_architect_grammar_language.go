@@ -87,6 +87,8 @@ func readArchitectGrammarLanguage(ctx context.Context, d *schema.ResourceData, m
 		if language.DtmfFileMetadata != nil {
 			_ = d.Set("dtmf_file_data", flattenGrammarLanguageFileMetadata(d, language.DtmfFileMetadata, Dtmf))
 		}
+		_ = d.Set("voice_file_rule_names", flattenGrammarRuleNames(d, Voice))
+		_ = d.Set("dtmf_file_rule_names", flattenGrammarRuleNames(d, Dtmf))
 
 		log.Printf("Read Architect Grammar Language %s", d.Id())
 		return cc.CheckState(d)
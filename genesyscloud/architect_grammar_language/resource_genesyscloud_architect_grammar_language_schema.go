@@ -36,10 +36,10 @@ func ResourceArchitectGrammarLanguage() *schema.Resource {
 				Type:        schema.TypeString,
 			},
 			`file_type`: {
-				Description:  "The extension of the file.",
+				Description:  "The extension of the file. Gram for GSL grammars or Grxml for SRGS XML grammars.",
 				Required:     true,
 				Type:         schema.TypeString,
-				ValidateFunc: validation.StringInSlice([]string{"Gram", "Grxml"}, false),
+				ValidateFunc: validation.StringInSlice([]string{gslFileType, grxmlFileType}, false),
 			},
 			"file_content_hash": {
 				Description: "Hash value of the file content. Used to detect changes.",
@@ -88,7 +88,21 @@ func ResourceArchitectGrammarLanguage() *schema.Resource {
 				MaxItems:    1,
 				Elem:        fileMetadataResource,
 			},
+			`voice_file_rule_names`: {
+				Description: "Names of the rules defined in the local voice grammar file, in the order they are defined. Empty when the file could not be parsed locally.",
+				Computed:    true,
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			`dtmf_file_rule_names`: {
+				Description: "Names of the rules defined in the local dtmf grammar file, in the order they are defined. Empty when the file could not be parsed locally.",
+				Computed:    true,
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 		},
+		CustomizeDiff:                  customizeGrammarLanguageDiff,
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{validateGrammarLanguageConfig},
 	}
 }
 
@@ -98,7 +112,7 @@ func ArchitectGrammarLanguageExporter() *resourceExporter.ResourceExporter {
 		GetResourcesFunc: provider.GetAllWithPooledClient(getAllAuthArchitectGrammarLanguage),
 		CustomFileWriter: resourceExporter.CustomFileWriterSettings{
 			RetrieveAndWriteFilesFunc: ArchitectGrammarLanguageResolver,
-			SubDirectory:              "language_files",
+			SubDirectory:              "grammars",
 		},
 		RefAttrs: map[string]*resourceExporter.RefAttrSettings{
 			"grammar_id": {RefType: "genesyscloud_architect_grammar"},
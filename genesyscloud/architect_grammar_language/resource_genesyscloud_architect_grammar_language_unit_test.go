@@ -0,0 +1,71 @@
+package architect_grammar_language
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v154/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitArchitectGrammarLanguageResolver(t *testing.T) {
+	grammarId := "grammar-id"
+	fixtures := map[string]string{
+		"https://example.com/voice": testGrammarDataPath("voice-gram-01.gram"),
+		"https://example.com/dtmf":  testGrammarDataPath("dtmf-grxml-01.grxml"),
+	}
+
+	internalProxy = &architectGrammarLanguageProxy{
+		getArchitectGrammarLanguageByIdAttr: func(ctx context.Context, p *architectGrammarLanguageProxy, grammarId string, languageCode string) (*platformclientv2.Grammarlanguage, *platformclientv2.APIResponse, error) {
+			return &platformclientv2.Grammarlanguage{
+				Id:                platformclientv2.String(languageCode),
+				GrammarId:         &grammarId,
+				Language:          &languageCode,
+				VoiceFileUrl:      platformclientv2.String("https://example.com/voice"),
+				VoiceFileMetadata: &platformclientv2.Grammarlanguagefilemetadata{FileType: platformclientv2.String("Gram")},
+				DtmfFileUrl:       platformclientv2.String("https://example.com/dtmf"),
+				DtmfFileMetadata:  &platformclientv2.Grammarlanguagefilemetadata{FileType: platformclientv2.String("Grxml")},
+			}, nil, nil
+		},
+	}
+	defer func() { internalProxy = nil }()
+
+	origDownloadFile := files.DownloadExportFile
+	files.DownloadExportFile = func(directory, fileName, uri string) (*platformclientv2.APIResponse, error) {
+		content, err := os.ReadFile(fixtures[uri])
+		if err != nil {
+			return nil, err
+		}
+		return nil, os.WriteFile(filepath.Join(directory, fileName), content, 0644)
+	}
+	defer func() { files.DownloadExportFile = origDownloadFile }()
+
+	exportDirectory := t.TempDir()
+	subDirectory := ArchitectGrammarLanguageExporter().CustomFileWriter.SubDirectory
+	assert.Equal(t, "grammars", subDirectory)
+
+	configMap := map[string]interface{}{
+		"voice_file_data": []interface{}{map[string]interface{}{"file_type": "Gram"}},
+		"dtmf_file_data":  []interface{}{map[string]interface{}{"file_type": "Grxml"}},
+	}
+	resource := resourceExporter.ResourceInfo{State: &terraform.InstanceState{Attributes: map[string]string{}}}
+	meta := &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}
+
+	err := ArchitectGrammarLanguageResolver(buildGrammarLanguageId(grammarId, "en-us"), exportDirectory, subDirectory, configMap, meta, resource)
+	assert.NoError(t, err)
+
+	voicePath := filepath.Join("grammars", "en-us-voice-grammar-id.gram")
+	dtmfPath := filepath.Join("grammars", "en-us-dtmf-grammar-id.grxml")
+	assert.FileExists(t, filepath.Join(exportDirectory, voicePath))
+	assert.FileExists(t, filepath.Join(exportDirectory, dtmfPath))
+	assert.Equal(t, voicePath, configMap["voice_file_data"].([]interface{})[0].(map[string]interface{})["file_name"])
+	assert.Equal(t, dtmfPath, configMap["dtmf_file_data"].([]interface{})[0].(map[string]interface{})["file_name"])
+	assert.Equal(t, `${filesha256("`+dtmfPath+`")}`, configMap["dtmf_file_data"].([]interface{})[0].(map[string]interface{})["file_content_hash"])
+}
@@ -0,0 +1,494 @@
+package architect_grammar_language
+
+import (
+	"bufio"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+The resource_genesyscloud_architect_grammar_language_validation.go file contains the local parsing and validation of
+the GRXML and GSL grammar files uploaded as grammar languages. Problems are reported as warnings with the line they were found on,
+so that they can be fixed before the file is rejected by the API without blocking grammars the API accepts.
+*/
+
+const (
+	grxmlFileType = "Grxml"
+	gslFileType   = "Gram"
+)
+
+// grammarIssue is a problem found on a line of a grammar file
+type grammarIssue struct {
+	line    int
+	message string
+}
+
+func (i grammarIssue) Error() string {
+	return fmt.Sprintf("line %d: %s", i.line, i.message)
+}
+
+func newGrammarIssue(line int, format string, a ...interface{}) error {
+	return grammarIssue{line: line, message: fmt.Sprintf(format, a...)}
+}
+
+// fileDataKey returns the attribute holding the file metadata of the voice or dtmf grammar
+func fileDataKey(fileType FileType) string {
+	if fileType == Voice {
+		return "voice_file_data"
+	}
+	return "dtmf_file_data"
+}
+
+// ruleNamesKey returns the computed attribute holding the rule names of the voice or dtmf grammar
+func ruleNamesKey(fileType FileType) string {
+	if fileType == Voice {
+		return "voice_file_rule_names"
+	}
+	return "dtmf_file_rule_names"
+}
+
+// parseGrammar parses a grammar file of the given format and returns the names of the rules it defines
+func parseGrammar(r io.Reader, format string, fileType FileType) ([]string, []error) {
+	switch format {
+	case grxmlFileType:
+		return parseGrxml(r, fileType)
+	case gslFileType:
+		return parseGsl(r)
+	}
+	return nil, []error{fmt.Errorf("unsupported grammar file type %s", format)}
+}
+
+// inspectGrammarFile opens and parses a local grammar file. It returns os.ErrNotExist when the file cannot be found.
+func inspectGrammarFile(filename, format string, fileType FileType) ([]string, []error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, []error{err}
+	}
+	defer file.Close()
+	return parseGrammar(file, format, fileType)
+}
+
+// parseGrxml parses an SRGS XML grammar. Rule ids must be unique and the root rule and local rule references must be defined.
+func parseGrxml(r io.Reader, fileType FileType) ([]string, []error) {
+	type ruleRef struct {
+		id   string
+		line int
+	}
+
+	var (
+		errs        []error
+		ruleNames   []string
+		ruleLines   = make(map[string]int)
+		references  []ruleRef
+		rootRule    *ruleRef
+		foundRoot   bool
+		grammarLine int
+	)
+
+	decoder := xml.NewDecoder(r)
+	for {
+		// The position before reading a token is the position of the '<' starting the next element
+		line, _ := decoder.InputPos()
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			var syntaxErr *xml.SyntaxError
+			if errors.As(err, &syntaxErr) {
+				errs = append(errs, newGrammarIssue(syntaxErr.Line, "%s", syntaxErr.Msg))
+			} else {
+				errs = append(errs, err)
+			}
+			return ruleNames, errs
+		}
+
+		element, ok := token.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		attrs := make(map[string]string)
+		for _, attr := range element.Attr {
+			attrs[attr.Name.Local] = attr.Value
+		}
+
+		if !foundRoot {
+			foundRoot = true
+			grammarLine = line
+			if element.Name.Local != "grammar" {
+				errs = append(errs, newGrammarIssue(line, "root element must be <grammar>, found <%s>", element.Name.Local))
+				return ruleNames, errs
+			}
+			mode := attrs["mode"]
+			if mode != "" && mode != "voice" && mode != "dtmf" {
+				errs = append(errs, newGrammarIssue(line, "mode must be voice or dtmf, found %q", mode))
+			} else if fileType == Dtmf && mode != "dtmf" {
+				errs = append(errs, newGrammarIssue(line, `dtmf grammars must declare mode="dtmf"`))
+			} else if fileType == Voice && mode == "dtmf" {
+				errs = append(errs, newGrammarIssue(line, `voice grammars must not declare mode="dtmf"`))
+			}
+			if root, ok := attrs["root"]; ok {
+				rootRule = &ruleRef{id: root, line: line}
+			}
+			continue
+		}
+
+		switch element.Name.Local {
+		case "rule":
+			id := attrs["id"]
+			if id == "" {
+				errs = append(errs, newGrammarIssue(line, "<rule> has no id"))
+				continue
+			}
+			if firstLine, ok := ruleLines[id]; ok {
+				errs = append(errs, newGrammarIssue(line, "rule %q is already defined on line %d", id, firstLine))
+				continue
+			}
+			ruleLines[id] = line
+			ruleNames = append(ruleNames, id)
+		case "ruleref":
+			// Only local references can be resolved. External grammars and special rules are left to the API.
+			if uri := attrs["uri"]; strings.HasPrefix(uri, "#") {
+				references = append(references, ruleRef{id: strings.TrimPrefix(uri, "#"), line: line})
+			}
+		}
+	}
+
+	if !foundRoot {
+		return ruleNames, append(errs, newGrammarIssue(1, "file does not contain a <grammar> element"))
+	}
+	if len(ruleNames) == 0 {
+		errs = append(errs, newGrammarIssue(grammarLine, "grammar does not define any rules"))
+	}
+	if rootRule != nil {
+		if _, ok := ruleLines[rootRule.id]; !ok {
+			errs = append(errs, newGrammarIssue(rootRule.line, "root rule %q is not defined", rootRule.id))
+		}
+	}
+	for _, ref := range references {
+		if _, ok := ruleLines[ref.id]; !ok {
+			errs = append(errs, newGrammarIssue(ref.line, "reference to undefined rule %q", ref.id))
+		}
+	}
+	return ruleNames, errs
+}
+
+// gslToken is a word, string or bracket read from a GSL grammar
+type gslToken struct {
+	text string
+	line int
+}
+
+var gslClosingBrackets = map[string]string{"(": ")", "[": "]"}
+
+// parseGsl parses a Nuance GSL grammar. A GSL grammar is a list of rule definitions, each made of a rule name
+// (containing at least one uppercase letter) followed by a single expression.
+func parseGsl(r io.Reader) ([]string, []error) {
+	tokens, hasIncludes, errs := tokenizeGsl(r)
+
+	type openBracket struct {
+		text string
+		line int
+	}
+
+	var (
+		ruleNames     []string
+		ruleLines     = make(map[string]int)
+		references    []gslToken
+		stack         []openBracket
+		expectingName = true
+		lastRule      gslToken
+	)
+
+	for _, token := range tokens {
+		switch token.text {
+		case "(", "[":
+			if len(stack) == 0 && expectingName {
+				errs = append(errs, newGrammarIssue(token.line, "expected a rule name before %q", token.text))
+			}
+			stack = append(stack, openBracket{text: token.text, line: token.line})
+			continue
+		case ")", "]":
+			if len(stack) == 0 {
+				errs = append(errs, newGrammarIssue(token.line, "unexpected %q", token.text))
+				continue
+			}
+			open := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if gslClosingBrackets[open.text] != token.text {
+				errs = append(errs, newGrammarIssue(token.line, "%q does not match %q opened on line %d", token.text, open.text, open.line))
+			}
+			if len(stack) == 0 {
+				expectingName = true
+			}
+			continue
+		}
+
+		word := strings.TrimLeft(token.text, "?*+~")
+		if word == "" {
+			// An operator applying to the bracketed expression that follows, e.g. ?( ... )
+			continue
+		}
+		if len(stack) > 0 || !expectingName {
+			// Part of an expression
+			if isGslRuleName(word) {
+				references = append(references, gslToken{text: gslRuleReference(word), line: token.line})
+			}
+			if len(stack) == 0 {
+				expectingName = true
+			}
+			continue
+		}
+
+		// A rule definition
+		if !isGslRuleName(word) || word != token.text {
+			errs = append(errs, newGrammarIssue(token.line, "invalid rule name %q. Rule names must contain an uppercase letter", token.text))
+		} else if firstLine, ok := ruleLines[word]; ok {
+			errs = append(errs, newGrammarIssue(token.line, "rule %q is already defined on line %d", word, firstLine))
+		} else {
+			ruleLines[word] = token.line
+			ruleNames = append(ruleNames, word)
+		}
+		expectingName = false
+		lastRule = token
+	}
+
+	for _, open := range stack {
+		errs = append(errs, newGrammarIssue(open.line, "%q is never closed", open.text))
+	}
+	if !expectingName && len(stack) == 0 {
+		errs = append(errs, newGrammarIssue(lastRule.line, "rule %q has no expression", lastRule.text))
+	}
+	if len(ruleNames) == 0 && len(errs) == 0 {
+		errs = append(errs, newGrammarIssue(1, "grammar does not define any rules"))
+	}
+
+	// Rules may be defined in included files, which cannot be resolved locally
+	if !hasIncludes {
+		for _, ref := range references {
+			if _, ok := ruleLines[ref.text]; ok {
+				continue
+			}
+			if _, ok := ruleLines["."+ref.text]; ok {
+				continue
+			}
+			errs = append(errs, newGrammarIssue(ref.line, "reference to undefined rule %q", ref.text))
+		}
+	}
+	return ruleNames, errs
+}
+
+// tokenizeGsl splits a GSL grammar into words, strings and brackets. Comments and {} commands are dropped.
+func tokenizeGsl(r io.Reader) (tokens []gslToken, hasIncludes bool, errs []error) {
+	scanner := bufio.NewScanner(r)
+	var (
+		lineNum      int
+		commandDepth int
+		commandLine  int
+	)
+
+	for scanner.Scan() {
+		lineNum++
+		line := scanner.Text()
+		if commandDepth == 0 && strings.HasPrefix(strings.TrimSpace(line), "#include") {
+			hasIncludes = true
+			continue
+		}
+
+		var word strings.Builder
+		flushWord := func() {
+			if word.Len() > 0 {
+				tokens = append(tokens, gslToken{text: word.String(), line: lineNum})
+				word.Reset()
+			}
+		}
+
+		for i := 0; i < len(line); i++ {
+			c := line[i]
+			if commandDepth > 0 {
+				switch c {
+				case '{':
+					commandDepth++
+				case '}':
+					commandDepth--
+				}
+				continue
+			}
+
+			switch {
+			case c == ';':
+				i = len(line)
+			case c == '{':
+				flushWord()
+				commandDepth = 1
+				commandLine = lineNum
+			case c == '}':
+				flushWord()
+				errs = append(errs, newGrammarIssue(lineNum, "unexpected %q", "}"))
+			case c == '"':
+				flushWord()
+				end := strings.IndexByte(line[i+1:], '"')
+				if end < 0 {
+					errs = append(errs, newGrammarIssue(lineNum, "unterminated string"))
+					i = len(line)
+					continue
+				}
+				tokens = append(tokens, gslToken{text: line[i : i+end+2], line: lineNum})
+				i += end + 1
+			case c == '(' || c == ')' || c == '[' || c == ']':
+				flushWord()
+				tokens = append(tokens, gslToken{text: string(c), line: lineNum})
+			case unicode.IsSpace(rune(c)):
+				flushWord()
+			default:
+				word.WriteByte(c)
+			}
+		}
+		flushWord()
+	}
+	if err := scanner.Err(); err != nil {
+		errs = append(errs, err)
+	}
+	if commandDepth > 0 {
+		errs = append(errs, newGrammarIssue(commandLine, "%q is never closed", "{"))
+	}
+	return tokens, hasIncludes, errs
+}
+
+// isGslRuleName returns true if a word names a rule rather than a terminal. GSL terminals are lowercase.
+func isGslRuleName(word string) bool {
+	if strings.HasPrefix(word, `"`) {
+		return false
+	}
+	for _, c := range gslRuleReference(word) {
+		if unicode.IsUpper(c) {
+			return true
+		}
+	}
+	return false
+}
+
+// gslRuleReference strips the variable assignment from a rule reference, e.g. NUMBER:n
+func gslRuleReference(word string) string {
+	if i := strings.IndexByte(word, ':'); i > 0 {
+		return word[:i]
+	}
+	return word
+}
+
+// validateGrammarLanguageConfig parses the local grammar files of the configuration. Problems are reported as warnings,
+// as the API may accept grammars using constructs the local parser does not understand.
+func validateGrammarLanguageConfig(_ context.Context, req schema.ValidateResourceConfigFuncRequest, resp *schema.ValidateResourceConfigFuncResponse) {
+	if req.RawConfig.IsNull() || !req.RawConfig.IsKnown() {
+		return
+	}
+	for _, fileType := range []FileType{Voice, Dtmf} {
+		key := fileDataKey(fileType)
+		fileData := req.RawConfig.GetAttr(key)
+		if fileData.IsNull() || !fileData.IsKnown() || fileData.LengthInt() == 0 {
+			continue
+		}
+		block := fileData.Index(cty.NumberIntVal(0))
+		fileName, format := block.GetAttr("file_name"), block.GetAttr("file_type")
+		if fileName.IsNull() || !fileName.IsKnown() || format.IsNull() || !format.IsKnown() {
+			continue
+		}
+
+		_, issues := inspectGrammarFile(fileName.AsString(), format.AsString(), fileType)
+		if len(issues) == 1 && errors.Is(issues[0], os.ErrNotExist) {
+			// The file may be created later in the apply
+			continue
+		}
+		for _, issue := range issues {
+			resp.Diagnostics = append(resp.Diagnostics, diag.Diagnostic{
+				Severity:      diag.Warning,
+				Summary:       fmt.Sprintf("%s: %s", fileName.AsString(), issue),
+				Detail:        "The grammar file may be rejected by the API when it is uploaded.",
+				AttributePath: cty.GetAttrPath(key).IndexInt(0).GetAttr("file_name"),
+			})
+		}
+	}
+}
+
+// customizeGrammarLanguageDiff computes the rule names of the local grammar files during plan
+func customizeGrammarLanguageDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	for _, fileType := range []FileType{Voice, Dtmf} {
+		key := fileDataKey(fileType)
+		if !diff.NewValueKnown(key) {
+			_ = diff.SetNewComputed(ruleNamesKey(fileType))
+			continue
+		}
+
+		ruleNames := make([]interface{}, 0)
+		if fileName, format := getFileData(diff.Get(key)); fileName != "" {
+			names, issues := inspectGrammarFile(fileName, format, fileType)
+			if len(issues) == 1 && errors.Is(issues[0], os.ErrNotExist) {
+				// The file may be created later in the apply
+				continue
+			}
+			// Rule names are only summarised for grammars parsed without problems
+			if len(issues) == 0 {
+				for _, name := range names {
+					ruleNames = append(ruleNames, name)
+				}
+			}
+		}
+
+		if old, _ := diff.Get(ruleNamesKey(fileType)).([]interface{}); !ruleNamesEqual(old, ruleNames) {
+			if err := diff.SetNew(ruleNamesKey(fileType), ruleNames); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// flattenGrammarRuleNames returns the rule names of a local grammar file referenced in the configuration
+func flattenGrammarRuleNames(d *schema.ResourceData, fileType FileType) []interface{} {
+	ruleNames := make([]interface{}, 0)
+	fileName, format := getFileData(d.Get(fileDataKey(fileType)))
+	if fileName == "" {
+		return ruleNames
+	}
+	names, errs := inspectGrammarFile(fileName, format, fileType)
+	if len(errs) > 0 {
+		return ruleNames
+	}
+	for _, name := range names {
+		ruleNames = append(ruleNames, name)
+	}
+	return ruleNames
+}
+
+func getFileData(fileData interface{}) (fileName, format string) {
+	fileDataList, _ := fileData.([]interface{})
+	if len(fileDataList) == 0 {
+		return "", ""
+	}
+	fileDataMap, _ := fileDataList[0].(map[string]interface{})
+	fileName, _ = fileDataMap["file_name"].(string)
+	format, _ = fileDataMap["file_type"].(string)
+	return fileName, format
+}
+
+func ruleNamesEqual(a, b []interface{}) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
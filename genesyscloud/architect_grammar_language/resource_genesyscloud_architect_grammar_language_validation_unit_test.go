@@ -0,0 +1,142 @@
+package architect_grammar_language
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitParseGrxml(t *testing.T) {
+	valid := `<?xml version="1.0" encoding="UTF-8"?>
+<grammar xmlns="http://www.w3.org/2001/06/grammar" version="1.0" mode="voice" root="ROOT">
+   <rule id="ROOT" scope="public">
+      <ruleref uri="#ACCOUNT"/>
+   </rule>
+   <rule id="ACCOUNT">
+      <one-of><item>checking</item><item>savings</item></one-of>
+   </rule>
+</grammar>`
+	ruleNames, errs := parseGrxml(strings.NewReader(valid), Voice)
+	assert.Empty(t, errs)
+	assert.Equal(t, []string{"ROOT", "ACCOUNT"}, ruleNames)
+
+	// A voice grammar cannot be uploaded as a dtmf grammar
+	_, errs = parseGrxml(strings.NewReader(valid), Dtmf)
+	assert.Len(t, errs, 1)
+	assert.Contains(t, errs[0].Error(), "line 2:")
+
+	invalid := `<?xml version="1.0" encoding="UTF-8"?>
+<grammar xmlns="http://www.w3.org/2001/06/grammar" version="1.0" root="MAIN">
+   <rule id="ROOT">
+      <ruleref uri="#MISSING"/>
+   </rule>
+   <rule id="ROOT">
+      <item>checking</item>
+   </rule>
+</grammar>`
+	_, errs = parseGrxml(strings.NewReader(invalid), Voice)
+	assert.Len(t, errs, 3)
+	assert.Equal(t, `line 6: rule "ROOT" is already defined on line 3`, errs[0].Error())
+	assert.Equal(t, `line 2: root rule "MAIN" is not defined`, errs[1].Error())
+	assert.Equal(t, `line 4: reference to undefined rule "MISSING"`, errs[2].Error())
+
+	malformed := `<grammar mode="voice">
+   <rule id="ROOT">
+      <item>checking</rule>
+</grammar>`
+	_, errs = parseGrxml(strings.NewReader(malformed), Voice)
+	assert.Len(t, errs, 1)
+	assert.Contains(t, errs[0].Error(), "line 3:")
+}
+
+func TestUnitParseGsl(t *testing.T) {
+	valid := `; account grammar
+.MAIN (
+  ?ARTICLE TYPE:t ?(account please) {<account $t>}
+)
+ARTICLE [a the my]
+TYPE [
+  (checking) {return("checking")}
+  (savings)  {return("savings")}
+]`
+	ruleNames, errs := parseGsl(strings.NewReader(valid))
+	assert.Empty(t, errs)
+	assert.Equal(t, []string{".MAIN", "ARTICLE", "TYPE"}, ruleNames)
+
+	invalid := `.MAIN (
+  ARTICLE ACCOUNT
+]
+article [a the]
+ARTICLE [my]
+.MAIN [yes no]
+TYPE (checking`
+	_, errs = parseGsl(strings.NewReader(invalid))
+	messages := make([]string, 0, len(errs))
+	for _, err := range errs {
+		messages = append(messages, err.Error())
+	}
+	assert.Equal(t, []string{
+		`line 3: "]" does not match "(" opened on line 1`,
+		`line 4: invalid rule name "article". Rule names must contain an uppercase letter`,
+		`line 6: rule ".MAIN" is already defined on line 1`,
+		`line 7: "(" is never closed`,
+		`line 2: reference to undefined rule "ACCOUNT"`,
+	}, messages)
+
+	// Rules from included files cannot be resolved locally
+	_, errs = parseGsl(strings.NewReader("#include \"types.gsl\"\n.MAIN [TYPE]"))
+	assert.Empty(t, errs)
+}
+
+func TestUnitInspectGrammarTestData(t *testing.T) {
+	testCases := []struct {
+		fileName string
+		format   string
+		fileType FileType
+	}{
+		{"voice-grxml-01.grxml", grxmlFileType, Voice},
+		{"voice-grxml-02.grxml", grxmlFileType, Voice},
+		{"dtmf-grxml-01.grxml", grxmlFileType, Dtmf},
+		{"dtmf-grxml-02.grxml", grxmlFileType, Dtmf},
+	}
+
+	for _, tc := range testCases {
+		ruleNames, errs := inspectGrammarFile(testGrammarDataPath(tc.fileName), tc.format, tc.fileType)
+		assert.Empty(t, errs, tc.fileName)
+		assert.NotEmpty(t, ruleNames, tc.fileName)
+	}
+}
+
+func TestUnitValidateGrammarLanguageConfig(t *testing.T) {
+	fileData := func(fileName, format string) cty.Value {
+		return cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+			"file_name": cty.StringVal(fileName),
+			"file_type": cty.StringVal(format),
+		})})
+	}
+	config := cty.ObjectVal(map[string]cty.Value{
+		// The test data gram files are not GSL grammars, so the parser reports problems the API does not
+		"voice_file_data": fileData(testGrammarDataPath("voice-gram-01.gram"), gslFileType),
+		"dtmf_file_data":  fileData(testGrammarDataPath("dtmf-grxml-01.grxml"), grxmlFileType),
+	})
+
+	resp := &schema.ValidateResourceConfigFuncResponse{}
+	validateGrammarLanguageConfig(context.Background(), schema.ValidateResourceConfigFuncRequest{RawConfig: config}, resp)
+	assert.False(t, resp.Diagnostics.HasError(), "grammar problems must not block the plan")
+	assert.NotEmpty(t, resp.Diagnostics)
+	for _, d := range resp.Diagnostics {
+		assert.Equal(t, diag.Warning, d.Severity)
+		assert.Equal(t, cty.GetAttrPath("voice_file_data").IndexInt(0).GetAttr("file_name"), d.AttributePath)
+	}
+}
+
+// testGrammarDataPath returns the path of a test data file, as testrunner.GetTestDataPath only resolves paths for acceptance tests
+func testGrammarDataPath(fileName string) string {
+	return filepath.Join("..", "..", "test", "data", "resource", ResourceType, fileName)
+}
@@ -55,6 +55,12 @@ func createIvrConfig(ctx context.Context, d *schema.ResourceData, meta interface
 	if ivrBody.Dnis != nil {
 		time.Sleep(3 * time.Second)
 	}
+	if diagErr := verifyIvrDnisNotClaimed(ctx, d, sdkConfig); diagErr != nil {
+		return diagErr
+	}
+	if diagErr := verifyIvrDnisInDidPools(ctx, d, sdkConfig); diagErr != nil {
+		return diagErr
+	}
 
 	log.Printf("Creating IVR config %s", *ivrBody.Name)
 	ivrConfig, resp, err := ap.createArchitectIvr(ctx, *ivrBody)
@@ -116,6 +122,15 @@ func updateIvrConfig(ctx context.Context, d *schema.ResourceData, meta interface
 	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
 	ap := getArchitectIvrProxy(sdkConfig)
 
+	if d.HasChanges("dnis", "did_pool_ids") {
+		if diagErr := verifyIvrDnisNotClaimed(ctx, d, sdkConfig); diagErr != nil {
+			return diagErr
+		}
+		if diagErr := verifyIvrDnisInDidPools(ctx, d, sdkConfig); diagErr != nil {
+			return diagErr
+		}
+	}
+
 	diagErr := util.RetryWhen(util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
 		// Get current version
 		ivr, resp, getErr := ap.getArchitectIvr(ctx, d.Id())
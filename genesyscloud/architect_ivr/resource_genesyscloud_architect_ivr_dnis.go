@@ -0,0 +1,303 @@
+package architect_ivr
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	didPool "terraform-provider-genesyscloud/genesyscloud/telephony_providers_edges_did_pool"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v154/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_architect_ivr_dnis.go file contains the validation of the dnis attribute.
+Numbers are normalised to E.164 and checked for duplicates within the IVR when the configuration is validated.
+The numbers added to an IVR are checked not to be assigned to another IVR read from the API during plan, and again before
+the IVR is created or updated so that IVRs of the same configuration claiming the same number are reported.
+During plan, the numbers added to an IVR are checked to fall within a DID pool read from the API: the DID pools
+referenced in did_pool_ids, or every DID pool in the org. When did_pool_ids references DID pools that do not
+exist yet, the check runs on apply once they have been created.
+*/
+
+// validateIvrDnisConfig reports the numbers of the dnis attribute that are the same number once formatted as E.164
+func validateIvrDnisConfig(_ context.Context, req schema.ValidateResourceConfigFuncRequest, resp *schema.ValidateResourceConfigFuncResponse) {
+	if req.RawConfig.IsNull() || !req.RawConfig.IsKnown() {
+		return
+	}
+	dnis := req.RawConfig.GetAttr("dnis")
+	if dnis.IsNull() || !dnis.IsKnown() {
+		return
+	}
+
+	var rawDnis []string
+	for it := dnis.ElementIterator(); it.Next(); {
+		_, number := it.Element()
+		if number.IsNull() || !number.IsKnown() {
+			continue
+		}
+		rawDnis = append(rawDnis, number.AsString())
+	}
+
+	_, duplicates := normaliseDnis(rawDnis)
+	duplicateDnis := make([]string, 0, len(duplicates))
+	for raw := range duplicates {
+		duplicateDnis = append(duplicateDnis, raw)
+	}
+	sort.Strings(duplicateDnis)
+	for _, raw := range duplicateDnis {
+		first := duplicates[raw]
+		resp.Diagnostics = append(resp.Diagnostics, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       fmt.Sprintf("%s and %s are the same number", first, raw),
+			Detail:        "Each number of dnis must be listed once.",
+			AttributePath: dnisPath(raw),
+		})
+	}
+}
+
+// customizeIvrDiff verifies that the numbers added to the dnis of the IVR are not assigned to another IVR and belong to a DID pool
+func customizeIvrDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("dnis") || !diff.NewValueKnown("did_pool_ids") {
+		// DID pools created in the same apply are verified once they exist
+		return nil
+	}
+	if !diff.HasChange("dnis") && !diff.HasChange("did_pool_ids") {
+		return nil
+	}
+	providerMeta, ok := meta.(*provider.ProviderMeta)
+	if !ok || providerMeta == nil {
+		return nil
+	}
+
+	oldDnis, newDnis := diff.GetChange("dnis")
+	numbers, _ := normaliseDnis(setToStrings(newDnis))
+	previousNumbers, _ := normaliseDnis(setToStrings(oldDnis))
+	addedNumbers := filterAddedDnis(numbers, previousNumbers)
+
+	if len(addedNumbers) > 0 {
+		claims, err := getDnisClaimedByOtherIvrs(ctx, providerMeta.ClientConfig, diff.Id(), addedNumbers)
+		if err != nil {
+			return fmt.Errorf("failed to verify dnis against the other IVRs: %s", err)
+		}
+		if len(claims) > 0 {
+			return dnisPath(claims[0].number.raw).NewErrorf("%s", formatDnisClaims(claims))
+		}
+	}
+
+	// Only numbers added by this plan are verified unless the DID pools changed, so unchanged IVRs do not call the API
+	if !diff.HasChange("did_pool_ids") {
+		numbers = addedNumbers
+	}
+	if len(numbers) == 0 {
+		return nil
+	}
+
+	didPoolIds := setToStrings(diff.Get("did_pool_ids"))
+	ranges, err := didPool.GetDidPoolRanges(ctx, providerMeta.ClientConfig, didPoolIds)
+	if err != nil {
+		return fmt.Errorf("failed to verify dnis against DID pools: %s", err)
+	}
+	outOfPool := getDnisOutsideDidPools(numbers, ranges)
+	if len(outOfPool) == 0 {
+		return nil
+	}
+
+	// CustomizeDiff can only report a single error, which is attached to the first number
+	rawNumbers := make([]string, 0, len(outOfPool))
+	for _, number := range outOfPool {
+		rawNumbers = append(rawNumbers, number.raw)
+	}
+	return dnisPath(outOfPool[0].raw).NewErrorf("%s %s", strings.Join(rawNumbers, ", "), didPoolMembershipError(didPoolIds))
+}
+
+// verifyIvrDnisInDidPools verifies that the numbers of the IVR belong to the DID pools referenced in did_pool_ids. It is
+// run before the IVR is created or updated, as the DID pools may not have existed when the IVR was planned.
+func verifyIvrDnisInDidPools(ctx context.Context, d *schema.ResourceData, clientConfig *platformclientv2.Configuration) diag.Diagnostics {
+	didPoolIds := setToStrings(d.Get("did_pool_ids"))
+	if len(didPoolIds) == 0 {
+		return nil
+	}
+	numbers, _ := normaliseDnis(setToStrings(d.Get("dnis")))
+	if len(numbers) == 0 {
+		return nil
+	}
+
+	ranges, err := didPool.GetDidPoolRanges(ctx, clientConfig, didPoolIds)
+	if err != nil {
+		return util.BuildDiagnosticError(ResourceType, "Failed to verify dnis against DID pools", err)
+	}
+
+	var diags diag.Diagnostics
+	for _, number := range getDnisOutsideDidPools(numbers, ranges) {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       fmt.Sprintf("%s %s", number.raw, didPoolMembershipError(didPoolIds)),
+			AttributePath: dnisPath(number.raw),
+		})
+	}
+	return diags
+}
+
+// verifyIvrDnisNotClaimed verifies that the numbers of the IVR are not assigned to another IVR. It is run before the IVR is
+// created or updated, as IVRs of the same configuration claiming the same number are only found once one of them exists.
+func verifyIvrDnisNotClaimed(ctx context.Context, d *schema.ResourceData, clientConfig *platformclientv2.Configuration) diag.Diagnostics {
+	numbers, _ := normaliseDnis(setToStrings(d.Get("dnis")))
+	if len(numbers) == 0 {
+		return nil
+	}
+
+	claims, err := getDnisClaimedByOtherIvrs(ctx, clientConfig, d.Id(), numbers)
+	if err != nil {
+		return util.BuildDiagnosticError(ResourceType, "Failed to verify dnis against the other IVRs", err)
+	}
+
+	var diags diag.Diagnostics
+	for _, claim := range claims {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       formatDnisClaims([]dnisClaim{claim}),
+			AttributePath: dnisPath(claim.number.raw),
+		})
+	}
+	return diags
+}
+
+// dnisClaim is a number of the dnis attribute that is already assigned to another IVR
+type dnisClaim struct {
+	number  dnisNumber
+	ivrName string
+}
+
+// getDnisClaimedByOtherIvrs returns the numbers that are assigned to an IVR other than the IVR with the given ID, which is
+// empty for an IVR that does not exist yet
+func getDnisClaimedByOtherIvrs(ctx context.Context, clientConfig *platformclientv2.Configuration, ivrId string, numbers []dnisNumber) ([]dnisClaim, error) {
+	ivrs, _, err := getArchitectIvrProxy(clientConfig).getAllArchitectIvrs(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	owners := make(map[string]string)
+	utilE164 := util.NewUtilE164Service()
+	for _, ivr := range *ivrs {
+		if ivr.Dnis == nil || (ivr.Id != nil && *ivr.Id == ivrId) {
+			continue
+		}
+		name := ""
+		if ivr.Name != nil {
+			name = *ivr.Name
+		}
+		for _, raw := range *ivr.Dnis {
+			if number, diagErr := utilE164.FormatAsValidE164Number(raw); diagErr == nil {
+				owners[number] = name
+			}
+		}
+	}
+
+	var claims []dnisClaim
+	for _, number := range numbers {
+		if owner, ok := owners[number.e164]; ok {
+			claims = append(claims, dnisClaim{number: number, ivrName: owner})
+		}
+	}
+	return claims, nil
+}
+
+func formatDnisClaims(claims []dnisClaim) string {
+	descriptions := make([]string, 0, len(claims))
+	for _, claim := range claims {
+		descriptions = append(descriptions, fmt.Sprintf("%s is already assigned to %s %q", claim.number.raw, ResourceType, claim.ivrName))
+	}
+	return strings.Join(descriptions, "; ")
+}
+
+// dnisNumber is a number of the dnis attribute formatted as E.164, along with the value in the configuration
+type dnisNumber struct {
+	e164 string
+	raw  string
+}
+
+func dnisPath(raw string) cty.Path {
+	return cty.GetAttrPath("dnis").Index(cty.StringVal(raw))
+}
+
+func didPoolMembershipError(didPoolIds []string) string {
+	if len(didPoolIds) > 0 {
+		return fmt.Sprintf("does not belong to any of the %s resources in did_pool_ids", didPool.ResourceType)
+	}
+	return fmt.Sprintf("does not belong to any %s in the org. Reference DID pools created in the same configuration in did_pool_ids.", didPool.ResourceType)
+}
+
+func setToStrings(value interface{}) []string {
+	set, _ := value.(*schema.Set)
+	if set == nil {
+		return nil
+	}
+	return lists.InterfaceListToStrings(set.List())
+}
+
+// normaliseDnis formats each number as E.164. Invalid numbers are left to the validation of the dnis elements. It also
+// returns the numbers that are the same as an earlier number once formatted, mapped to that earlier number.
+func normaliseDnis(rawDnis []string) ([]dnisNumber, map[string]string) {
+	var (
+		normalised []dnisNumber
+		duplicates = make(map[string]string)
+		seen       = make(map[string]string)
+	)
+	utilE164 := util.NewUtilE164Service()
+
+	sorted := append([]string{}, rawDnis...)
+	sort.Strings(sorted)
+	for _, raw := range sorted {
+		number, diagErr := utilE164.FormatAsValidE164Number(raw)
+		if diagErr != nil {
+			continue
+		}
+		if first, ok := seen[number]; ok {
+			duplicates[raw] = first
+			continue
+		}
+		seen[number] = raw
+		normalised = append(normalised, dnisNumber{e164: number, raw: raw})
+	}
+	return normalised, duplicates
+}
+
+// filterAddedDnis returns the numbers that are not in the previous numbers
+func filterAddedDnis(numbers, previousNumbers []dnisNumber) []dnisNumber {
+	previous := make(map[string]bool, len(previousNumbers))
+	for _, number := range previousNumbers {
+		previous[number.e164] = true
+	}
+	var added []dnisNumber
+	for _, number := range numbers {
+		if !previous[number.e164] {
+			added = append(added, number)
+		}
+	}
+	return added
+}
+
+// getDnisOutsideDidPools returns the numbers that do not fall within any of the DID pool ranges
+func getDnisOutsideDidPools(numbers []dnisNumber, ranges []didPool.DidPoolRange) []dnisNumber {
+	var outOfPool []dnisNumber
+	for _, number := range numbers {
+		found := false
+		for _, didPoolRange := range ranges {
+			if didPoolRange.Contains(number.e164) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			outOfPool = append(outOfPool, number)
+		}
+	}
+	return outOfPool
+}
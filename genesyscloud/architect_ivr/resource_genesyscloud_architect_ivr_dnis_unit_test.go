@@ -0,0 +1,87 @@
+package architect_ivr
+
+import (
+	"context"
+	didPool "terraform-provider-genesyscloud/genesyscloud/telephony_providers_edges_did_pool"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v154/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitNormaliseDnis(t *testing.T) {
+	normalised, duplicates := normaliseDnis([]string{"+1 (417) 555-0011", "+14175550011", "+14175550012", "not a number"})
+
+	assert.Equal(t, []dnisNumber{
+		{e164: "+14175550011", raw: "+1 (417) 555-0011"},
+		{e164: "+14175550012", raw: "+14175550012"},
+	}, normalised)
+	assert.Equal(t, map[string]string{"+14175550011": "+1 (417) 555-0011"}, duplicates)
+}
+
+func TestUnitValidateIvrDnisConfig(t *testing.T) {
+	config := cty.ObjectVal(map[string]cty.Value{
+		"dnis": cty.SetVal([]cty.Value{
+			cty.StringVal("+1 (417) 555-0011"),
+			cty.StringVal("+14175550011"),
+			cty.StringVal("+14175550012"),
+			cty.UnknownVal(cty.String),
+		}),
+	})
+
+	resp := &schema.ValidateResourceConfigFuncResponse{}
+	validateIvrDnisConfig(context.Background(), schema.ValidateResourceConfigFuncRequest{RawConfig: config}, resp)
+	if assert.Len(t, resp.Diagnostics, 1) {
+		assert.Equal(t, dnisPath("+14175550011"), resp.Diagnostics[0].AttributePath)
+	}
+
+	// Unknown dnis are validated once they are known
+	resp = &schema.ValidateResourceConfigFuncResponse{}
+	config = cty.ObjectVal(map[string]cty.Value{"dnis": cty.UnknownVal(cty.Set(cty.String))})
+	validateIvrDnisConfig(context.Background(), schema.ValidateResourceConfigFuncRequest{RawConfig: config}, resp)
+	assert.Empty(t, resp.Diagnostics)
+}
+
+func TestUnitGetDnisOutsideDidPools(t *testing.T) {
+	ranges := []didPool.DidPoolRange{
+		{Start: "+14175550010", End: "+14175550019"},
+		{Start: "+442071838750", End: "+442071838759"},
+	}
+
+	numbers, _ := normaliseDnis([]string{"+14175550010", "+14175550019", "+442071838755"})
+	assert.Empty(t, getDnisOutsideDidPools(numbers, ranges))
+
+	numbers, _ = normaliseDnis([]string{"+1 417 555 0020", "+442071838760"})
+	assert.Equal(t, []dnisNumber{
+		{e164: "+14175550020", raw: "+1 417 555 0020"},
+		{e164: "+442071838760", raw: "+442071838760"},
+	}, getDnisOutsideDidPools(numbers, ranges))
+}
+
+func TestUnitFilterAddedDnis(t *testing.T) {
+	previous, _ := normaliseDnis([]string{"+14175550010", "+14175550011"})
+	numbers, _ := normaliseDnis([]string{"+1 417 555 0011", "+14175550012"})
+
+	assert.Equal(t, []dnisNumber{{e164: "+14175550012", raw: "+14175550012"}}, filterAddedDnis(numbers, previous))
+}
+
+func TestUnitGetDnisClaimedByOtherIvrs(t *testing.T) {
+	internalProxyCopy := internalProxy
+	defer func() { internalProxy = internalProxyCopy }()
+	internalProxy = &architectIvrProxy{
+		getAllArchitectIvrsAttr: func(_ context.Context, _ *architectIvrProxy, _ string) (*[]platformclientv2.Ivr, *platformclientv2.APIResponse, error) {
+			return &[]platformclientv2.Ivr{
+				{Id: platformclientv2.String("ivr-1"), Name: platformclientv2.String("Main"), Dnis: &[]string{"+14175550010", "+14175550011"}},
+				{Id: platformclientv2.String("ivr-2"), Name: platformclientv2.String("Support"), Dnis: &[]string{"+14175550012"}},
+			}, nil, nil
+		},
+	}
+
+	numbers, _ := normaliseDnis([]string{"+1 417 555 0010", "+14175550012", "+14175550013"})
+	claims, err := getDnisClaimedByOtherIvrs(context.Background(), &platformclientv2.Configuration{}, "ivr-2", numbers)
+	assert.NoError(t, err)
+	assert.Equal(t, []dnisClaim{{number: dnisNumber{e164: "+14175550010", raw: "+1 417 555 0010"}, ivrName: "Main"}}, claims, "the numbers of the IVR itself are not claims")
+	assert.Equal(t, `+1 417 555 0010 is already assigned to genesyscloud_architect_ivr "Main"`, formatDnisClaims(claims))
+}
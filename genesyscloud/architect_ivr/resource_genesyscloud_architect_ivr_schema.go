@@ -62,12 +62,18 @@ func ResourceArchitectIvrConfig() *schema.Resource {
 				Optional:    true,
 			},
 			"dnis": {
-				Description: fmt.Sprintf("The phone number(s) to contact the IVR by. Each phone number in the array must be in an E.164 number format. (Note: An array with a length greater than %v will be broken into chunks and uploaded in subsequent PUT requests.)", maxDnisPerRequest),
+				Description: fmt.Sprintf("The phone number(s) to contact the IVR by. Each phone number in the array must be in an E.164 number format. (Note: An array with a length greater than %v will be broken into chunks and uploaded in subsequent PUT requests.) Each number must be listed once, must not be assigned to another IVR and, during plan, must belong to a DID pool of `did_pool_ids` or, when it is not set, to a DID pool in the org.", maxDnisPerRequest),
 				Type:        schema.TypeSet,
 				Optional:    true,
 				Computed:    true,
 				Elem:        &schema.Schema{Type: schema.TypeString, ValidateDiagFunc: validators.ValidatePhoneNumber},
 			},
+			"did_pool_ids": {
+				Description: "IDs of the DID pools the numbers of `dnis` belong to. The numbers are verified against these DID pools instead of every DID pool in the org. Reference the DID pools created in the same configuration here, so that the numbers are verified on apply once the DID pools exist.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 			"open_hours_flow_id": {
 				Description: "ID of inbound call flow for open hours.",
 				Type:        schema.TypeString,
@@ -95,6 +101,8 @@ func ResourceArchitectIvrConfig() *schema.Resource {
 				Computed:    true,
 			},
 		},
+		CustomizeDiff:                  customizeIvrDiff,
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{validateIvrDnisConfig},
 	}
 }
 
@@ -229,6 +229,11 @@ func TestUnitResourceArchitectCreate(t *testing.T) {
 		return ivr, apiResponse, nil
 	}
 
+	archProxy.getAllArchitectIvrsAttr = func(ctx context.Context, a *architectIvrProxy, name string) (*[]platformclientv2.Ivr, *platformclientv2.APIResponse, error) {
+		// The numbers of the IVR itself are not assigned to another IVR
+		return &[]platformclientv2.Ivr{{Id: &tId, Name: &tName, Dnis: &tDnis}}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+
 	archProxy.createArchitectIvrAttr = func(ctx context.Context, a *architectIvrProxy, ivr platformclientv2.Ivr) (*platformclientv2.Ivr, *platformclientv2.APIResponse, error) {
 		assert.Equal(t, tName, *ivr.Name, "ivr.Name check failed in create createArchitectIvrAttr")
 		assert.Equal(t, tDescription, *ivr.Description, "ivr.Description check failed in create createArchitectIvrAttr")
@@ -300,6 +305,11 @@ func TestUnitResourceArchitectUpdate(t *testing.T) {
 		return ivr, apiResponse, nil
 	}
 
+	archProxy.getAllArchitectIvrsAttr = func(ctx context.Context, a *architectIvrProxy, name string) (*[]platformclientv2.Ivr, *platformclientv2.APIResponse, error) {
+		// The numbers of the IVR itself are not assigned to another IVR
+		return &[]platformclientv2.Ivr{{Id: &tId, Name: &tName, Dnis: &tDnis}}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+
 	archProxy.updateArchitectIvrAttr = func(ctx context.Context, a *architectIvrProxy, id string, ivr platformclientv2.Ivr) (*platformclientv2.Ivr, *platformclientv2.APIResponse, error) {
 		assert.Equal(t, tName, *ivr.Name, "ivr.Name check failed in create updateArchitectIvrAttr")
 		assert.Equal(t, tDescription, *ivr.Description, "ivr.Description check failed in updateArchitectIvrAttr")
@@ -0,0 +1,222 @@
+package architect_schedulegroups
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/rrule"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v154/platformclientv2"
+)
+
+/*
+   The data_source_genesyscloud_architect_schedulegroups_calendar.go contains the data source implementation
+   for simulating the open/closed/holiday state of a schedule group over a time range.
+*/
+
+const calendarTimeFormat = "2006-01-02T15:04:05"
+
+const (
+	calendarStateOpen    = "open"
+	calendarStateClosed  = "closed"
+	calendarStateHoliday = "holiday"
+)
+
+// statePrecedence orders states so that holidays override closed schedules and closed schedules override open ones
+var statePrecedence = map[string]int{
+	calendarStateOpen:    1,
+	calendarStateClosed:  2,
+	calendarStateHoliday: 3,
+}
+
+// calendarSchedule is a schedule of a group reduced to the fields needed for the simulation
+type calendarSchedule struct {
+	id    string
+	state string
+	start time.Time
+	end   time.Time
+	rrule string
+}
+
+// calendarInterval is a contiguous period during which the schedule group has a single state
+type calendarInterval struct {
+	state      string
+	start      time.Time
+	end        time.Time
+	scheduleId string
+}
+
+// dataSourceArchitectSchedulegroupsCalendarRead expands the schedules of a schedule group between start and end
+func dataSourceArchitectSchedulegroupsCalendarRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getArchitectSchedulegroupsProxy(sdkConfig)
+
+	scheduleGroupId := d.Get("schedule_group_id").(string)
+
+	scheduleGroup, proxyResponse, err := proxy.getArchitectSchedulegroupsById(ctx, scheduleGroupId)
+	if err != nil {
+		return util.BuildAPIDiagnosticError(CalendarDataSourceType, fmt.Sprintf("failed to read schedule group %s | error: %s", scheduleGroupId, err), proxyResponse)
+	}
+
+	timeZone := d.Get("time_zone").(string)
+	if timeZone == "" && scheduleGroup.TimeZone != nil {
+		timeZone = *scheduleGroup.TimeZone
+	}
+	if timeZone == "" {
+		timeZone = "UTC"
+	}
+	loc, err := time.LoadLocation(timeZone)
+	if err != nil {
+		return util.BuildDiagnosticError(CalendarDataSourceType, fmt.Sprintf("invalid time zone %s", timeZone), err)
+	}
+
+	from, _ := time.ParseInLocation(calendarTimeFormat, d.Get("start").(string), loc)
+	to, _ := time.ParseInLocation(calendarTimeFormat, d.Get("end").(string), loc)
+	if !from.Before(to) {
+		return util.BuildDiagnosticError(CalendarDataSourceType, "invalid range", fmt.Errorf("start %s must be before end %s", d.Get("start").(string), d.Get("end").(string)))
+	}
+
+	refsByState := map[string]*[]platformclientv2.Domainentityref{
+		calendarStateOpen:    scheduleGroup.OpenSchedules,
+		calendarStateClosed:  scheduleGroup.ClosedSchedules,
+		calendarStateHoliday: scheduleGroup.HolidaySchedules,
+	}
+
+	var schedules []calendarSchedule
+	for state, refs := range refsByState {
+		if refs == nil {
+			continue
+		}
+		for _, ref := range *refs {
+			if ref.Id == nil {
+				continue
+			}
+			schedule, proxyResponse, err := proxy.getArchitectScheduleById(ctx, *ref.Id)
+			if err != nil {
+				return util.BuildAPIDiagnosticError(CalendarDataSourceType, fmt.Sprintf("failed to read schedule %s of schedule group %s | error: %s", *ref.Id, scheduleGroupId, err), proxyResponse)
+			}
+			if schedule.Start == nil || schedule.End == nil {
+				continue
+			}
+			calSchedule := calendarSchedule{
+				id:    *ref.Id,
+				state: state,
+				start: *schedule.Start,
+				end:   *schedule.End,
+			}
+			if schedule.Rrule != nil {
+				calSchedule.rrule = *schedule.Rrule
+			}
+			schedules = append(schedules, calSchedule)
+		}
+	}
+
+	intervals, err := buildScheduleGroupCalendar(schedules, from, to)
+	if err != nil {
+		return util.BuildDiagnosticError(CalendarDataSourceType, fmt.Sprintf("failed to simulate schedule group %s", scheduleGroupId), err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s/%s", scheduleGroupId, from.Format(calendarTimeFormat), to.Format(calendarTimeFormat), timeZone))
+	_ = d.Set("time_zone", timeZone)
+	_ = d.Set("intervals", flattenCalendarIntervals(intervals))
+	return nil
+}
+
+// buildScheduleGroupCalendar expands the schedules in the location of from and merges them into contiguous intervals covering [from, to)
+func buildScheduleGroupCalendar(schedules []calendarSchedule, from, to time.Time) ([]calendarInterval, error) {
+	loc := from.Location()
+
+	type span struct {
+		state      string
+		scheduleId string
+		start      time.Time
+		end        time.Time
+	}
+	var spans []span
+	boundaries := []time.Time{from, to}
+
+	for _, schedule := range schedules {
+		rule, err := rrule.Parse(schedule.rrule)
+		if err != nil {
+			return nil, fmt.Errorf("schedule %s has an invalid rrule: %s", schedule.id, err)
+		}
+		// Schedule times carry no time zone, so their wall clock is read in the evaluated location
+		start := time.Date(schedule.start.Year(), schedule.start.Month(), schedule.start.Day(),
+			schedule.start.Hour(), schedule.start.Minute(), schedule.start.Second(), 0, loc)
+		end := time.Date(schedule.end.Year(), schedule.end.Month(), schedule.end.Day(),
+			schedule.end.Hour(), schedule.end.Minute(), schedule.end.Second(), 0, loc)
+		if !end.After(start) {
+			continue
+		}
+
+		for _, occurrence := range rrule.Expand(rule, start, end.Sub(start), from, to) {
+			occStart, occEnd := occurrence.Start, occurrence.End
+			if occStart.Before(from) {
+				occStart = from
+			}
+			if occEnd.After(to) {
+				occEnd = to
+			}
+			spans = append(spans, span{state: schedule.state, scheduleId: schedule.id, start: occStart, end: occEnd})
+			boundaries = append(boundaries, occStart, occEnd)
+		}
+	}
+
+	sort.Slice(boundaries, func(i, j int) bool { return boundaries[i].Before(boundaries[j]) })
+
+	var intervals []calendarInterval
+	for i := 0; i < len(boundaries)-1; i++ {
+		segStart, segEnd := boundaries[i], boundaries[i+1]
+		if !segStart.Before(segEnd) {
+			continue
+		}
+
+		state, scheduleId := calendarStateClosed, ""
+		for _, s := range spans {
+			if s.start.After(segStart) || !s.end.After(segStart) {
+				continue
+			}
+			if scheduleId == "" || statePrecedence[s.state] > statePrecedence[state] ||
+				(s.state == state && s.scheduleId < scheduleId) {
+				state, scheduleId = s.state, s.scheduleId
+			}
+		}
+
+		if n := len(intervals); n > 0 && intervals[n-1].state == state && intervals[n-1].scheduleId == scheduleId {
+			intervals[n-1].end = segEnd
+			continue
+		}
+		intervals = append(intervals, calendarInterval{state: state, start: segStart, end: segEnd, scheduleId: scheduleId})
+	}
+	return intervals, nil
+}
+
+func flattenCalendarIntervals(intervals []calendarInterval) []interface{} {
+	flattened := make([]interface{}, 0, len(intervals))
+	for _, interval := range intervals {
+		flattened = append(flattened, map[string]interface{}{
+			"state":       interval.state,
+			"start":       interval.start.Format(calendarTimeFormat),
+			"end":         interval.end.Format(calendarTimeFormat),
+			"schedule_id": interval.scheduleId,
+		})
+	}
+	return flattened
+}
+
+// validateCalendarDateTime validates a date time in the yyyy-MM-ddTHH:mm:ss format
+func validateCalendarDateTime(date interface{}, _ cty.Path) diag.Diagnostics {
+	if dateStr, ok := date.(string); ok {
+		if _, err := time.Parse(calendarTimeFormat, dateStr); err != nil {
+			return diag.Errorf("Failed to parse date time %s: %s", dateStr, err)
+		}
+		return nil
+	}
+	return diag.Errorf("Date time %v is not a string", date)
+}
@@ -0,0 +1,80 @@
+package architect_schedulegroups
+
+import (
+	"testing"
+	"time"
+)
+
+func TestUnitBuildScheduleGroupCalendar(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("failed to load location: %s", err)
+	}
+
+	schedules := []calendarSchedule{
+		{
+			id:    "open-weekdays",
+			state: calendarStateOpen,
+			start: time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC),
+			end:   time.Date(2024, 1, 1, 17, 0, 0, 0, time.UTC),
+			rrule: "FREQ=WEEKLY;INTERVAL=1;BYDAY=MO,TU,WE,TH,FR",
+		},
+		{
+			id:    "closed-lunch",
+			state: calendarStateClosed,
+			start: time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+			end:   time.Date(2024, 1, 1, 13, 0, 0, 0, time.UTC),
+			rrule: "FREQ=DAILY",
+		},
+		{
+			id:    "christmas",
+			state: calendarStateHoliday,
+			start: time.Date(2020, 12, 25, 0, 0, 0, 0, time.UTC),
+			end:   time.Date(2020, 12, 26, 0, 0, 0, 0, time.UTC),
+			rrule: "FREQ=YEARLY;INTERVAL=1;BYMONTH=12;BYMONTHDAY=25",
+		},
+	}
+
+	from := time.Date(2024, 12, 24, 0, 0, 0, 0, loc)
+	to := time.Date(2024, 12, 26, 0, 0, 0, 0, loc)
+
+	intervals, err := buildScheduleGroupCalendar(schedules, from, to)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []calendarInterval{
+		{state: calendarStateClosed, start: time.Date(2024, 12, 24, 0, 0, 0, 0, loc), end: time.Date(2024, 12, 24, 9, 0, 0, 0, loc)},
+		{state: calendarStateOpen, start: time.Date(2024, 12, 24, 9, 0, 0, 0, loc), end: time.Date(2024, 12, 24, 12, 0, 0, 0, loc), scheduleId: "open-weekdays"},
+		{state: calendarStateClosed, start: time.Date(2024, 12, 24, 12, 0, 0, 0, loc), end: time.Date(2024, 12, 24, 13, 0, 0, 0, loc), scheduleId: "closed-lunch"},
+		{state: calendarStateOpen, start: time.Date(2024, 12, 24, 13, 0, 0, 0, loc), end: time.Date(2024, 12, 24, 17, 0, 0, 0, loc), scheduleId: "open-weekdays"},
+		{state: calendarStateClosed, start: time.Date(2024, 12, 24, 17, 0, 0, 0, loc), end: time.Date(2024, 12, 25, 0, 0, 0, 0, loc)},
+		{state: calendarStateHoliday, start: time.Date(2024, 12, 25, 0, 0, 0, 0, loc), end: time.Date(2024, 12, 26, 0, 0, 0, 0, loc), scheduleId: "christmas"},
+	}
+
+	if len(intervals) != len(expected) {
+		t.Fatalf("expected %d intervals, got %d: %v", len(expected), len(intervals), intervals)
+	}
+	for i, interval := range intervals {
+		want := expected[i]
+		if interval.state != want.state || interval.scheduleId != want.scheduleId || !interval.start.Equal(want.start) || !interval.end.Equal(want.end) {
+			t.Errorf("interval %d: expected %+v, got %+v", i, want, interval)
+		}
+	}
+}
+
+func TestUnitBuildScheduleGroupCalendarInvalidRrule(t *testing.T) {
+	schedules := []calendarSchedule{
+		{
+			id:    "invalid",
+			state: calendarStateOpen,
+			start: time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC),
+			end:   time.Date(2024, 1, 1, 17, 0, 0, 0, time.UTC),
+			rrule: "FREQ=HOURLY",
+		},
+	}
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	if _, err := buildScheduleGroupCalendar(schedules, from, from.AddDate(0, 0, 1)); err == nil {
+		t.Errorf("expected an error for an unsupported rrule")
+	}
+}
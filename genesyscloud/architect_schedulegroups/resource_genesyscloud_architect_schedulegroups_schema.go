@@ -17,11 +17,13 @@ resource_genesycloud_architect_schedulegroups_schema.go holds four functions wit
 4.  The resource exporter configuration for the architect_schedulegroups exporter.
 */
 const ResourceType = "genesyscloud_architect_schedulegroups"
+const CalendarDataSourceType = "genesyscloud_architect_schedulegroups_calendar"
 
 // SetRegistrar registers all of the resources, datasources and exporters in the package
 func SetRegistrar(regInstance registrar.Registrar) {
 	regInstance.RegisterResource(ResourceType, ResourceArchitectSchedulegroups())
 	regInstance.RegisterDataSource(ResourceType, DataSourceArchitectSchedulegroups())
+	regInstance.RegisterDataSource(CalendarDataSourceType, DataSourceArchitectSchedulegroupsCalendar())
 	regInstance.RegisterExporter(ResourceType, ArchitectSchedulegroupsExporter())
 }
 
@@ -112,3 +114,69 @@ func DataSourceArchitectSchedulegroups() *schema.Resource {
 		},
 	}
 }
+
+var calendarIntervalResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"state": {
+			Description: "State of the schedule group during the interval. Valid values: open, closed, holiday.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"start": {
+			Description: "Start of the interval in the evaluated time zone. Format: yyyy-MM-ddTHH:mm:ss",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"end": {
+			Description: "End of the interval (exclusive) in the evaluated time zone. Format: yyyy-MM-ddTHH:mm:ss",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"schedule_id": {
+			Description: "The schedule that determined the state. Empty when the group is closed because no schedule applies.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	},
+}
+
+// DataSourceArchitectSchedulegroupsCalendar registers the genesyscloud_architect_schedulegroups_calendar data source
+func DataSourceArchitectSchedulegroupsCalendar() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for simulating the calendar of a Genesys Cloud Schedule Group. The open, closed and holiday schedules of the group are expanded locally " +
+			"and the resulting intervals between start and end are returned. Holiday schedules take precedence over closed schedules, which take precedence over open schedules. " +
+			"Times not covered by any schedule are reported as closed.",
+		ReadContext: provider.ReadWithPooledClient(dataSourceArchitectSchedulegroupsCalendarRead),
+		Schema: map[string]*schema.Schema{
+			"schedule_group_id": {
+				Description: "ID of the schedule group to evaluate.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"start": {
+				Description:      "Start of the evaluated range in the evaluated time zone. Format: yyyy-MM-ddTHH:mm:ss",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateCalendarDateTime,
+			},
+			"end": {
+				Description:      "End of the evaluated range (exclusive) in the evaluated time zone. Format: yyyy-MM-ddTHH:mm:ss",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateCalendarDateTime,
+			},
+			"time_zone": {
+				Description: "IANA time zone the schedules are evaluated in, e.g. America/New_York. Defaults to the time zone of the schedule group, or UTC if the group has none.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"intervals": {
+				Description: "Contiguous intervals covering the evaluated range, in chronological order.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        calendarIntervalResource,
+			},
+		},
+	}
+}
@@ -107,6 +107,19 @@ func ResourceArchitectUserPrompt() *schema.Resource {
 				ConfigMode:  schema.SchemaConfigModeAttr,
 				Elem:        userPromptResource,
 			},
+			"require_audio_or_tts": {
+				Description: "If true, the plan fails when a resource has neither a `tts_string` nor a readable audio `filename`. Local .wav files encoded in PCM (8, 16 or 24 bit), A-law or mu-law, including WAVE_FORMAT_EXTENSIBLE files, are always validated to have a supported sample rate and duration. Other WAV codecs are left for the API to validate.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"audio_durations": {
+				Description: "Duration in seconds of the local .wav file of each resource, keyed by language. Files in WAV codecs that are not validated locally have no duration.",
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeFloat},
+			},
 		},
+		CustomizeDiff: customizeUserPromptDiff,
 	}
 }
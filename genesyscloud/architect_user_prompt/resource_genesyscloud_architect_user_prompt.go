@@ -109,6 +109,7 @@ func readUserPrompt(ctx context.Context, d *schema.ResourceData, meta interface{
 		resourcedata.SetNillableValue(d, "name", userPrompt.Name)
 		resourcedata.SetNillableValue(d, "description", userPrompt.Description)
 		_ = d.Set("resources", flattenPromptResources(d, userPrompt.Resources))
+		_ = d.Set("audio_durations", flattenAudioDurations(d))
 
 		log.Printf("Read Audio Prompt %s %s", d.Id(), *userPrompt.Id)
 		return cc.CheckState(d)
@@ -0,0 +1,302 @@
+package architect_user_prompt
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/util/architectlanguages"
+	"terraform-provider-genesyscloud/genesyscloud/util/lists"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+The resource_genesyscloud_architect_user_prompt_audio.go file contains the local validation of the audio files
+uploaded as user prompt resources. Only local files with a .wav extension are inspected; other formats, WAV codecs that
+are not known here and URLs are left for the API to validate.
+*/
+
+const (
+	wavFormatPCM   = 1
+	wavFormatALaw  = 6
+	wavFormatMuLaw = 7
+
+	// wavFormatExtensible WAV files hold their codec in the sub-format GUID of the fmt chunk extension
+	wavFormatExtensible = 0xFFFE
+
+	maxPromptAudioDuration = 10 * time.Minute
+)
+
+var supportedWavFormats = map[uint16]string{
+	wavFormatPCM:   "PCM",
+	wavFormatALaw:  "A-law",
+	wavFormatMuLaw: "mu-law",
+}
+
+var supportedWavSampleRates = []uint32{8000, 11025, 16000, 22050, 32000, 44100, 48000}
+
+var supportedPCMBitDepths = []uint16{8, 16, 24}
+
+// wavSubFormatGUIDSuffix is the part of the KSDATAFORMAT_SUBTYPE GUIDs that follows the codec in an extensible fmt chunk
+var wavSubFormatGUIDSuffix = []byte{0x00, 0x00, 0x00, 0x00, 0x10, 0x00, 0x80, 0x00, 0x00, 0xAA, 0x00, 0x38, 0x9B, 0x71}
+
+// errUnknownWavCodec is returned for WAV codecs that are not validated locally
+var errUnknownWavCodec = errors.New("unknown WAV codec")
+
+// wavInfo holds the properties of a WAV file read from its header
+type wavInfo struct {
+	audioFormat   uint16
+	channels      uint16
+	sampleRate    uint32
+	byteRate      uint32
+	bitsPerSample uint16
+	dataSize      uint32
+}
+
+func (w *wavInfo) duration() time.Duration {
+	if w.byteRate == 0 {
+		return 0
+	}
+	return time.Duration(float64(w.dataSize) / float64(w.byteRate) * float64(time.Second))
+}
+
+// readWavInfo reads the RIFF header of a WAV file along with its fmt and data chunk headers
+func readWavInfo(r io.Reader) (*wavInfo, error) {
+	var riffHeader [12]byte
+	if _, err := io.ReadFull(r, riffHeader[:]); err != nil {
+		return nil, fmt.Errorf("file is too short to be a WAV file")
+	}
+	if string(riffHeader[0:4]) != "RIFF" || string(riffHeader[8:12]) != "WAVE" {
+		return nil, fmt.Errorf("file is not a RIFF/WAVE file")
+	}
+
+	var (
+		info     wavInfo
+		foundFmt bool
+	)
+	for {
+		var chunkHeader [8]byte
+		if _, err := io.ReadFull(r, chunkHeader[:]); err != nil {
+			if !foundFmt {
+				return nil, fmt.Errorf("WAV file has no fmt chunk")
+			}
+			return nil, fmt.Errorf("WAV file has no data chunk")
+		}
+		chunkId := string(chunkHeader[0:4])
+		chunkSize := binary.LittleEndian.Uint32(chunkHeader[4:8])
+
+		switch chunkId {
+		case "fmt ":
+			if chunkSize < 16 {
+				return nil, fmt.Errorf("WAV fmt chunk is too short")
+			}
+			fmtChunk := make([]byte, chunkSize)
+			if _, err := io.ReadFull(r, fmtChunk); err != nil {
+				return nil, fmt.Errorf("WAV fmt chunk is truncated")
+			}
+			info.audioFormat = binary.LittleEndian.Uint16(fmtChunk[0:2])
+			info.channels = binary.LittleEndian.Uint16(fmtChunk[2:4])
+			info.sampleRate = binary.LittleEndian.Uint32(fmtChunk[4:8])
+			info.byteRate = binary.LittleEndian.Uint32(fmtChunk[8:12])
+			info.bitsPerSample = binary.LittleEndian.Uint16(fmtChunk[14:16])
+			if info.audioFormat == wavFormatExtensible {
+				if chunkSize < 40 {
+					return nil, fmt.Errorf("WAV extensible fmt chunk is too short")
+				}
+				// Sub-formats that are not a codec GUID keep the extensible format and are left for the API
+				if bytes.Equal(fmtChunk[26:40], wavSubFormatGUIDSuffix) {
+					info.audioFormat = binary.LittleEndian.Uint16(fmtChunk[24:26])
+				}
+			}
+			foundFmt = true
+		case "data":
+			if !foundFmt {
+				return nil, fmt.Errorf("WAV data chunk appears before the fmt chunk")
+			}
+			info.dataSize = chunkSize
+			return &info, nil
+		default:
+			if _, err := io.CopyN(io.Discard, r, int64(chunkSize)); err != nil {
+				return nil, fmt.Errorf("WAV %q chunk is truncated", chunkId)
+			}
+		}
+		// Chunks are word aligned
+		if chunkSize%2 == 1 {
+			if _, err := io.CopyN(io.Discard, r, 1); err != nil {
+				return nil, fmt.Errorf("WAV file is truncated")
+			}
+		}
+	}
+}
+
+// validateWavInfo checks the codec, sample rate and duration of a WAV file against what Genesys Cloud accepts for prompts
+func validateWavInfo(info *wavInfo) error {
+	codec, ok := supportedWavFormats[info.audioFormat]
+	if !ok {
+		return fmt.Errorf("%w %d", errUnknownWavCodec, info.audioFormat)
+	}
+	if info.channels != 1 && info.channels != 2 {
+		return fmt.Errorf("unsupported channel count %d", info.channels)
+	}
+	if !lists.ItemInSlice(info.sampleRate, supportedWavSampleRates) {
+		return fmt.Errorf("unsupported sample rate %d Hz. Supported sample rates are %v", info.sampleRate, supportedWavSampleRates)
+	}
+	if info.audioFormat == wavFormatPCM && !lists.ItemInSlice(info.bitsPerSample, supportedPCMBitDepths) {
+		return fmt.Errorf("unsupported PCM bit depth %d. Supported bit depths are %v", info.bitsPerSample, supportedPCMBitDepths)
+	}
+	if info.audioFormat != wavFormatPCM && info.bitsPerSample != 8 {
+		return fmt.Errorf("unsupported %s bit depth %d. Expected 8", codec, info.bitsPerSample)
+	}
+	if info.dataSize == 0 {
+		return fmt.Errorf("WAV file contains no audio")
+	}
+	if duration := info.duration(); duration > maxPromptAudioDuration {
+		return fmt.Errorf("audio duration %s exceeds the maximum of %s", duration.Round(time.Second), maxPromptAudioDuration)
+	}
+	return nil
+}
+
+// isRemotePromptFile returns true if the filename is a URL rather than a local path
+func isRemotePromptFile(filename string) bool {
+	u, err := url.ParseRequestURI(filename)
+	return err == nil && u.Scheme != ""
+}
+
+// isWavPromptFile returns true if the local filename has a .wav extension
+func isWavPromptFile(filename string) bool {
+	return strings.EqualFold(filepath.Ext(filename), ".wav")
+}
+
+// inspectPromptAudioFile opens and validates a local prompt audio file. It returns os.ErrNotExist when the file cannot be found.
+func inspectPromptAudioFile(filename string) (*wavInfo, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	info, err := readWavInfo(file)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateWavInfo(info); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+// customizeUserPromptDiff validates the prompt resources locally and computes the audio durations during plan
+func customizeUserPromptDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if !diff.NewValueKnown("resources") {
+		_ = diff.SetNewComputed("audio_durations")
+		return nil
+	}
+
+	resources, _ := diff.Get("resources").(*schema.Set)
+	if resources == nil {
+		return nil
+	}
+	requireAudioOrTts, _ := diff.Get("require_audio_or_tts").(bool)
+
+	var errs []error
+	durations := make(map[string]interface{})
+	for _, r := range resources.List() {
+		resourceMap, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		// The language is optional and is empty in the plan until it is known
+		language, _ := resourceMap["language"].(string)
+		if language != "" && !lists.ItemInSlice(language, architectlanguages.Languages) {
+			errs = append(errs, fmt.Errorf("resource language '%s' is not a valid architect language code", language))
+			continue
+		}
+
+		filename, _ := resourceMap["filename"].(string)
+		ttsString, _ := resourceMap["tts_string"].(string)
+
+		readable := false
+		if filename != "" && isRemotePromptFile(filename) {
+			readable = true
+		} else if filename != "" && !isWavPromptFile(filename) {
+			// Other audio formats are validated by the API on upload
+			_, err := os.Stat(filename)
+			readable = err == nil
+		} else if filename != "" {
+			info, err := inspectPromptAudioFile(filename)
+			switch {
+			case err == nil:
+				readable = true
+				if language != "" {
+					durations[language] = info.duration().Seconds()
+				}
+			case errors.Is(err, os.ErrNotExist):
+				// The file may be created later in the apply. Only fail below if it is required.
+			case errors.Is(err, errUnknownWavCodec):
+				// The API validates codecs that are not known here, so the file is uploaded without a duration
+				log.Printf("[WARN] Audio file '%s' for language '%s' has an %s. It is left for the API to validate and its duration is not computed", filename, language, err)
+				readable = true
+			default:
+				errs = append(errs, fmt.Errorf("invalid audio file '%s' for language '%s': %s", filename, language, err))
+				continue
+			}
+		}
+
+		if requireAudioOrTts && !readable && ttsString == "" {
+			errs = append(errs, fmt.Errorf("resource for language '%s' has neither a tts_string nor a readable audio file", language))
+		}
+	}
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	if old, _ := diff.Get("audio_durations").(map[string]interface{}); !audioDurationsEqual(old, durations) {
+		return diff.SetNew("audio_durations", durations)
+	}
+	return nil
+}
+
+// flattenAudioDurations computes the durations of the local audio files referenced by the resources in the configuration
+func flattenAudioDurations(d *schema.ResourceData) map[string]interface{} {
+	durations := make(map[string]interface{})
+	resources, _ := d.Get("resources").(*schema.Set)
+	if resources == nil {
+		return durations
+	}
+	for _, r := range resources.List() {
+		resourceMap, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		language, _ := resourceMap["language"].(string)
+		filename, _ := resourceMap["filename"].(string)
+		if language == "" || filename == "" || isRemotePromptFile(filename) || !isWavPromptFile(filename) {
+			continue
+		}
+		if info, err := inspectPromptAudioFile(filename); err == nil {
+			durations[language] = info.duration().Seconds()
+		}
+	}
+	return durations
+}
+
+func audioDurationsEqual(a, b map[string]interface{}) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if other, ok := b[k]; !ok || other != v {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,195 @@
+package architect_user_prompt
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// buildTestWav builds an in-memory WAV file with a silent data chunk of the given size
+func buildTestWav(audioFormat, channels uint16, sampleRate uint32, bitsPerSample uint16, dataSize uint32) []byte {
+	blockAlign := channels * bitsPerSample / 8
+	byteRate := sampleRate * uint32(blockAlign)
+
+	buf := new(bytes.Buffer)
+	buf.WriteString("RIFF")
+	_ = binary.Write(buf, binary.LittleEndian, uint32(36+dataSize))
+	buf.WriteString("WAVE")
+	buf.WriteString("fmt ")
+	_ = binary.Write(buf, binary.LittleEndian, uint32(16))
+	_ = binary.Write(buf, binary.LittleEndian, audioFormat)
+	_ = binary.Write(buf, binary.LittleEndian, channels)
+	_ = binary.Write(buf, binary.LittleEndian, sampleRate)
+	_ = binary.Write(buf, binary.LittleEndian, byteRate)
+	_ = binary.Write(buf, binary.LittleEndian, blockAlign)
+	_ = binary.Write(buf, binary.LittleEndian, bitsPerSample)
+	buf.WriteString("LIST")
+	_ = binary.Write(buf, binary.LittleEndian, uint32(3))
+	buf.Write([]byte{0, 0, 0, 0})
+	buf.WriteString("data")
+	_ = binary.Write(buf, binary.LittleEndian, dataSize)
+	return buf.Bytes()
+}
+
+// buildTestExtensibleWav builds an in-memory WAVE_FORMAT_EXTENSIBLE file whose sub-format GUID starts with the given codec
+func buildTestExtensibleWav(subFormat uint16, guidSuffix []byte, sampleRate uint32, bitsPerSample uint16, dataSize uint32) []byte {
+	blockAlign := bitsPerSample / 8
+	byteRate := sampleRate * uint32(blockAlign)
+
+	buf := new(bytes.Buffer)
+	buf.WriteString("RIFF")
+	_ = binary.Write(buf, binary.LittleEndian, uint32(60+dataSize))
+	buf.WriteString("WAVE")
+	buf.WriteString("fmt ")
+	_ = binary.Write(buf, binary.LittleEndian, uint32(40))
+	_ = binary.Write(buf, binary.LittleEndian, uint16(wavFormatExtensible))
+	_ = binary.Write(buf, binary.LittleEndian, uint16(1))
+	_ = binary.Write(buf, binary.LittleEndian, sampleRate)
+	_ = binary.Write(buf, binary.LittleEndian, byteRate)
+	_ = binary.Write(buf, binary.LittleEndian, blockAlign)
+	_ = binary.Write(buf, binary.LittleEndian, bitsPerSample)
+	_ = binary.Write(buf, binary.LittleEndian, uint16(22))
+	_ = binary.Write(buf, binary.LittleEndian, bitsPerSample)
+	_ = binary.Write(buf, binary.LittleEndian, uint32(4))
+	_ = binary.Write(buf, binary.LittleEndian, subFormat)
+	buf.Write(guidSuffix)
+	buf.WriteString("data")
+	_ = binary.Write(buf, binary.LittleEndian, dataSize)
+	return buf.Bytes()
+}
+
+func TestUnitReadAndValidateWavInfo(t *testing.T) {
+	testCases := []struct {
+		name             string
+		data             []byte
+		expectReadError  bool
+		expectValidError bool
+		expectUnknown    bool
+		expectedDuration time.Duration
+	}{
+		{
+			name:             "16 bit PCM mono",
+			data:             buildTestWav(wavFormatPCM, 1, 8000, 16, 32000),
+			expectedDuration: 2 * time.Second,
+		},
+		{
+			name:             "24 bit PCM mono",
+			data:             buildTestWav(wavFormatPCM, 1, 48000, 24, 144000),
+			expectedDuration: time.Second,
+		},
+		{
+			name:             "extensible 24 bit PCM",
+			data:             buildTestExtensibleWav(wavFormatPCM, wavSubFormatGUIDSuffix, 16000, 24, 96000),
+			expectedDuration: 2 * time.Second,
+		},
+		{
+			name:             "extensible with an unknown sub-format",
+			data:             buildTestExtensibleWav(wavFormatPCM, make([]byte, 14), 16000, 24, 96000),
+			expectValidError: true,
+			expectUnknown:    true,
+		},
+		{
+			name:             "mu-law",
+			data:             buildTestWav(wavFormatMuLaw, 1, 8000, 8, 8000),
+			expectedDuration: time.Second,
+		},
+		{
+			name:            "not a wav file",
+			data:            []byte("ID3\x03\x00\x00\x00\x00\x00\x00\x00\x00"),
+			expectReadError: true,
+		},
+		{
+			name:            "truncated header",
+			data:            []byte("RIFF"),
+			expectReadError: true,
+		},
+		{
+			name:             "unknown codec",
+			data:             buildTestWav(3, 1, 8000, 32, 32000),
+			expectValidError: true,
+			expectUnknown:    true,
+		},
+		{
+			name:             "unsupported PCM bit depth",
+			data:             buildTestWav(wavFormatPCM, 1, 8000, 32, 32000),
+			expectValidError: true,
+		},
+		{
+			name:             "unsupported sample rate",
+			data:             buildTestWav(wavFormatPCM, 1, 12345, 16, 32000),
+			expectValidError: true,
+		},
+		{
+			name:             "too long",
+			data:             buildTestWav(wavFormatPCM, 1, 8000, 8, uint32(8000*(maxPromptAudioDuration/time.Second)+1)),
+			expectValidError: true,
+		},
+		{
+			name:             "empty audio",
+			data:             buildTestWav(wavFormatPCM, 1, 8000, 16, 0),
+			expectValidError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		info, err := readWavInfo(bytes.NewReader(tc.data))
+		if tc.expectReadError {
+			if err == nil {
+				t.Errorf("%s: expected a read error", tc.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected read error: %s", tc.name, err)
+			continue
+		}
+
+		err = validateWavInfo(info)
+		if tc.expectValidError {
+			if err == nil {
+				t.Errorf("%s: expected a validation error", tc.name)
+			} else if errors.Is(err, errUnknownWavCodec) != tc.expectUnknown {
+				t.Errorf("%s: unexpected unknown codec error state: %s", tc.name, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected validation error: %s", tc.name, err)
+			continue
+		}
+		if info.duration() != tc.expectedDuration {
+			t.Errorf("%s: expected duration %s, got %s", tc.name, tc.expectedDuration, info.duration())
+		}
+	}
+}
+
+func TestUnitInspectPromptAudioTestData(t *testing.T) {
+	for _, fileName := range []string{"test-prompt-01.wav", "test-prompt-02.wav"} {
+		// testrunner.GetTestDataPath only resolves paths for acceptance tests
+		path := filepath.Join("..", "..", "test", "data", "resource", ResourceType, fileName)
+		info, err := inspectPromptAudioFile(path)
+		if err != nil {
+			t.Errorf("expected %s to be a valid prompt audio file: %s", fileName, err)
+			continue
+		}
+		if info.duration() <= 0 {
+			t.Errorf("expected %s to have a positive duration", fileName)
+		}
+	}
+}
+
+func TestUnitIsWavPromptFile(t *testing.T) {
+	for filename, expected := range map[string]bool{
+		"greeting.wav":         true,
+		"prompts/GREETING.WAV": true,
+		"greeting.mp3":         false,
+		"greeting":             false,
+	} {
+		if isWavPromptFile(filename) != expected {
+			t.Errorf("expected isWavPromptFile(%q) to be %t", filename, expected)
+		}
+	}
+}
@@ -17,10 +17,15 @@ type deleteGroupMembersFunc func(ctx context.Context, p *groupProxy, id string,
 type getGroupMembersFunc func(ctx context.Context, p *groupProxy, id string) (*[]string, *platformclientv2.APIResponse, error)
 type getGroupByNameFunc func(ctx context.Context, p *groupProxy, name string) (*platformclientv2.Groupssearchresponse, *platformclientv2.APIResponse, error)
 type deleteGroupFunc func(ctx context.Context, p *groupProxy, id string) (*platformclientv2.APIResponse, error)
+type searchUserIdsFunc func(ctx context.Context, p *groupProxy, divisionIds []string, departments []string, titles []string) ([]string, *platformclientv2.APIResponse, error)
+
+// internalProxy holds a proxy instance that can be used throughout the package
+var internalProxy *groupProxy
 
 type groupProxy struct {
 	clientConfig           *platformclientv2.Configuration
 	groupsApi              *platformclientv2.GroupsApi
+	usersApi               *platformclientv2.UsersApi
 	createGroupAttr        createGroupFunc
 	getAllGroupAttr        getAllGroupFunc
 	updateGroupAttr        updateGroupFunc
@@ -30,6 +35,7 @@ type groupProxy struct {
 	addGroupMembersAttr    addGroupMembersFunc
 	deleteGroupMembersAttr deleteGroupMembersFunc
 	getGroupMembersAttr    getGroupMembersFunc
+	searchUserIdsAttr      searchUserIdsFunc
 	groupCache             rc.CacheInterface[platformclientv2.Group]
 }
 
@@ -40,6 +46,7 @@ func newGroupProxy(clientConfig *platformclientv2.Configuration) *groupProxy {
 	return &groupProxy{
 		clientConfig:           clientConfig,
 		groupsApi:              api,
+		usersApi:               platformclientv2.NewUsersApiWithConfig(clientConfig),
 		createGroupAttr:        createGroupFn,
 		getAllGroupAttr:        getAllGroupFn,
 		updateGroupAttr:        updateGroupFn,
@@ -49,12 +56,18 @@ func newGroupProxy(clientConfig *platformclientv2.Configuration) *groupProxy {
 		addGroupMembersAttr:    addGroupMembersFn,
 		deleteGroupMembersAttr: deleteGroupMembersFn,
 		getGroupMembersAttr:    getGroupMembersFn,
+		searchUserIdsAttr:      searchUserIdsFn,
 		groupCache:             groupCache,
 	}
 }
 
+// getGroupProxy acts as a singleton for the internalProxy. It also ensures
+// that we can still proxy our tests by directly setting internalProxy package variable
 func getGroupProxy(clientConfig *platformclientv2.Configuration) *groupProxy {
-	return newGroupProxy(clientConfig)
+	if internalProxy == nil {
+		internalProxy = newGroupProxy(clientConfig)
+	}
+	return internalProxy
 }
 
 func (p *groupProxy) createGroup(ctx context.Context, group *platformclientv2.Groupcreate) (*platformclientv2.Group, *platformclientv2.APIResponse, error) {
@@ -93,6 +106,11 @@ func (p *groupProxy) getGroupsByName(ctx context.Context, name string) (*platfor
 	return p.getGroupByNameAttr(ctx, p, name)
 }
 
+// searchUserIds returns the IDs of the active users in one of the divisions, in one of the departments and with one of the titles
+func (p *groupProxy) searchUserIds(ctx context.Context, divisionIds []string, departments []string, titles []string) ([]string, *platformclientv2.APIResponse, error) {
+	return p.searchUserIdsAttr(ctx, p, divisionIds, departments, titles)
+}
+
 func createGroupFn(_ context.Context, p *groupProxy, group *platformclientv2.Groupcreate) (*platformclientv2.Group, *platformclientv2.APIResponse, error) {
 	return p.groupsApi.PostGroups(*group)
 }
@@ -185,3 +203,52 @@ func getAllGroupFn(_ context.Context, p *groupProxy) (*[]platformclientv2.Group,
 
 	return &allGroups, nil, nil
 }
+
+// searchUserIdsFn is an implementation function for searching the active users by division, department and title
+func searchUserIdsFn(_ context.Context, p *groupProxy, divisionIds []string, departments []string, titles []string) ([]string, *platformclientv2.APIResponse, error) {
+	const pageSize = 100
+	exactType := "EXACT"
+	query := []platformclientv2.Usersearchcriteria{
+		{
+			Fields:  &[]string{"state"},
+			Values:  &[]string{"active"},
+			VarType: &exactType,
+		},
+	}
+	for field, values := range map[string][]string{"divisionId": divisionIds, "department": departments, "title": titles} {
+		if len(values) == 0 {
+			continue
+		}
+		query = append(query, platformclientv2.Usersearchcriteria{
+			Fields:  &[]string{field},
+			Values:  &values,
+			VarType: &exactType,
+		})
+	}
+
+	var (
+		userIds []string
+		resp    *platformclientv2.APIResponse
+	)
+	for pageNum := 1; ; pageNum++ {
+		results, apiResp, err := p.usersApi.PostUsersSearch(platformclientv2.Usersearchrequest{
+			PageSize:   platformclientv2.Int(pageSize),
+			PageNumber: platformclientv2.Int(pageNum),
+			Query:      &query,
+		})
+		resp = apiResp
+		if err != nil {
+			return nil, resp, fmt.Errorf("failed to search users: %s", err)
+		}
+		if results.Results == nil || len(*results.Results) == 0 {
+			break
+		}
+		for _, user := range *results.Results {
+			userIds = append(userIds, *user.Id)
+		}
+		if results.PageCount == nil || pageNum >= *results.PageCount {
+			break
+		}
+	}
+	return userIds, resp, nil
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
 	"terraform-provider-genesyscloud/genesyscloud/provider"
 	"terraform-provider-genesyscloud/genesyscloud/util"
 	"terraform-provider-genesyscloud/genesyscloud/util/constants"
@@ -13,7 +12,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 
 	"terraform-provider-genesyscloud/genesyscloud/consistency_checker"
-	"terraform-provider-genesyscloud/genesyscloud/util/chunks"
 	lists "terraform-provider-genesyscloud/genesyscloud/util/lists"
 	"terraform-provider-genesyscloud/genesyscloud/util/resourcedata"
 
@@ -132,6 +130,17 @@ func readGroup(ctx context.Context, d *schema.ResourceData, meta interface{}) di
 		}
 		_ = d.Set("member_ids", members)
 
+		// The members are resolved from the membership rules again so that new matching users show up as a diff
+		membershipInSync := true
+		if hasMembershipRules(d) {
+			memberIds, err := resolveMembershipRules(ctx, gp, d.Id(), d.Get("membership_rules").([]interface{}))
+			if err != nil {
+				return retry.NonRetryableError(fmt.Errorf("failed to resolve the membership rules of group %s: %s", d.Id(), err))
+			}
+			membershipInSync = ruleMembersInSync(memberIds, *lists.SetToStringList(members), *lists.SetToStringList(d.Get("rule_member_ids").(*schema.Set)))
+		}
+		_ = d.Set("membership_in_sync", membershipInSync)
+
 		log.Printf("Read group %s %s", d.Id(), *group.Name)
 		return cc.CheckState(d)
 	})
@@ -250,50 +259,27 @@ func deleteGroup(ctx context.Context, d *schema.ResourceData, meta interface{})
 	})
 }
 
+// updateGroupMembers applies the member_ids of the group when they changed, or resolves and applies its membership_rules
+// without removing the members assigned outside of the rules
 func updateGroupMembers(ctx context.Context, d *schema.ResourceData, sdkConfig *platformclientv2.Configuration) diag.Diagnostics {
 	gp := getGroupProxy(sdkConfig)
+	if hasMembershipRules(d) {
+		memberIds, err := resolveMembershipRules(ctx, gp, d.Id(), d.Get("membership_rules").([]interface{}))
+		if err != nil {
+			return util.BuildDiagnosticError(ResourceType, fmt.Sprintf("Failed to resolve the membership rules of group %s", d.Id()), err)
+		}
+		log.Printf("Membership rules of group %s resolved to %d members", d.Id(), len(memberIds))
+		ruleMemberIds, diagErr := syncRuleMembers(ctx, gp, d.Id(), memberIds, *lists.SetToStringList(d.Get("rule_member_ids").(*schema.Set)))
+		_ = d.Set("rule_member_ids", ruleMemberIds)
+		return diagErr
+	}
+	if d.HasChange("membership_rules") {
+		_ = d.Set("rule_member_ids", nil)
+	}
+
 	if d.HasChange("member_ids") {
 		if membersConfig := d.Get("member_ids"); membersConfig != nil {
-			configMemberIds := *lists.SetToStringList(membersConfig.(*schema.Set))
-			existingMemberIds, err := getGroupMemberIds(ctx, d, sdkConfig)
-			if err != nil {
-				return err
-			}
-
-			maxMembersPerRequest := 50
-			membersToRemoveList := lists.SliceDifference(existingMemberIds, configMemberIds)
-			chunkedMemberIdsDelete := chunks.ChunkBy(membersToRemoveList, maxMembersPerRequest)
-
-			chunkProcessor := func(membersToRemove []string) diag.Diagnostics {
-				if len(membersToRemove) > 0 {
-					if diagErr := util.RetryWhen(util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
-						_, resp, err := gp.deleteGroupMembers(ctx, d.Id(), strings.Join(membersToRemove, ","))
-						if err != nil {
-							return resp, util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to remove members from group %s: %s", d.Id(), err), resp)
-						}
-						return resp, nil
-					}); diagErr != nil {
-						return diagErr
-					}
-				}
-				return nil
-			}
-
-			if err := chunks.ProcessChunks(chunkedMemberIdsDelete, chunkProcessor); err != nil {
-				return err
-			}
-
-			membersToAdd := lists.SliceDifference(configMemberIds, existingMemberIds)
-			if len(membersToAdd) < 1 {
-				return nil
-			}
-
-			chunkedMemberIds := lists.ChunkStringSlice(membersToAdd, maxMembersPerRequest)
-			for _, chunk := range chunkedMemberIds {
-				if err := addGroupMembers(ctx, d, chunk, sdkConfig); err != nil {
-					return err
-				}
-			}
+			return setGroupMembers(ctx, gp, d.Id(), *lists.SetToStringList(membersConfig.(*schema.Set)))
 		}
 	}
 	return nil
@@ -313,37 +299,3 @@ func readGroupMembers(ctx context.Context, groupID string, sdkConfig *platformcl
 	}
 	return schema.NewSet(schema.HashString, interfaceList), nil
 }
-
-func getGroupMemberIds(ctx context.Context, d *schema.ResourceData, sdkConfig *platformclientv2.Configuration) ([]string, diag.Diagnostics) {
-	gp := getGroupProxy(sdkConfig)
-	members, resp, err := gp.getGroupMembers(ctx, d.Id())
-	if err != nil {
-		return nil, util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Unable to retrieve members for group %s. %s", d.Id(), err), resp)
-	}
-	return *members, nil
-}
-
-func addGroupMembers(ctx context.Context, d *schema.ResourceData, membersToAdd []string, sdkConfig *platformclientv2.Configuration) diag.Diagnostics {
-	gp := getGroupProxy(sdkConfig)
-	if diagErr := util.RetryWhen(util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
-		// Need the current group version to add members
-		groupInfo, resp, getErr := gp.getGroupById(ctx, d.Id())
-		if getErr != nil {
-			return resp, util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to read group %s: %s", d.Id(), getErr), resp)
-		}
-
-		groupMemberUpdate := &platformclientv2.Groupmembersupdate{
-			MemberIds: &membersToAdd,
-			Version:   groupInfo.Version,
-		}
-		_, resp, postErr := gp.addGroupMembers(ctx, d.Id(), groupMemberUpdate)
-		if postErr != nil {
-			return resp, util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to add group members %s: %s", d.Id(), postErr), resp)
-		}
-		return resp, nil
-	}); diagErr != nil {
-		return diagErr
-	}
-
-	return nil
-}
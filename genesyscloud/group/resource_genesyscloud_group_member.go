@@ -0,0 +1,123 @@
+package group
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	lists "terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+The resource_genesyscloud_group_member.go file contains the genesyscloud_group_member resource. It is not authoritative:
+it only adds and removes the users in its member_ids, and reading it only keeps the users that are still members.
+*/
+
+func createGroupMember(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	gp := getGroupProxy(sdkConfig)
+	groupId := d.Get("group_id").(string)
+	memberIds := *lists.SetToStringList(d.Get("member_ids").(*schema.Set))
+
+	existingMemberIds, diagErr := getGroupMemberIds(ctx, gp, groupId)
+	if diagErr != nil {
+		return diagErr
+	}
+
+	log.Printf("Adding %d members to group %s", len(memberIds), groupId)
+	if diagErr := addGroupMembers(ctx, gp, groupId, lists.SliceDifference(memberIds, existingMemberIds)); diagErr != nil {
+		return diagErr
+	}
+
+	d.SetId(uuid.NewString())
+	log.Printf("Added %d members to group %s", len(memberIds), groupId)
+	return readGroupMember(ctx, d, meta)
+}
+
+func readGroupMember(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	gp := getGroupProxy(sdkConfig)
+	groupId := d.Get("group_id").(string)
+
+	log.Printf("Reading members of group %s", groupId)
+	return util.WithRetriesForRead(ctx, d, func() *retry.RetryError {
+		members, resp, err := gp.getGroupMembers(ctx, groupId)
+		if err != nil {
+			if util.IsStatus404(resp) {
+				return retry.RetryableError(util.BuildWithRetriesApiDiagnosticError(MemberResourceType, fmt.Sprintf("Failed to read members of group %s | error: %s", groupId, err), resp))
+			}
+			return retry.NonRetryableError(util.BuildWithRetriesApiDiagnosticError(MemberResourceType, fmt.Sprintf("Failed to read members of group %s | error: %s", groupId, err), resp))
+		}
+
+		// Only the members managed by this resource are kept, so that removed members show up as a diff
+		configMemberIds := *lists.SetToStringList(d.Get("member_ids").(*schema.Set))
+		_ = d.Set("member_ids", intersectStrings(configMemberIds, *members))
+
+		log.Printf("Read members of group %s", groupId)
+		return nil
+	})
+}
+
+func updateGroupMember(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	gp := getGroupProxy(sdkConfig)
+	groupId := d.Get("group_id").(string)
+
+	oldMembers, newMembers := d.GetChange("member_ids")
+	oldMemberIds := *lists.SetToStringList(oldMembers.(*schema.Set))
+	newMemberIds := *lists.SetToStringList(newMembers.(*schema.Set))
+
+	existingMemberIds, diagErr := getGroupMemberIds(ctx, gp, groupId)
+	if diagErr != nil {
+		return diagErr
+	}
+
+	log.Printf("Updating members of group %s", groupId)
+	membersToRemove := intersectStrings(lists.SliceDifference(oldMemberIds, newMemberIds), existingMemberIds)
+	if diagErr := removeGroupMembers(ctx, gp, groupId, membersToRemove); diagErr != nil {
+		return diagErr
+	}
+	if diagErr := addGroupMembers(ctx, gp, groupId, lists.SliceDifference(newMemberIds, existingMemberIds)); diagErr != nil {
+		return diagErr
+	}
+
+	log.Printf("Updated members of group %s", groupId)
+	return readGroupMember(ctx, d, meta)
+}
+
+func deleteGroupMember(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	gp := getGroupProxy(sdkConfig)
+	groupId := d.Get("group_id").(string)
+
+	members, resp, err := gp.getGroupMembers(ctx, groupId)
+	if err != nil {
+		if util.IsStatus404(resp) {
+			log.Printf("Group %s already deleted", groupId)
+			return nil
+		}
+		return util.BuildAPIDiagnosticError(MemberResourceType, fmt.Sprintf("Failed to read members of group %s: %s", groupId, err), resp)
+	}
+
+	memberIds := intersectStrings(*lists.SetToStringList(d.Get("member_ids").(*schema.Set)), *members)
+	log.Printf("Removing %d members from group %s", len(memberIds), groupId)
+	if diagErr := removeGroupMembers(ctx, gp, groupId, memberIds); diagErr != nil {
+		return diagErr
+	}
+	log.Printf("Removed members from group %s", groupId)
+	return nil
+}
+
+func getGroupMemberIds(ctx context.Context, gp *groupProxy, groupId string) ([]string, diag.Diagnostics) {
+	members, resp, err := gp.getGroupMembers(ctx, groupId)
+	if err != nil {
+		return nil, util.BuildAPIDiagnosticError(MemberResourceType, fmt.Sprintf("Unable to retrieve members for group %s. %s", groupId, err), resp)
+	}
+	return *members, nil
+}
@@ -0,0 +1,220 @@
+package group
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/chunks"
+	lists "terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v154/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_group_membership.go file resolves the membership_rules of a group to user IDs and applies member
+changes to groups. A user is a member when they match every criteria set on one of the rules: the user search criteria
+(division, department and title) select active users, and group_ids selects the individual members of other groups, so
+nested groups are flattened when the rules are applied.
+Member changes are applied in chunks, each retried on version conflicts, as they are shared with genesyscloud_group_member.
+*/
+
+// maxMembersPerRequest is the number of members added or removed by each request to the group members API
+const maxMembersPerRequest = 50
+
+// hasMembershipRules returns whether the members of the group are resolved from its membership_rules
+func hasMembershipRules(d interface{ Get(string) interface{} }) bool {
+	rules, _ := d.Get("membership_rules").([]interface{})
+	return len(rules) > 0
+}
+
+// resolveMembershipRules returns the sorted IDs of the users matching one of the membership rules of a group.
+// A rule referencing the group itself ignores its current members.
+func resolveMembershipRules(ctx context.Context, gp *groupProxy, groupId string, rules []interface{}) ([]string, error) {
+	memberIds := make(map[string]bool)
+	groupMemberIds := make(map[string][]string)
+	for i, rule := range rules {
+		ruleMap, _ := rule.(map[string]interface{})
+		if ruleMap == nil {
+			continue
+		}
+		divisionIds := lists.SetToStringList(ruleMap["division_ids"].(*schema.Set))
+		departments := lists.SetToStringList(ruleMap["departments"].(*schema.Set))
+		titles := lists.SetToStringList(ruleMap["titles"].(*schema.Set))
+		groupIds := lists.SetToStringList(ruleMap["group_ids"].(*schema.Set))
+
+		var ruleMemberIds []string
+		if len(*divisionIds) > 0 || len(*departments) > 0 || len(*titles) > 0 {
+			userIds, _, err := gp.searchUserIds(ctx, *divisionIds, *departments, *titles)
+			if err != nil {
+				return nil, fmt.Errorf("membership_rules.%d: %s", i, err)
+			}
+			ruleMemberIds = userIds
+		}
+
+		if len(*groupIds) > 0 {
+			var nestedMemberIds []string
+			for _, nestedGroupId := range *groupIds {
+				if nestedGroupId == groupId {
+					continue
+				}
+				if _, ok := groupMemberIds[nestedGroupId]; !ok {
+					members, _, err := gp.getGroupMembers(ctx, nestedGroupId)
+					if err != nil {
+						return nil, fmt.Errorf("membership_rules.%d: failed to read the members of group %s: %s", i, nestedGroupId, err)
+					}
+					groupMemberIds[nestedGroupId] = *members
+				}
+				nestedMemberIds = append(nestedMemberIds, groupMemberIds[nestedGroupId]...)
+			}
+			if ruleMemberIds == nil {
+				ruleMemberIds = nestedMemberIds
+			} else {
+				ruleMemberIds = intersectStrings(ruleMemberIds, nestedMemberIds)
+			}
+		}
+
+		for _, memberId := range ruleMemberIds {
+			memberIds[memberId] = true
+		}
+	}
+
+	resolved := make([]string, 0, len(memberIds))
+	for memberId := range memberIds {
+		resolved = append(resolved, memberId)
+	}
+	sort.Strings(resolved)
+	return resolved, nil
+}
+
+// validateMembershipRules checks at plan time that every membership rule sets at least one criteria
+func validateMembershipRules(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	rules, _ := diff.Get("membership_rules").([]interface{})
+	for i, rule := range rules {
+		ruleMap, _ := rule.(map[string]interface{})
+		if ruleMap == nil {
+			return fmt.Errorf("membership_rules.%d: at least one of division_ids, departments, titles or group_ids must be set", i)
+		}
+		configured := false
+		for _, criteria := range []string{"division_ids", "departments", "titles", "group_ids"} {
+			if set, ok := ruleMap[criteria].(*schema.Set); ok && set.Len() > 0 {
+				configured = true
+			}
+		}
+		if !configured {
+			return fmt.Errorf("membership_rules.%d: at least one of division_ids, departments, titles or group_ids must be set", i)
+		}
+	}
+	return nil
+}
+
+// membershipOutOfSync is true when the last refresh found members of the group that do not match its membership_rules
+func membershipOutOfSync(_ context.Context, d *schema.ResourceDiff, _ interface{}) bool {
+	return d.Id() != "" && hasMembershipRules(d) && !d.Get("membership_in_sync").(bool)
+}
+
+// setGroupMembers makes the members of the group the given users, removing the other members
+func setGroupMembers(ctx context.Context, gp *groupProxy, groupId string, memberIds []string) diag.Diagnostics {
+	members, resp, err := gp.getGroupMembers(ctx, groupId)
+	if err != nil {
+		return util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Unable to retrieve members for group %s. %s", groupId, err), resp)
+	}
+	existingMemberIds := *members
+
+	if diagErr := removeGroupMembers(ctx, gp, groupId, lists.SliceDifference(existingMemberIds, memberIds)); diagErr != nil {
+		return diagErr
+	}
+	return addGroupMembers(ctx, gp, groupId, lists.SliceDifference(memberIds, existingMemberIds))
+}
+
+// syncRuleMembers adds the users resolved from the membership rules to the group, and removes the members previously
+// added by the rules that no longer match them. Members assigned outside of the rules are kept. It returns the members
+// the rules added to the group.
+func syncRuleMembers(ctx context.Context, gp *groupProxy, groupId string, memberIds []string, ruleMemberIds []string) ([]string, diag.Diagnostics) {
+	members, resp, err := gp.getGroupMembers(ctx, groupId)
+	if err != nil {
+		return ruleMemberIds, util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Unable to retrieve members for group %s. %s", groupId, err), resp)
+	}
+	existingMemberIds := *members
+
+	membersToAdd := lists.SliceDifference(memberIds, existingMemberIds)
+	if diagErr := removeGroupMembers(ctx, gp, groupId, lists.SliceDifference(intersectStrings(ruleMemberIds, existingMemberIds), memberIds)); diagErr != nil {
+		return ruleMemberIds, diagErr
+	}
+	if diagErr := addGroupMembers(ctx, gp, groupId, membersToAdd); diagErr != nil {
+		return ruleMemberIds, diagErr
+	}
+
+	ruleMembers := append(intersectStrings(ruleMemberIds, memberIds), lists.SliceDifference(membersToAdd, ruleMemberIds)...)
+	sort.Strings(ruleMembers)
+	return ruleMembers, nil
+}
+
+// ruleMembersInSync is true when the group has every member resolved from its rules, and no member added by the rules
+// that no longer matches them
+func ruleMembersInSync(memberIds []string, existingMemberIds []string, ruleMemberIds []string) bool {
+	return len(lists.SliceDifference(memberIds, existingMemberIds)) == 0 &&
+		len(lists.SliceDifference(intersectStrings(ruleMemberIds, existingMemberIds), memberIds)) == 0
+}
+
+// addGroupMembers adds the users to the group in chunks
+func addGroupMembers(ctx context.Context, gp *groupProxy, groupId string, membersToAdd []string) diag.Diagnostics {
+	if len(membersToAdd) == 0 {
+		return nil
+	}
+	log.Printf("Adding %d members to group %s", len(membersToAdd), groupId)
+	return chunks.ProcessChunks(chunks.ChunkBy(membersToAdd, maxMembersPerRequest), func(chunk []string) diag.Diagnostics {
+		return util.RetryWhen(util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+			// Need the current group version to add members
+			groupInfo, resp, getErr := gp.getGroupById(ctx, groupId)
+			if getErr != nil {
+				return resp, util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to read group %s: %s", groupId, getErr), resp)
+			}
+
+			groupMemberUpdate := &platformclientv2.Groupmembersupdate{
+				MemberIds: &chunk,
+				Version:   groupInfo.Version,
+			}
+			_, resp, postErr := gp.addGroupMembers(ctx, groupId, groupMemberUpdate)
+			if postErr != nil {
+				return resp, util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to add group members %s: %s", groupId, postErr), resp)
+			}
+			return resp, nil
+		})
+	})
+}
+
+// removeGroupMembers removes the users from the group in chunks
+func removeGroupMembers(ctx context.Context, gp *groupProxy, groupId string, membersToRemove []string) diag.Diagnostics {
+	if len(membersToRemove) == 0 {
+		return nil
+	}
+	log.Printf("Removing %d members from group %s", len(membersToRemove), groupId)
+	return chunks.ProcessChunks(chunks.ChunkBy(membersToRemove, maxMembersPerRequest), func(chunk []string) diag.Diagnostics {
+		return util.RetryWhen(util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+			_, resp, err := gp.deleteGroupMembers(ctx, groupId, strings.Join(chunk, ","))
+			if err != nil {
+				return resp, util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to remove members from group %s: %s", groupId, err), resp)
+			}
+			return resp, nil
+		})
+	})
+}
+
+func intersectStrings(a []string, b []string) []string {
+	inB := make(map[string]bool, len(b))
+	for _, item := range b {
+		inB[item] = true
+	}
+	intersection := make([]string, 0)
+	for _, item := range a {
+		if inB[item] {
+			intersection = append(intersection, item)
+		}
+	}
+	return intersection
+}
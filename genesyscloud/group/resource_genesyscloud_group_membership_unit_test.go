@@ -0,0 +1,165 @@
+package group
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v154/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+// testGroupMembersProxy stubs the group members API with an in memory map of the members of each group
+func testGroupMembersProxy(t *testing.T, members map[string][]string) *groupProxy {
+	versionConflicts := 1
+	return &groupProxy{
+		getGroupByIdAttr: func(_ context.Context, _ *groupProxy, id string) (*platformclientv2.Group, *platformclientv2.APIResponse, error) {
+			return &platformclientv2.Group{Id: &id, Version: platformclientv2.Int(1)}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+		},
+		getGroupMembersAttr: func(_ context.Context, _ *groupProxy, id string) (*[]string, *platformclientv2.APIResponse, error) {
+			groupMembers := append([]string{}, members[id]...)
+			return &groupMembers, nil, nil
+		},
+		addGroupMembersAttr: func(_ context.Context, _ *groupProxy, id string, update *platformclientv2.Groupmembersupdate) (*interface{}, *platformclientv2.APIResponse, error) {
+			assert.LessOrEqual(t, len(*update.MemberIds), maxMembersPerRequest)
+			// The first request conflicts with a concurrent update of the group
+			if versionConflicts > 0 {
+				versionConflicts--
+				return nil, &platformclientv2.APIResponse{StatusCode: http.StatusConflict}, assert.AnError
+			}
+			members[id] = append(members[id], *update.MemberIds...)
+			return nil, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+		},
+		deleteGroupMembersAttr: func(_ context.Context, _ *groupProxy, id string, memberIds string) (*interface{}, *platformclientv2.APIResponse, error) {
+			removed := strings.Split(memberIds, ",")
+			assert.LessOrEqual(t, len(removed), maxMembersPerRequest)
+			var remaining []string
+			for _, member := range members[id] {
+				if !containsString(removed, member) {
+					remaining = append(remaining, member)
+				}
+			}
+			members[id] = remaining
+			return nil, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+		},
+	}
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func TestUnitResolveMembershipRules(t *testing.T) {
+	members := map[string][]string{
+		"nested-1": {"user-1", "user-2"},
+		"nested-2": {"user-2", "user-5"},
+		"group-1":  {"user-9"},
+	}
+	gp := testGroupMembersProxy(t, members)
+	gp.searchUserIdsAttr = func(_ context.Context, _ *groupProxy, divisionIds []string, departments []string, titles []string) ([]string, *platformclientv2.APIResponse, error) {
+		if len(divisionIds) > 0 {
+			assert.Equal(t, []string{"division-1"}, divisionIds)
+			return []string{"user-3", "user-4"}, nil, nil
+		}
+		assert.Equal(t, []string{"Sales"}, departments)
+		assert.Equal(t, []string{"Manager"}, titles)
+		return []string{"user-1", "user-6"}, nil, nil
+	}
+
+	rules := schema.TestResourceDataRaw(t, ResourceGroup().Schema, map[string]interface{}{
+		"name": "group",
+		"membership_rules": []interface{}{
+			map[string]interface{}{"division_ids": []interface{}{"division-1"}},
+			map[string]interface{}{"departments": []interface{}{"Sales"}, "titles": []interface{}{"Manager"}, "group_ids": []interface{}{"nested-1"}},
+			map[string]interface{}{"group_ids": []interface{}{"nested-2", "group-1"}},
+		},
+	}).Get("membership_rules").([]interface{})
+
+	memberIds, err := resolveMembershipRules(context.Background(), gp, "group-1", rules)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"user-1", "user-2", "user-3", "user-4", "user-5"}, memberIds, "criteria of a rule must all match, and a rule referencing the group itself ignores its members")
+}
+
+func TestUnitSyncRuleMembers(t *testing.T) {
+	members := map[string][]string{"group-1": {"user-1", "user-2", "manual-1"}}
+	gp := testGroupMembersProxy(t, members)
+
+	// Only the members added by the rules that no longer match them are removed
+	ruleMemberIds, diags := syncRuleMembers(context.Background(), gp, "group-1", []string{"user-1", "user-3"}, []string{"user-1", "user-2"})
+	assert.False(t, diags.HasError(), diags)
+	sort.Strings(members["group-1"])
+	assert.Equal(t, []string{"manual-1", "user-1", "user-3"}, members["group-1"], "members assigned outside of the rules are kept")
+	assert.Equal(t, []string{"user-1", "user-3"}, ruleMemberIds)
+	assert.True(t, ruleMembersInSync([]string{"user-1", "user-3"}, members["group-1"], ruleMemberIds))
+
+	// A member assigned outside of the rules that starts matching them is not removed once it no longer does
+	members["group-1"] = append(members["group-1"], "manual-2")
+	ruleMemberIds, diags = syncRuleMembers(context.Background(), gp, "group-1", []string{"user-1", "manual-2"}, ruleMemberIds)
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, []string{"user-1"}, ruleMemberIds)
+	assert.False(t, ruleMembersInSync([]string{"user-1", "user-4"}, members["group-1"], ruleMemberIds))
+	assert.True(t, ruleMembersInSync([]string{"user-1"}, members["group-1"], ruleMemberIds))
+
+	ruleMemberIds, diags = syncRuleMembers(context.Background(), gp, "group-1", []string{"user-4"}, ruleMemberIds)
+	assert.False(t, diags.HasError(), diags)
+	sort.Strings(members["group-1"])
+	assert.Equal(t, []string{"manual-1", "manual-2", "user-4"}, members["group-1"])
+	assert.Equal(t, []string{"user-4"}, ruleMemberIds)
+}
+
+func TestUnitGroupMembershipRulesDiff(t *testing.T) {
+	diff, err := ResourceGroup().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":             "group",
+		"membership_rules": []interface{}{map[string]interface{}{}},
+	}), nil)
+	assert.Nil(t, diff)
+	assert.ErrorContains(t, err, "membership_rules.0: at least one of division_ids, departments, titles or group_ids must be set")
+}
+
+func TestUnitGroupMember(t *testing.T) {
+	internalProxyCopy := internalProxy
+	defer func() {
+		internalProxy = internalProxyCopy
+	}()
+
+	var newMembers []interface{}
+	for i := 0; i < 120; i++ {
+		newMembers = append(newMembers, fmt.Sprintf("user-%03d", i))
+	}
+	members := map[string][]string{"group-1": {"existing-1", "existing-2"}}
+	internalProxy = testGroupMembersProxy(t, members)
+	meta := &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}
+
+	d := schema.TestResourceDataRaw(t, ResourceGroupMember().Schema, map[string]interface{}{
+		"group_id":   "group-1",
+		"member_ids": append([]interface{}{"existing-1"}, newMembers...),
+	})
+	diags := createGroupMember(context.Background(), d, meta)
+	assert.False(t, diags.HasError(), diags)
+	assert.NotEmpty(t, d.Id())
+	assert.Len(t, members["group-1"], 122, "members are added in chunks, retried on version conflicts")
+	assert.Equal(t, 121, d.Get("member_ids").(*schema.Set).Len())
+
+	// Members removed outside of Terraform are dropped from the state
+	members["group-1"] = members["group-1"][1:]
+	diags = readGroupMember(context.Background(), d, meta)
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, 120, d.Get("member_ids").(*schema.Set).Len())
+
+	// Destroying the resource only removes its members
+	diags = deleteGroupMember(context.Background(), d, meta)
+	assert.False(t, diags.HasError(), diags)
+	sort.Strings(members["group-1"])
+	assert.Equal(t, []string{"existing-2"}, members["group-1"])
+}
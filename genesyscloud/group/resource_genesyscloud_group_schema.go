@@ -1,16 +1,19 @@
 package group
 
 import (
+	"context"
 	"terraform-provider-genesyscloud/genesyscloud/provider"
 	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
 	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
 	"terraform-provider-genesyscloud/genesyscloud/validators"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 const ResourceType = "genesyscloud_group"
+const MemberResourceType = "genesyscloud_group_member"
 
 var (
 	groupPhoneType       = "PHONE"
@@ -35,11 +38,41 @@ var (
 			},
 		},
 	}
+
+	groupMembershipRuleResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"division_ids": {
+				Description: "The active users in one of these divisions match the rule.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"departments": {
+				Description: "The active users in one of these departments match the rule.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"titles": {
+				Description: "The active users with one of these titles match the rule.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"group_ids": {
+				Description: "The individual members of one of these groups match the rule. The members of nested groups are flattened when the rule is applied.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
 )
 
 // SetRegistrar registers all of the resources, datasources and exporters in the package
 func SetRegistrar(regInstance registrar.Registrar) {
 	regInstance.RegisterResource(ResourceType, ResourceGroup())
+	regInstance.RegisterResource(MemberResourceType, ResourceGroupMember())
 	regInstance.RegisterDataSource(ResourceType, DataSourceGroup())
 	regInstance.RegisterExporter(ResourceType, GroupExporter())
 }
@@ -69,6 +102,18 @@ func ResourceGroup() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		SchemaVersion: 1,
+		CustomizeDiff: customdiff.All(
+			validateMembershipRules,
+			customdiff.ComputedIf("member_ids", membershipOutOfSync),
+			customdiff.ComputedIf("membership_in_sync", membershipOutOfSync),
+			customdiff.ComputedIf("member_ids", func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+				return d.HasChange("membership_rules") && hasMembershipRules(d)
+			}),
+			customdiff.ComputedIf("rule_member_ids", membershipOutOfSync),
+			customdiff.ComputedIf("rule_member_ids", func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+				return d.HasChange("membership_rules")
+			}),
+		),
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Description: "Group name.",
@@ -115,9 +160,28 @@ func ResourceGroup() *schema.Resource {
 				Computed:    true,
 			},
 			"member_ids": {
-				Description: "IDs of members assigned to the group. If not set, this resource will not manage group members.",
+				Description:   "IDs of members assigned to the group. If not set, this resource will not manage group members. When membership_rules are set, all the members of the group, including the members assigned outside of the rules.",
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Computed:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"membership_rules"},
+			},
+			"membership_rules": {
+				Description:   "Rules the members of the group are resolved from when it is applied. A user is a member when they match every criteria set on one of the rules. The rules are resolved again on every refresh: new matching users are added on the next apply, and the members added by the rules that no longer match are removed. Members assigned with genesyscloud_group_member resources or outside of Terraform are kept.",
+				Type:          schema.TypeList,
+				Optional:      true,
+				Elem:          groupMembershipRuleResource,
+				ConflictsWith: []string{"member_ids"},
+			},
+			"membership_in_sync": {
+				Description: "Whether the group had every member resolved from its membership_rules, and no member added by the rules that no longer matches them, when last refreshed. When false, the next apply updates the members.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"rule_member_ids": {
+				Description: "IDs of the members added to the group by its membership_rules. Only these members are removed when they no longer match the rules.",
 				Type:        schema.TypeSet,
-				Optional:    true,
 				Computed:    true,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
@@ -137,6 +201,34 @@ func ResourceGroup() *schema.Resource {
 	}
 }
 
+// ResourceGroupMember registers the genesyscloud_group_member resource with Terraform
+func ResourceGroupMember() *schema.Resource {
+	return &schema.Resource{
+		Description: "Genesys Cloud Directory Group Member. Adds users to a group without managing its other members, so that members can be assigned from several configurations. Removing a user from member_ids or destroying the resource removes the user from the group. Do not set member_ids on the genesyscloud_group of a group whose members are assigned with this resource, as each would remove the members of the other. Members assigned with this resource are kept by the membership_rules of the group.",
+
+		CreateContext: provider.CreateWithPooledClient(createGroupMember),
+		ReadContext:   provider.ReadWithPooledClient(readGroupMember),
+		UpdateContext: provider.UpdateWithPooledClient(updateGroupMember),
+		DeleteContext: provider.DeleteWithPooledClient(deleteGroupMember),
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Description: "ID of the group. Changing the group_id attribute will cause the members to be removed from the group and added to the new group.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"member_ids": {
+				Description: "IDs of the users added to the group. Members are added and removed in chunks of 50.",
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
 func DataSourceGroup() *schema.Resource {
 	return &schema.Resource{
 		Description: "Data source for Genesys Cloud Groups. Select a group by name.",
@@ -3,6 +3,7 @@ package outbound
 import (
 	"terraform-provider-genesyscloud/genesyscloud/provider"
 	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"terraform-provider-genesyscloud/genesyscloud/util/lifecycle"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -178,8 +179,15 @@ func ResourceOutboundMessagingCampaign() *schema.Resource {
 				Type:        schema.TypeSet,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			`drain_timeout_seconds`: {
+				Description:  `The number of seconds to wait for a running messaging campaign to stop before it is changed. The messaging campaign is turned back on once the change is applied.`,
+				Optional:     true,
+				Default:      lifecycle.DefaultDrainTimeoutSeconds,
+				Type:         schema.TypeInt,
+				ValidateFunc: validation.IntBetween(30, 3600),
+			},
 			`campaign_status`: {
-				Description:  `The current status of the messaging campaign. A messaging campaign may be turned 'on' or 'off'.`,
+				Description:  `The current status of the messaging campaign. A messaging campaign may be turned 'on' or 'off'. A messaging campaign that is on is turned off before other changes are applied, and is turned back on afterwards unless campaign_status is changed to 'off'. See drain_timeout_seconds.`,
 				Optional:     true,
 				Computed:     true,
 				Type:         schema.TypeString,
@@ -1,16 +1,48 @@
 package outbound
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/util/lifecycle"
 	"terraform-provider-genesyscloud/genesyscloud/util/lists"
 	"terraform-provider-genesyscloud/genesyscloud/util/resourcedata"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/mypurecloud/platform-client-sdk-go/v154/platformclientv2"
 )
 
+// newMessagingCampaignOrchestrator returns the lifecycle orchestrator used to stop a running messaging campaign before it is changed
+func newMessagingCampaignOrchestrator(outboundApi *platformclientv2.OutboundApi, d *schema.ResourceData) *lifecycle.Orchestrator {
+	messagingCampaignId := d.Id()
+	return &lifecycle.Orchestrator{
+		ResourceType: ResourceType,
+		Name:         fmt.Sprintf("Outbound Messagingcampaign %s", messagingCampaignId),
+		GetStatus: func(_ context.Context) (string, *platformclientv2.APIResponse, error) {
+			messagingCampaign, resp, err := outboundApi.GetOutboundMessagingcampaign(messagingCampaignId)
+			if err != nil {
+				return "", resp, err
+			}
+			if messagingCampaign == nil || messagingCampaign.CampaignStatus == nil {
+				return "", resp, fmt.Errorf("no status returned for outbound messagingcampaign %s", messagingCampaignId)
+			}
+			return *messagingCampaign.CampaignStatus, resp, nil
+		},
+		SetStatus: func(_ context.Context, status string) (*platformclientv2.APIResponse, error) {
+			messagingCampaign, resp, err := outboundApi.GetOutboundMessagingcampaign(messagingCampaignId)
+			if err != nil {
+				return resp, err
+			}
+			messagingCampaign.CampaignStatus = &status
+			_, resp, err = outboundApi.PutOutboundMessagingcampaign(messagingCampaignId, *messagingCampaign)
+			return resp, err
+		},
+		DrainTimeout: time.Duration(d.Get("drain_timeout_seconds").(int)) * time.Second,
+	}
+}
+
 func gatherExtraErrorMessagesFromResponseBody(resp *platformclientv2.APIResponse) (string, error) {
 	if resp == nil || resp.RawBody == nil {
 		return "", errors.New("no raw body to parse from API response")
@@ -7,6 +7,7 @@ import (
 	"log"
 	"terraform-provider-genesyscloud/genesyscloud/provider"
 	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/lifecycle"
 	"terraform-provider-genesyscloud/genesyscloud/util/resourcedata"
 	"time"
 
@@ -154,6 +155,19 @@ func updateOutboundMessagingcampaign(ctx context.Context, d *schema.ResourceData
 		return util.BuildDiagnosticError(ResourceType, "Configuration error", errors.New(msg))
 	}
 
+	// A running messaging campaign is stopped before it is changed and turned back on afterwards
+	orchestrator := newMessagingCampaignOrchestrator(outboundApi, d)
+	wasRunning := false
+	if d.HasChangesExcept("drain_timeout_seconds") {
+		var diagErr diag.Diagnostics
+		if wasRunning, diagErr = orchestrator.StopIfRunning(ctx); diagErr != nil {
+			return diagErr
+		}
+		if wasRunning {
+			sdkmessagingcampaign.CampaignStatus = platformclientv2.String(lifecycle.StatusOff)
+		}
+	}
+
 	log.Printf("Updating Outbound Messaging Campaign %s", name)
 	diagErr := util.RetryWhen(util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
 		// Get current Outbound Messagingcampaign version
@@ -177,6 +191,9 @@ func updateOutboundMessagingcampaign(ctx context.Context, d *schema.ResourceData
 	if diagErr != nil {
 		return diagErr
 	}
+	if diagErr := orchestrator.RestoreStatus(ctx, wasRunning, campaignStatus); diagErr != nil {
+		return diagErr
+	}
 
 	log.Printf("Updated Outbound Messagingcampaign %s", name)
 	return readOutboundMessagingcampaign(ctx, d, meta)
@@ -205,6 +222,7 @@ func readOutboundMessagingcampaign(ctx context.Context, d *schema.ResourceData,
 		resourcedata.SetNillableReference(d, "division_id", sdkMessagingCampaign.Division)
 		resourcedata.SetNillableReference(d, "callable_time_set_id", sdkMessagingCampaign.CallableTimeSet)
 		resourcedata.SetNillableReference(d, "contact_list_id", sdkMessagingCampaign.ContactList)
+		lifecycle.SetDefaultDrainTimeout(d)
 
 		if sdkMessagingCampaign.EmailConfig != nil {
 			_ = d.Set("email_config", flattenEmailConfig(*sdkMessagingCampaign.EmailConfig))
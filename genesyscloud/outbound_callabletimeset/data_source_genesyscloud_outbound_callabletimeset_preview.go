@@ -0,0 +1,232 @@
+package outbound_callabletimeset
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/resourcedata"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v154/platformclientv2"
+)
+
+/*
+   The data_source_genesyscloud_outbound_callabletimeset_preview.go contains the data source implementation
+   for previewing when contacts in a set of time zones may be dialed under a callable time set.
+*/
+
+const previewTimeFormat = "2006-01-02T15:04:05"
+
+// previewTimeSlot is a weekly callable window in the time zone of its callable time
+type previewTimeSlot struct {
+	day      time.Weekday
+	start    time.Duration
+	stop     time.Duration
+	location *time.Location
+}
+
+// previewWindow is a period during which contacts may be dialed
+type previewWindow struct {
+	start time.Time
+	end   time.Time
+}
+
+// dataSourceOutboundCallabletimesetPreviewRead computes the dialable windows of each contact time zone between start_date and end_date
+func dataSourceOutboundCallabletimesetPreviewRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getOutboundCallabletimesetProxy(sdkConfig)
+
+	timesetId := d.Get("callable_time_set_id").(string)
+	timeset, resp, err := proxy.getOutboundCallabletimesetById(ctx, timesetId)
+	if err != nil {
+		return util.BuildAPIDiagnosticError(PreviewDataSourceType, fmt.Sprintf("failed to read callable time set %s | error: %s", timesetId, err), resp)
+	}
+	if timeset == nil {
+		return util.BuildDiagnosticError(PreviewDataSourceType, fmt.Sprintf("callable time set %s not found", timesetId), fmt.Errorf("callable time set %s does not exist", timesetId))
+	}
+
+	slots, err := buildPreviewTimeSlots(timeset.CallableTimes)
+	if err != nil {
+		return util.BuildDiagnosticError(PreviewDataSourceType, fmt.Sprintf("failed to read the callable times of callable time set %s", timesetId), err)
+	}
+
+	startDate, _ := time.Parse(resourcedata.DateParseFormat, d.Get("start_date").(string))
+	endDate, _ := time.Parse(resourcedata.DateParseFormat, d.Get("end_date").(string))
+	if endDate.Before(startDate) {
+		return util.BuildDiagnosticError(PreviewDataSourceType, "invalid range", fmt.Errorf("start_date %s must not be after end_date %s", d.Get("start_date").(string), d.Get("end_date").(string)))
+	}
+
+	var timeZones []interface{}
+	for _, timeZoneId := range d.Get("contact_time_zones").([]interface{}) {
+		loc, err := time.LoadLocation(timeZoneId.(string))
+		if err != nil {
+			return util.BuildDiagnosticError(PreviewDataSourceType, fmt.Sprintf("invalid contact time zone %s", timeZoneId), err)
+		}
+		windows := buildPreviewWindows(slots, startDate, endDate, loc)
+		timeZones = append(timeZones, flattenPreviewTimeZone(timeZoneId.(string), windows))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", timesetId, startDate.Format(resourcedata.DateParseFormat), endDate.Format(resourcedata.DateParseFormat)))
+	_ = d.Set("time_zones", timeZones)
+	return nil
+}
+
+// buildPreviewTimeSlots reads the time slots of the callable times with their time zone
+func buildPreviewTimeSlots(callableTimes *[]platformclientv2.Callabletime) ([]previewTimeSlot, error) {
+	if callableTimes == nil {
+		return nil, nil
+	}
+	var slots []previewTimeSlot
+	for _, callableTime := range *callableTimes {
+		if callableTime.TimeZoneId == nil || callableTime.TimeSlots == nil {
+			continue
+		}
+		loc, err := time.LoadLocation(*callableTime.TimeZoneId)
+		if err != nil {
+			return nil, fmt.Errorf("invalid time_zone_id %s: %s", *callableTime.TimeZoneId, err)
+		}
+		for _, timeSlot := range *callableTime.TimeSlots {
+			if timeSlot.Day == nil || timeSlot.StartTime == nil || timeSlot.StopTime == nil {
+				continue
+			}
+			start, err := parsePreviewTimeOfDay(*timeSlot.StartTime)
+			if err != nil {
+				return nil, err
+			}
+			stop, err := parsePreviewTimeOfDay(*timeSlot.StopTime)
+			if err != nil {
+				return nil, err
+			}
+			slots = append(slots, previewTimeSlot{
+				// Days run from 1 (Monday) to 7 (Sunday)
+				day:      time.Weekday(*timeSlot.Day % 7),
+				start:    start,
+				stop:     stop,
+				location: loc,
+			})
+		}
+	}
+	return slots, nil
+}
+
+// buildPreviewWindows returns the merged windows during which a contact in loc may be dialed between the start of startDate
+// and the end of endDate in loc. Each slot is evaluated in the time zone of its callable time.
+func buildPreviewWindows(slots []previewTimeSlot, startDate, endDate time.Time, loc *time.Location) []previewWindow {
+	from := time.Date(startDate.Year(), startDate.Month(), startDate.Day(), 0, 0, 0, 0, loc)
+	to := time.Date(endDate.Year(), endDate.Month(), endDate.Day()+1, 0, 0, 0, 0, loc)
+
+	var windows []previewWindow
+	for _, slot := range slots {
+		// Time zones are at most a day apart, so the days around the range cover every overlapping occurrence
+		for day := startDate.AddDate(0, 0, -2); !day.After(endDate.AddDate(0, 0, 2)); day = day.AddDate(0, 0, 1) {
+			if day.Weekday() != slot.day {
+				continue
+			}
+			midnight := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, slot.location)
+			start := previewTimeOnDay(midnight, slot.start)
+			end := previewTimeOnDay(midnight, slot.stop)
+			// A stop time before the start time ends on the next day
+			if !end.After(start) {
+				end = previewTimeOnDay(midnight.AddDate(0, 0, 1), slot.stop)
+			}
+			if start.Before(from) {
+				start = from
+			}
+			if end.After(to) {
+				end = to
+			}
+			if start.Before(end) {
+				windows = append(windows, previewWindow{start: start.In(loc), end: end.In(loc)})
+			}
+		}
+	}
+
+	sort.Slice(windows, func(i, j int) bool { return windows[i].start.Before(windows[j].start) })
+
+	var merged []previewWindow
+	for _, window := range windows {
+		if n := len(merged); n > 0 && !window.start.After(merged[n-1].end) {
+			if window.end.After(merged[n-1].end) {
+				merged[n-1].end = window.end
+			}
+			continue
+		}
+		merged = append(merged, window)
+	}
+	return merged
+}
+
+// previewTimeOnDay returns the wall clock time of day on the day of midnight, adjusting for daylight saving changes
+func previewTimeOnDay(midnight time.Time, timeOfDay time.Duration) time.Time {
+	return time.Date(midnight.Year(), midnight.Month(), midnight.Day(), 0, 0, int(timeOfDay.Seconds()), 0, midnight.Location())
+}
+
+// flattenPreviewTimeZone reports the windows of a contact time zone in local time, with the earliest and latest local
+// times of day a contact may be dialed
+func flattenPreviewTimeZone(timeZoneId string, windows []previewWindow) map[string]interface{} {
+	var (
+		flattened       = make([]interface{}, 0, len(windows))
+		earliest        = ""
+		latest          = ""
+		dialableSeconds = 0
+	)
+	for _, window := range windows {
+		start, end := window.start, window.end
+		flattened = append(flattened, map[string]interface{}{
+			"start":     start.Format(previewTimeFormat),
+			"end":       end.Format(previewTimeFormat),
+			"start_utc": start.UTC().Format(time.RFC3339),
+			"end_utc":   end.UTC().Format(time.RFC3339),
+		})
+		dialableSeconds += int(end.Sub(start).Seconds())
+
+		// Windows are split at local midnight to find the times of day
+		for dayStart := start; dayStart.Before(end); {
+			nextMidnight := time.Date(dayStart.Year(), dayStart.Month(), dayStart.Day()+1, 0, 0, 0, 0, dayStart.Location())
+			dayEnd := end
+			endOfDay := dayEnd.Format("15:04:05")
+			if nextMidnight.Before(end) || nextMidnight.Equal(end) {
+				dayEnd = nextMidnight
+				endOfDay = "24:00:00"
+			}
+			if startOfDay := dayStart.Format("15:04:05"); earliest == "" || startOfDay < earliest {
+				earliest = startOfDay
+			}
+			if endOfDay > latest {
+				latest = endOfDay
+			}
+			dayStart = dayEnd
+		}
+	}
+	return map[string]interface{}{
+		"time_zone_id":        timeZoneId,
+		"windows":             flattened,
+		"earliest_start_time": earliest,
+		"latest_end_time":     latest,
+		"dialable_minutes":    dialableSeconds / 60,
+	}
+}
+
+// parsePreviewTimeOfDay parses a HH:mm:ss or HH:mm time of day
+func parsePreviewTimeOfDay(value string) (time.Duration, error) {
+	parts := strings.Split(value, ":")
+	if len(parts) == 2 {
+		parts = append(parts, "00")
+	}
+	var hours, minutes, seconds int
+	if len(parts) != 3 {
+		return 0, fmt.Errorf("invalid time %s", value)
+	}
+	if _, err := fmt.Sscanf(strings.Join(parts[:3], " "), "%d %d %d", &hours, &minutes, &seconds); err != nil {
+		return 0, fmt.Errorf("invalid time %s: %s", value, err)
+	}
+	if hours < 0 || hours > 24 || minutes < 0 || minutes > 59 || seconds < 0 || seconds > 59 {
+		return 0, fmt.Errorf("invalid time %s", value)
+	}
+	return time.Duration(hours)*time.Hour + time.Duration(minutes)*time.Minute + time.Duration(seconds)*time.Second, nil
+}
@@ -0,0 +1,75 @@
+package outbound_callabletimeset
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v154/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitBuildPreviewWindows(t *testing.T) {
+	slots, err := buildPreviewTimeSlots(&[]platformclientv2.Callabletime{
+		{
+			TimeZoneId: platformclientv2.String("America/New_York"),
+			TimeSlots: &[]platformclientv2.Campaigntimeslot{
+				{Day: platformclientv2.Int(1), StartTime: platformclientv2.String("09:00:00"), StopTime: platformclientv2.String("20:00:00")},
+				{Day: platformclientv2.Int(7), StartTime: platformclientv2.String("01:00:00"), StopTime: platformclientv2.String("04:00:00")},
+			},
+		},
+	})
+	assert.NoError(t, err)
+
+	// Monday 11 March 2024, the day after daylight saving time started in the US but not in Europe
+	monday := time.Date(2024, 3, 11, 0, 0, 0, 0, time.UTC)
+	expected := map[string]map[string]interface{}{
+		"America/New_York":    {"start": "2024-03-11T09:00:00", "end": "2024-03-11T20:00:00", "earliest": "09:00:00", "latest": "20:00:00"},
+		"America/Los_Angeles": {"start": "2024-03-11T06:00:00", "end": "2024-03-11T17:00:00", "earliest": "06:00:00", "latest": "17:00:00"},
+		"Europe/London":       {"start": "2024-03-11T13:00:00", "end": "2024-03-12T00:00:00", "earliest": "13:00:00", "latest": "24:00:00"},
+	}
+	for timeZoneId, want := range expected {
+		loc, err := time.LoadLocation(timeZoneId)
+		assert.NoError(t, err)
+
+		timeZone := flattenPreviewTimeZone(timeZoneId, buildPreviewWindows(slots, monday, monday, loc))
+		windows := timeZone["windows"].([]interface{})
+		if assert.Len(t, windows, 1, timeZoneId) {
+			assert.Equal(t, want["start"], windows[0].(map[string]interface{})["start"], timeZoneId)
+			assert.Equal(t, want["end"], windows[0].(map[string]interface{})["end"], timeZoneId)
+		}
+		assert.Equal(t, "2024-03-11T13:00:00Z", windows[0].(map[string]interface{})["start_utc"], timeZoneId)
+		assert.Equal(t, want["earliest"], timeZone["earliest_start_time"], timeZoneId)
+		assert.Equal(t, want["latest"], timeZone["latest_end_time"], timeZoneId)
+		assert.Equal(t, 660, timeZone["dialable_minutes"], timeZoneId)
+	}
+
+	// The Sunday slot spans the skipped hour at the start of daylight saving time
+	sunday := time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC)
+	newYork, _ := time.LoadLocation("America/New_York")
+	timeZone := flattenPreviewTimeZone("America/New_York", buildPreviewWindows(slots, sunday, sunday, newYork))
+	assert.Equal(t, 120, timeZone["dialable_minutes"])
+}
+
+func TestUnitBuildPreviewWindowsMerged(t *testing.T) {
+	slots, err := buildPreviewTimeSlots(&[]platformclientv2.Callabletime{
+		{
+			TimeZoneId: platformclientv2.String("UTC"),
+			TimeSlots: &[]platformclientv2.Campaigntimeslot{
+				{Day: platformclientv2.Int(3), StartTime: platformclientv2.String("08:00"), StopTime: platformclientv2.String("12:00")},
+				{Day: platformclientv2.Int(3), StartTime: platformclientv2.String("11:00"), StopTime: platformclientv2.String("15:00")},
+			},
+		},
+	})
+	assert.NoError(t, err)
+
+	wednesday := time.Date(2024, 3, 13, 0, 0, 0, 0, time.UTC)
+	windows := buildPreviewWindows(slots, wednesday, wednesday.AddDate(0, 0, 6), time.UTC)
+	assert.Equal(t, []previewWindow{
+		{start: time.Date(2024, 3, 13, 8, 0, 0, 0, time.UTC), end: time.Date(2024, 3, 13, 15, 0, 0, 0, time.UTC)},
+	}, windows)
+
+	_, err = buildPreviewTimeSlots(&[]platformclientv2.Callabletime{
+		{TimeZoneId: platformclientv2.String("Not/A_Zone"), TimeSlots: &[]platformclientv2.Campaigntimeslot{}},
+	})
+	assert.Error(t, err)
+}
@@ -11,10 +11,12 @@ import (
 )
 
 const ResourceType = "genesyscloud_outbound_callabletimeset"
+const PreviewDataSourceType = "genesyscloud_outbound_callabletimeset_preview"
 
 // SetRegistrar registers all of the resources and exporters in the package
 func SetRegistrar(l registrar.Registrar) {
 	l.RegisterDataSource(ResourceType, DataSourceOutboundCallabletimeset())
+	l.RegisterDataSource(PreviewDataSourceType, DataSourceOutboundCallabletimesetPreview())
 	l.RegisterResource(ResourceType, ResourceOutboundCallabletimeset())
 	l.RegisterExporter(ResourceType, OutboundCallableTimesetExporter())
 }
@@ -108,3 +110,101 @@ func DataSourceOutboundCallabletimeset() *schema.Resource {
 		},
 	}
 }
+
+var previewWindowResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"start": {
+			Description: "Start of the window in the contact time zone. Format: yyyy-MM-ddTHH:mm:ss",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"end": {
+			Description: "End of the window (exclusive) in the contact time zone. Format: yyyy-MM-ddTHH:mm:ss",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"start_utc": {
+			Description: "Start of the window in UTC, in RFC 3339 format.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"end_utc": {
+			Description: "End of the window (exclusive) in UTC, in RFC 3339 format.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	},
+}
+
+var previewTimeZoneResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"time_zone_id": {
+			Description: "The contact time zone.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"windows": {
+			Description: "The windows during which contacts in the time zone may be dialed, in chronological order.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        previewWindowResource,
+		},
+		"earliest_start_time": {
+			Description: "The earliest local time of day a contact in the time zone may be dialed. Format: HH:mm:ss. Empty when there are no windows.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"latest_end_time": {
+			Description: "The latest local time of day dialing ends for a contact in the time zone, 24:00:00 when dialing continues past midnight. Format: HH:mm:ss. Empty when there are no windows.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"dialable_minutes": {
+			Description: "The total number of minutes contacts in the time zone may be dialed in the range.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+	},
+}
+
+// DataSourceOutboundCallabletimesetPreview registers the genesyscloud_outbound_callabletimeset_preview data source
+func DataSourceOutboundCallabletimesetPreview() *schema.Resource {
+	return &schema.Resource{
+		Description: "Data source for previewing when contacts may be dialed under a Genesys Cloud Outbound Callable Timeset. The time slots of the callable timeset are expanded locally, " +
+			"each in the time zone of its callable time, and the resulting windows between start_date and end_date are reported in the local time of each contact time zone. " +
+			"This allows compliance rules, such as calling hours, to be asserted in check blocks.",
+		ReadContext: provider.ReadWithPooledClient(dataSourceOutboundCallabletimesetPreviewRead),
+		Schema: map[string]*schema.Schema{
+			"callable_time_set_id": {
+				Description: "ID of the callable timeset to preview.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"start_date": {
+				Description:      "First day of the previewed range in each contact time zone. Format: yyyy-MM-dd",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validators.ValidateDate,
+			},
+			"end_date": {
+				Description:      "Last day (inclusive) of the previewed range in each contact time zone. Format: yyyy-MM-dd",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validators.ValidateDate,
+			},
+			"contact_time_zones": {
+				Description: "IANA time zones of the contacts to preview, e.g. America/New_York.",
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"time_zones": {
+				Description: "The dialable windows of each contact time zone, in the order of contact_time_zones.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        previewTimeZoneResource,
+			},
+		},
+	}
+}
@@ -30,6 +30,7 @@ type getOutboundCampaignIdByNameFunc func(ctx context.Context, p *outboundCampai
 type getOutboundCampaignByIdFunc func(ctx context.Context, p *outboundCampaignProxy, id string) (campaign *platformclientv2.Campaign, response *platformclientv2.APIResponse, err error)
 type updateOutboundCampaignFunc func(ctx context.Context, p *outboundCampaignProxy, id string, campaign *platformclientv2.Campaign) (*platformclientv2.Campaign, *platformclientv2.APIResponse, error)
 type deleteOutboundCampaignFunc func(ctx context.Context, p *outboundCampaignProxy, id string) (response *platformclientv2.APIResponse, err error)
+type getOutboundCampaignStatsFunc func(ctx context.Context, p *outboundCampaignProxy, id string) (*platformclientv2.Campaignstats, *platformclientv2.APIResponse, error)
 
 // outboundCampaignProxy contains all of the methods that call genesys cloud APIs.
 type outboundCampaignProxy struct {
@@ -41,6 +42,7 @@ type outboundCampaignProxy struct {
 	getOutboundCampaignByIdAttr     getOutboundCampaignByIdFunc
 	updateOutboundCampaignAttr      updateOutboundCampaignFunc
 	deleteOutboundCampaignAttr      deleteOutboundCampaignFunc
+	getOutboundCampaignStatsAttr    getOutboundCampaignStatsFunc
 	campaignCache                   rc.CacheInterface[platformclientv2.Campaign]
 }
 
@@ -57,6 +59,7 @@ func newOutboundCampaignProxy(clientConfig *platformclientv2.Configuration) *out
 		getOutboundCampaignByIdAttr:     getOutboundCampaignByIdFn,
 		updateOutboundCampaignAttr:      updateOutboundCampaignFn,
 		deleteOutboundCampaignAttr:      deleteOutboundCampaignFn,
+		getOutboundCampaignStatsAttr:    getOutboundCampaignStatsFn,
 		campaignCache:                   campaignCache,
 	}
 }
@@ -103,6 +106,11 @@ func (p *outboundCampaignProxy) deleteOutboundCampaign(ctx context.Context, id s
 	return p.deleteOutboundCampaignAttr(ctx, p, id)
 }
 
+// getOutboundCampaignStats returns the live statistics of a Genesys Cloud outbound campaign
+func (p *outboundCampaignProxy) getOutboundCampaignStats(ctx context.Context, id string) (*platformclientv2.Campaignstats, *platformclientv2.APIResponse, error) {
+	return p.getOutboundCampaignStatsAttr(ctx, p, id)
+}
+
 // turnOffCampaign sets a campaign's campaign_status to 'off' before confirming the update using retry logic and get calls
 func (p *outboundCampaignProxy) turnOffCampaign(ctx context.Context, campaignId string) diag.Diagnostics {
 	log.Printf("Reading Outbound Campaign %s", campaignId)
@@ -222,6 +230,15 @@ func updateOutboundCampaignFn(ctx context.Context, p *outboundCampaignProxy, id
 	return outboundCampaign, resp, nil
 }
 
+// getOutboundCampaignStatsFn is an implementation function for retrieving the live statistics of a Genesys Cloud outbound campaign
+func getOutboundCampaignStatsFn(_ context.Context, p *outboundCampaignProxy, id string) (*platformclientv2.Campaignstats, *platformclientv2.APIResponse, error) {
+	stats, resp, err := p.outboundApi.GetOutboundCampaignStats(id)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to get stats of campaign %s: %s", id, err)
+	}
+	return stats, resp, nil
+}
+
 // deleteOutboundCampaignFn is an implementation function for deleting a Genesys Cloud outbound campaign
 func deleteOutboundCampaignFn(_ context.Context, p *outboundCampaignProxy, id string) (response *platformclientv2.APIResponse, err error) {
 	_, resp, err := p.outboundApi.DeleteOutboundCampaign(id)
@@ -9,6 +9,7 @@ import (
 	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
 	"terraform-provider-genesyscloud/genesyscloud/util"
 	"terraform-provider-genesyscloud/genesyscloud/util/constants"
+	"terraform-provider-genesyscloud/genesyscloud/util/lifecycle"
 	"terraform-provider-genesyscloud/genesyscloud/util/resourcedata"
 	"time"
 
@@ -153,6 +154,7 @@ func readOutboundCampaign(ctx context.Context, d *schema.ResourceData, meta inte
 			_ = d.Set("skill_columns", *campaign.SkillColumns)
 		}
 		resourcedata.SetNillableValue(d, "auto_answer", campaign.CallbackAutoAnswer)
+		lifecycle.SetDefaultDrainTimeout(d)
 		log.Printf("Read Outbound Campaign %s %s", d.Id(), *campaign.Name)
 		return cc.CheckState(d)
 	})
@@ -166,6 +168,13 @@ func updateOutboundCampaign(ctx context.Context, d *schema.ResourceData, meta in
 
 	campaign := getOutboundCampaignFromResourceData(d)
 
+	// A running campaign is stopped and drained before it is changed. updateOutboundCampaignStatus restores its status afterwards.
+	if d.HasChangesExcept("drain_timeout_seconds") {
+		if _, diagErr := newOutboundCampaignOrchestrator(proxy, d).StopIfRunning(ctx); diagErr != nil {
+			return diagErr
+		}
+	}
+
 	log.Printf("Updating Outbound Campaign %s", *campaign.Name)
 	campaignSdk, resp, err := proxy.updateOutboundCampaign(ctx, d.Id(), &campaign)
 	if err != nil {
@@ -5,6 +5,7 @@ import (
 	"terraform-provider-genesyscloud/genesyscloud/provider"
 	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
 	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
+	"terraform-provider-genesyscloud/genesyscloud/util/lifecycle"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -51,6 +52,7 @@ func ResourceOutboundCampaign() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		SchemaVersion: 1,
+		CustomizeDiff: validateCampaignColumnsDiff,
 		Schema: map[string]*schema.Schema{
 			`name`: {
 				Description: `The name of the Campaign.`,
@@ -58,7 +60,7 @@ func ResourceOutboundCampaign() *schema.Resource {
 				Type:        schema.TypeString,
 			},
 			`contact_list_id`: {
-				Description: `The ContactList for this Campaign to dial.`,
+				Description: `The ContactList for this Campaign to dial. The columns referenced by phone_columns, contact_sorts and skill_columns must exist in the column_names of the contact list, and contact_sorts must be selected for dynamic queueing when dynamic_contact_queueing_settings sorts contacts. This is verified at plan time, or during apply when the contact list is created in the same apply.`,
 				Required:    true,
 				Type:        schema.TypeString,
 			},
@@ -89,7 +91,7 @@ func ResourceOutboundCampaign() *schema.Resource {
 				Type:        schema.TypeString,
 			},
 			`campaign_status`: {
-				Description:  `The current status of the Campaign. A Campaign may be turned 'on' or 'off' (default). If this value is changed alongside other changes to the resource, a subsequent update will occur immediately afterwards to set the campaign status. This is due to behavioral requirements in the Genesys Cloud API.`,
+				Description:  `The current status of the Campaign. A Campaign may be turned 'on' or 'off' (default). A campaign that is on is turned off and drained before other changes are applied, and is turned back on afterwards unless campaign_status is changed to 'off'. See drain_timeout_seconds.`,
 				Optional:     true,
 				Type:         schema.TypeString,
 				Computed:     true,
@@ -98,6 +100,13 @@ func ResourceOutboundCampaign() *schema.Resource {
 					return (old == `complete` && new == `off`) || (old == `invalid` && new == `off`) || (old == `stopping` && new == `off` || old == `complete` && new == `on`)
 				},
 			},
+			`drain_timeout_seconds`: {
+				Description:  `The number of seconds to wait for a running campaign to stop and for its active calls to finish before the campaign is changed. The campaign is turned back on once the change is applied.`,
+				Optional:     true,
+				Default:      lifecycle.DefaultDrainTimeoutSeconds,
+				Type:         schema.TypeInt,
+				ValidateFunc: validation.IntBetween(30, 3600),
+			},
 			`phone_columns`: {
 				Description: `The ContactPhoneNumberColumns on the ContactList that this Campaign should dial.`,
 				Required:    true,
@@ -13,8 +13,10 @@ import (
 	routingQueue "terraform-provider-genesyscloud/genesyscloud/routing_queue"
 	routingWrapupcode "terraform-provider-genesyscloud/genesyscloud/routing_wrapupcode"
 	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/lifecycle"
 	"terraform-provider-genesyscloud/genesyscloud/util/lists"
 	"terraform-provider-genesyscloud/genesyscloud/util/resourcedata"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -110,6 +112,45 @@ func updateOutboundCampaignStatus(ctx context.Context, campaignId string, proxy
 	return nil
 }
 
+// newOutboundCampaignOrchestrator returns the lifecycle orchestrator used to stop and drain a running campaign before it is changed
+func newOutboundCampaignOrchestrator(proxy *outboundCampaignProxy, d *schema.ResourceData) *lifecycle.Orchestrator {
+	campaignId := d.Id()
+	return &lifecycle.Orchestrator{
+		ResourceType: ResourceType,
+		Name:         fmt.Sprintf("Outbound Campaign %s", campaignId),
+		GetStatus: func(ctx context.Context) (string, *platformclientv2.APIResponse, error) {
+			campaign, resp, err := proxy.getOutboundCampaignById(ctx, campaignId)
+			if err != nil {
+				return "", resp, err
+			}
+			if campaign == nil || campaign.CampaignStatus == nil {
+				return "", resp, fmt.Errorf("no status returned for outbound campaign %s", campaignId)
+			}
+			return *campaign.CampaignStatus, resp, nil
+		},
+		SetStatus: func(ctx context.Context, status string) (*platformclientv2.APIResponse, error) {
+			campaign, resp, err := proxy.getOutboundCampaignById(ctx, campaignId)
+			if err != nil {
+				return resp, err
+			}
+			campaign.CampaignStatus = &status
+			_, resp, err = proxy.updateOutboundCampaign(ctx, campaignId, campaign)
+			return resp, err
+		},
+		ActiveCount: func(ctx context.Context) (int, error) {
+			stats, _, err := proxy.getOutboundCampaignStats(ctx, campaignId)
+			if err != nil {
+				return 0, err
+			}
+			if stats.OutstandingCalls == nil {
+				return 0, nil
+			}
+			return *stats.OutstandingCalls, nil
+		},
+		DrainTimeout: time.Duration(d.Get("drain_timeout_seconds").(int)) * time.Second,
+	}
+}
+
 func buildPhoneColumns(phonecolumns []interface{}) *[]platformclientv2.Phonecolumn {
 	if len(phonecolumns) == 0 {
 		return nil
@@ -456,3 +497,11 @@ resource "genesyscloud_outbound_callabletimeset" "%s"{
 %s
 `, contactList, dncList, queue, callAnalysisResponseSet, contactListFilter, site, ruleSet, callableTimeSet)
 }
+
+// validateCampaignColumnsDiff checks that the columns referenced by the campaign exist in its contact list
+func validateCampaignColumnsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("contact_list_id") || !diff.HasChanges("contact_list_id", "phone_columns", "contact_sorts", "skill_columns", "dynamic_contact_queueing_settings") {
+		return nil
+	}
+	return obContactList.ValidateColumnReferences(ctx, meta, diff.Get("contact_list_id").(string), obContactList.BuildCampaignColumnReferences(diff.Get))
+}
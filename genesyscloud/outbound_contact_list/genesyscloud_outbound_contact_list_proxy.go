@@ -36,6 +36,9 @@ type uploadContactListBulkContactsFunc func(ctx context.Context, p *OutboundCont
 type clearContactListContactsFunc func(ctx context.Context, p *OutboundContactlistProxy, contactListId string) (*platformclientv2.APIResponse, error)
 type getContactListContactsExportUrlFunc func(ctx context.Context, p *OutboundContactlistProxy, contactListId string) (exportUrl string, resp *platformclientv2.APIResponse, error error)
 type initiateContactListContactsExportFunc func(ctx context.Context, p *OutboundContactlistProxy, contactListId string) (resp *platformclientv2.APIResponse, error error)
+type upsertContactListContactsFunc func(ctx context.Context, p *OutboundContactlistProxy, contactListId string, contacts []platformclientv2.Writabledialercontact) (*platformclientv2.APIResponse, error)
+type deleteContactListContactsFunc func(ctx context.Context, p *OutboundContactlistProxy, contactListId string, contactIds []string) (*platformclientv2.APIResponse, error)
+type getContactListTemplateByIdFunc func(ctx context.Context, p *OutboundContactlistProxy, templateId string) (*platformclientv2.Contactlisttemplate, *platformclientv2.APIResponse, error)
 
 // OutboundContactListProxy defines the interface for outbound contact list operations
 type OutboundContactlistProxy struct {
@@ -54,6 +57,9 @@ type OutboundContactlistProxy struct {
 	accessToken                                   string
 	getContactListContactsExportUrlAttr           getContactListContactsExportUrlFunc
 	initiateContactListContactsExportAttr         initiateContactListContactsExportFunc
+	upsertContactListContactsAttr                 upsertContactListContactsFunc
+	deleteContactListContactsAttr                 deleteContactListContactsFunc
+	getContactListTemplateByIdAttr                getContactListTemplateByIdFunc
 	contactListCache                              rc.CacheInterface[platformclientv2.Contactlist]
 }
 
@@ -76,6 +82,9 @@ func newOutboundContactlistProxy(clientConfig *platformclientv2.Configuration) *
 		accessToken:                                   api.Configuration.AccessToken,
 		getContactListContactsExportUrlAttr:           getContactListContactsExportUrlFn,
 		initiateContactListContactsExportAttr:         initiateContactListContactsExportFn,
+		upsertContactListContactsAttr:                 upsertContactListContactsFn,
+		deleteContactListContactsAttr:                 deleteContactListContactsFn,
+		getContactListTemplateByIdAttr:                getContactListTemplateByIdFn,
 		contactListCache:                              contactListCache,
 	}
 }
@@ -161,6 +170,21 @@ func (p *OutboundContactlistProxy) getContactListContactsExportUrl(ctx context.C
 	return p.getContactListContactsExportUrlAttr(ctx, p, contactListId)
 }
 
+// upsertContactListContacts adds contacts to a contact list or updates them if they already exist, preserving their system data
+func (p *OutboundContactlistProxy) upsertContactListContacts(ctx context.Context, contactListId string, contacts []platformclientv2.Writabledialercontact) (*platformclientv2.APIResponse, error) {
+	return p.upsertContactListContactsAttr(ctx, p, contactListId, contacts)
+}
+
+// deleteContactListContacts deletes contacts from a contact list
+func (p *OutboundContactlistProxy) deleteContactListContacts(ctx context.Context, contactListId string, contactIds []string) (*platformclientv2.APIResponse, error) {
+	return p.deleteContactListContactsAttr(ctx, p, contactListId, contactIds)
+}
+
+// getContactListTemplateById returns the Genesys Cloud outbound contact list template a contact list inherits from
+func (p *OutboundContactlistProxy) getContactListTemplateById(ctx context.Context, templateId string) (*platformclientv2.Contactlisttemplate, *platformclientv2.APIResponse, error) {
+	return p.getContactListTemplateByIdAttr(ctx, p, templateId)
+}
+
 // createOutboundContactlistFn is an implementation function for creating a Genesys Cloud outbound contactlist
 func createOutboundContactlistFn(ctx context.Context, p *OutboundContactlistProxy, outboundContactlist *platformclientv2.Contactlist) (*platformclientv2.Contactlist, *platformclientv2.APIResponse, error) {
 	return p.outboundApi.PostOutboundContactlists(*outboundContactlist)
@@ -318,6 +342,29 @@ func getContactListContactsExportUrlFn(_ context.Context, p *OutboundContactlist
 	return *data.Uri, resp, nil
 }
 
+// upsertContactListContactsFn is an implementation function for adding or updating contacts without clearing their system data
+func upsertContactListContactsFn(_ context.Context, p *OutboundContactlistProxy, contactListId string, contacts []platformclientv2.Writabledialercontact) (*platformclientv2.APIResponse, error) {
+	_, resp, err := p.outboundApi.PostOutboundContactlistContacts(contactListId, contacts, false, false, false)
+	if err != nil {
+		return resp, fmt.Errorf("failed to add or update %d contacts on contact list %s: %s", len(contacts), contactListId, err)
+	}
+	return resp, nil
+}
+
+// deleteContactListContactsFn is an implementation function for deleting contacts from a contact list
+func deleteContactListContactsFn(_ context.Context, p *OutboundContactlistProxy, contactListId string, contactIds []string) (*platformclientv2.APIResponse, error) {
+	resp, err := p.outboundApi.DeleteOutboundContactlistContacts(contactListId, contactIds)
+	if err != nil {
+		return resp, fmt.Errorf("failed to delete %d contacts from contact list %s: %s", len(contactIds), contactListId, err)
+	}
+	return resp, nil
+}
+
+// getContactListTemplateByIdFn is an implementation of the function to get a Genesys Cloud outbound contact list template by Id
+func getContactListTemplateByIdFn(_ context.Context, p *OutboundContactlistProxy, templateId string) (*platformclientv2.Contactlisttemplate, *platformclientv2.APIResponse, error) {
+	return p.outboundApi.GetOutboundContactlisttemplate(templateId)
+}
+
 // createBulkOutboundContactsFormData creates the form data attributes to create a bulk upload of contacts in Genesys Cloud
 func createBulkOutboundContactsFormData(filePath, contactListId, contactIdColumnName string) (map[string]io.Reader, error) {
 	fileReader, _, err := files.DownloadOrOpenFile(filePath)
@@ -270,6 +270,24 @@ func uploadOutboundContactListBulkContacts(ctx context.Context, d *schema.Resour
 				return diag.Errorf("Failed to get CSV record count: %v", err)
 			}
 
+			if d.Get("contacts_sync_mode").(string) == contactsSyncModeSync && !d.IsNewResource() {
+				diff, diagErr := syncOutboundContactListContacts(ctx, d, meta, filePath)
+				if diagErr != nil {
+					return diagErr
+				}
+
+				contactCount, diagErr := validateContactsRecordCount(ctx, cp, contactListId, csvRecordsCount)
+				if diagErr != nil {
+					return diagErr
+				}
+
+				_ = d.Set("contacts_file_content_hash", filePathHash)
+				_ = d.Set("contacts_record_count", contactCount)
+				_ = d.Set("contacts_sync_counts", diff.counts())
+				return nil
+			}
+			previousRecordCount := d.Get("contacts_record_count").(int)
+
 			log.Printf("Clearing existing contacts on contact list %s in preparation for updating the latest contacts", contactListName)
 			resp, err := cp.clearContactListContacts(ctx, d.Id())
 			if err != nil {
@@ -294,6 +312,12 @@ func uploadOutboundContactListBulkContacts(ctx context.Context, d *schema.Resour
 
 			d.Set("contacts_file_content_hash", filePathHash)
 			d.Set("contacts_record_count", contactCount)
+			d.Set("contacts_sync_counts", map[string]interface{}{
+				"added":     csvRecordsCount,
+				"updated":   0,
+				"deleted":   previousRecordCount,
+				"unchanged": 0,
+			})
 		}
 	}
 	return nil
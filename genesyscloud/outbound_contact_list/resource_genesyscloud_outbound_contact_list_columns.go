@@ -0,0 +1,269 @@
+package outbound_contact_list
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v154/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_outbound_contact_list_columns.go file verifies the column names referenced by the outbound
+resources (contact lists, campaigns, rule sets, contact list filters and sequences) at plan time, before the API rejects
+them or, worse, before they fail at dial time.
+A contact list verifies the columns it references against its planned column_names. Other resources verify them against
+the contact list read from the API on each plan. Contact lists created by the same apply are verified once they exist.
+*/
+
+// ContactListColumns are the column names of a contact list
+type ContactListColumns struct {
+	Name string
+	// ColumnNames are the names of the contact data columns
+	ColumnNames []string
+	// DynamicQueueingColumns are the columns selected for dynamic queueing in column_data_type_specifications
+	DynamicQueueingColumns []string
+}
+
+// ColumnReference is a column name referenced by an attribute of another outbound resource
+type ColumnReference struct {
+	// Path is the path of the attribute the column is referenced from, e.g. phone_columns[0].column_name
+	Path cty.Path
+	// ColumnName is the name of the referenced column
+	ColumnName string
+	// DynamicQueueing is true when the column must also be selected for dynamic queueing
+	DynamicQueueing bool
+}
+
+// validateContactListColumnsDiff checks that the columns referenced by the contact list itself exist in column_names
+func validateContactListColumnsDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if !diff.NewValueKnown("column_names") {
+		return nil
+	}
+	columns := ContactListColumns{
+		Name:        diff.Get("name").(string),
+		ColumnNames: lists.InterfaceListToStrings(diff.Get("column_names").([]interface{})),
+	}
+
+	// Phone and email columns are sets, so their references point to the attribute
+	var references []ColumnReference
+	if phoneColumns, ok := diff.Get("phone_columns").(*schema.Set); ok {
+		for _, column := range phoneColumns.List() {
+			columnMap, _ := column.(map[string]interface{})
+			references = append(references, NewColumnReference(cty.GetAttrPath("phone_columns"), columnMap["column_name"], false)...)
+			references = append(references, NewColumnReference(cty.GetAttrPath("phone_columns"), columnMap["callable_time_column"], false)...)
+		}
+	}
+	if emailColumns, ok := diff.Get("email_columns").(*schema.Set); ok {
+		for _, column := range emailColumns.List() {
+			columnMap, _ := column.(map[string]interface{})
+			references = append(references, NewColumnReference(cty.GetAttrPath("email_columns"), columnMap["column_name"], false)...)
+			references = append(references, NewColumnReference(cty.GetAttrPath("email_columns"), columnMap["contactable_time_column"], false)...)
+		}
+	}
+	references = append(references, NewColumnReference(cty.GetAttrPath("preview_mode_column_name"), diff.Get("preview_mode_column_name"), false)...)
+	references = append(references, NewColumnReference(cty.GetAttrPath("zip_code_column_name"), diff.Get("zip_code_column_name"), false)...)
+	if specifications, ok := diff.Get("column_data_type_specifications").([]interface{}); ok {
+		for i, specification := range specifications {
+			specificationMap, _ := specification.(map[string]interface{})
+			if columnName, _ := specificationMap["column_name"].(string); columnName != "" {
+				columns.DynamicQueueingColumns = append(columns.DynamicQueueingColumns, columnName)
+			}
+			references = append(references, NewColumnReference(cty.GetAttrPath("column_data_type_specifications").IndexInt(i).GetAttr("column_name"), specificationMap["column_name"], false)...)
+		}
+	}
+
+	return columns.verify(references)
+}
+
+// ValidateColumnReferences checks that the referenced columns exist in the contact list read from the API. Contact lists
+// that cannot be found (e.g. created by the same apply) are skipped, as other validation reports those.
+func ValidateColumnReferences(ctx context.Context, meta interface{}, contactListId string, references []ColumnReference) error {
+	if contactListId == "" || len(references) == 0 {
+		return nil
+	}
+	providerMeta, ok := meta.(*provider.ProviderMeta)
+	if !ok || providerMeta == nil {
+		return nil
+	}
+
+	columns, found, err := getContactListColumns(ctx, providerMeta.ClientConfig, contactListId)
+	if err != nil {
+		return fmt.Errorf("failed to verify the columns referenced from %s %s: %s", ResourceType, contactListId, err)
+	}
+	if !found {
+		return nil
+	}
+	return columns.verify(references)
+}
+
+// getContactListColumns reads the columns of a contact list from the API
+func getContactListColumns(ctx context.Context, clientConfig *platformclientv2.Configuration, contactListId string) (ContactListColumns, bool, error) {
+	proxy := GetOutboundContactlistProxy(clientConfig)
+	contactList, resp, err := proxy.GetOutboundContactlistById(ctx, contactListId)
+	if err != nil {
+		if util.IsStatus404(resp) {
+			return ContactListColumns{}, false, nil
+		}
+		return ContactListColumns{}, false, err
+	}
+
+	columns := ContactListColumns{Name: contactListId}
+	if contactList.Name != nil {
+		columns.Name = *contactList.Name
+	}
+	if contactList.ColumnNames != nil {
+		columns.ColumnNames = *contactList.ColumnNames
+	}
+	if contactList.ColumnDataTypeSpecifications != nil {
+		for _, specification := range *contactList.ColumnDataTypeSpecifications {
+			if specification.ColumnName != nil {
+				columns.DynamicQueueingColumns = append(columns.DynamicQueueingColumns, *specification.ColumnName)
+			}
+		}
+	}
+	return columns, true, nil
+}
+
+// verify reports the references to columns that do not exist in the contact list. CustomizeDiff can only report a single
+// error, so the error is attached to the path of the first invalid reference and lists the others.
+func (c ContactListColumns) verify(references []ColumnReference) error {
+	var (
+		firstPath cty.Path
+		messages  []string
+	)
+	for _, reference := range references {
+		var message string
+		if !lists.ItemInSlice(reference.ColumnName, c.ColumnNames) {
+			message = fmt.Sprintf("column %q does not exist in %s %q", reference.ColumnName, ResourceType, c.Name)
+		} else if reference.DynamicQueueing && !lists.ItemInSlice(reference.ColumnName, c.DynamicQueueingColumns) {
+			message = fmt.Sprintf("column %q is not selected for dynamic queueing in the column_data_type_specifications of %s %q", reference.ColumnName, ResourceType, c.Name)
+		} else {
+			continue
+		}
+		if firstPath == nil {
+			firstPath = reference.Path
+		} else {
+			message = fmt.Sprintf("%s: %s", FormatColumnReferencePath(reference.Path), message)
+		}
+		messages = append(messages, message)
+	}
+	if len(messages) == 0 {
+		return nil
+	}
+	return firstPath.NewErrorf("%s", strings.Join(messages, "; "))
+}
+
+// BuildCampaignColumnReferences returns the contact list columns referenced by a campaign, from its outbound_campaign
+// attributes. Contact sorts of a campaign sorting contacts dynamically must be columns selected for dynamic queueing.
+func BuildCampaignColumnReferences(get func(key string) interface{}) []ColumnReference {
+	var references []ColumnReference
+
+	phoneColumns, _ := get("phone_columns").([]interface{})
+	for i, phoneColumn := range phoneColumns {
+		if phoneColumnMap, ok := phoneColumn.(map[string]interface{}); ok {
+			references = append(references, NewColumnReference(cty.GetAttrPath("phone_columns").IndexInt(i).GetAttr("column_name"), phoneColumnMap["column_name"], false)...)
+		}
+	}
+
+	dynamicSort := false
+	if settings, ok := get("dynamic_contact_queueing_settings").([]interface{}); ok && len(settings) > 0 {
+		if settingsMap, ok := settings[0].(map[string]interface{}); ok {
+			dynamicSort, _ = settingsMap["sort"].(bool)
+		}
+	}
+	contactSorts, _ := get("contact_sorts").([]interface{})
+	for i, contactSort := range contactSorts {
+		if contactSortMap, ok := contactSort.(map[string]interface{}); ok {
+			references = append(references, NewColumnReference(cty.GetAttrPath("contact_sorts").IndexInt(i).GetAttr("field_name"), contactSortMap["field_name"], dynamicSort)...)
+		}
+	}
+
+	skillColumns, _ := get("skill_columns").([]interface{})
+	references = append(references, NewColumnReferences(cty.GetAttrPath("skill_columns"), lists.InterfaceListToStrings(skillColumns), false)...)
+	return references
+}
+
+// CampaignColumnsGetter returns the outbound_campaign attributes referencing contact list columns of a campaign read from
+// the API, for BuildCampaignColumnReferences
+func CampaignColumnsGetter(campaign *platformclientv2.Campaign) func(key string) interface{} {
+	return func(key string) interface{} {
+		values := make([]interface{}, 0)
+		switch key {
+		case "phone_columns":
+			if campaign.PhoneColumns != nil {
+				for _, phoneColumn := range *campaign.PhoneColumns {
+					phoneColumnMap := map[string]interface{}{}
+					if phoneColumn.ColumnName != nil {
+						phoneColumnMap["column_name"] = *phoneColumn.ColumnName
+					}
+					values = append(values, phoneColumnMap)
+				}
+			}
+		case "contact_sorts":
+			if campaign.ContactSorts != nil {
+				for _, contactSort := range *campaign.ContactSorts {
+					contactSortMap := map[string]interface{}{}
+					if contactSort.FieldName != nil {
+						contactSortMap["field_name"] = *contactSort.FieldName
+					}
+					values = append(values, contactSortMap)
+				}
+			}
+		case "dynamic_contact_queueing_settings":
+			if campaign.DynamicContactQueueingSettings != nil && campaign.DynamicContactQueueingSettings.Sort != nil {
+				values = append(values, map[string]interface{}{"sort": *campaign.DynamicContactQueueingSettings.Sort})
+			}
+		case "skill_columns":
+			if campaign.SkillColumns != nil {
+				values = lists.StringListToInterfaceList(*campaign.SkillColumns)
+			}
+		}
+		return values
+	}
+}
+
+// NewColumnReference builds the reference to a non empty column name
+func NewColumnReference(path cty.Path, columnName interface{}, dynamicQueueing bool) []ColumnReference {
+	name, _ := columnName.(string)
+	if name == "" {
+		return nil
+	}
+	return []ColumnReference{{Path: path, ColumnName: name, DynamicQueueing: dynamicQueueing}}
+}
+
+// NewColumnReferences builds the references to the column names of a list attribute
+func NewColumnReferences(path cty.Path, columnNames []string, dynamicQueueing bool) []ColumnReference {
+	var references []ColumnReference
+	for i, columnName := range columnNames {
+		references = append(references, NewColumnReference(path.IndexInt(i), columnName, dynamicQueueing)...)
+	}
+	return references
+}
+
+// FormatColumnReferencePath formats the path of a column reference the way it is written in the configuration, e.g.
+// phone_columns[0].column_name
+func FormatColumnReferencePath(path cty.Path) string {
+	var builder strings.Builder
+	for _, step := range path {
+		switch step := step.(type) {
+		case cty.GetAttrStep:
+			if builder.Len() > 0 {
+				builder.WriteString(".")
+			}
+			builder.WriteString(step.Name)
+		case cty.IndexStep:
+			if step.Key.Type() == cty.Number {
+				builder.WriteString(fmt.Sprintf("[%s]", step.Key.AsBigFloat().Text('f', 0)))
+			} else if step.Key.Type() == cty.String {
+				builder.WriteString(fmt.Sprintf("[%q]", step.Key.AsString()))
+			}
+		}
+	}
+	return builder.String()
+}
@@ -0,0 +1,77 @@
+package outbound_contact_list
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/mypurecloud/platform-client-sdk-go/v154/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitContactListValidateColumnReferences(t *testing.T) {
+	var lookups int
+	internalProxy = &OutboundContactlistProxy{
+		getOutboundContactlistByIdAttr: func(_ context.Context, p *OutboundContactlistProxy, id string) (*platformclientv2.Contactlist, *platformclientv2.APIResponse, error) {
+			lookups++
+			if id != "org-list-id" {
+				return nil, &platformclientv2.APIResponse{StatusCode: http.StatusNotFound}, assert.AnError
+			}
+			return &platformclientv2.Contactlist{
+				Name:        platformclientv2.String("Org list"),
+				ColumnNames: &[]string{"id", "phone", "zip"},
+				ColumnDataTypeSpecifications: &[]platformclientv2.Columndatatypespecification{
+					{ColumnName: platformclientv2.String("zip")},
+				},
+			}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+		},
+	}
+	defer func() { internalProxy = nil }()
+
+	meta := &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}
+	sortPath := cty.GetAttrPath("contact_sorts")
+	skillPath := cty.GetAttrPath("skill_columns")
+
+	// Errors are attached to the first invalid reference and list the others
+	references := append(NewColumnReferences(sortPath, []string{"zip", "phone"}, true), NewColumnReferences(skillPath, []string{"language", ""}, false)...)
+	err := ValidateColumnReferences(context.Background(), meta, "org-list-id", references)
+	var pathErr cty.PathError
+	if assert.True(t, errors.As(err, &pathErr)) {
+		assert.Equal(t, sortPath.IndexInt(1), pathErr.Path)
+	}
+	assert.EqualError(t, err, `column "phone" is not selected for dynamic queueing in the column_data_type_specifications of genesyscloud_outbound_contact_list "Org list"; `+
+		`skill_columns[0]: column "language" does not exist in genesyscloud_outbound_contact_list "Org list"`)
+
+	// Contact lists are read from the API on each validation
+	assert.NoError(t, ValidateColumnReferences(context.Background(), meta, "org-list-id", NewColumnReferences(cty.GetAttrPath("phone_columns"), []string{"phone"}, false)))
+	assert.Equal(t, 2, lookups)
+
+	// Unknown contact lists are left to other validation
+	assert.NoError(t, ValidateColumnReferences(context.Background(), meta, "missing-list-id", NewColumnReferences(cty.GetAttrPath("phone_columns"), []string{"phone"}, false)))
+}
+
+func TestUnitFormatColumnReferencePath(t *testing.T) {
+	path := cty.GetAttrPath("rules").IndexInt(0).GetAttr("actions").IndexInt(2).GetAttr("properties").Index(cty.StringVal("callerAddress"))
+	assert.Equal(t, `rules[0].actions[2].properties["callerAddress"]`, FormatColumnReferencePath(path))
+}
+
+func TestUnitBuildCampaignColumnReferences(t *testing.T) {
+	references := BuildCampaignColumnReferences(CampaignColumnsGetter(&platformclientv2.Campaign{
+		PhoneColumns: &[]platformclientv2.Phonecolumn{{ColumnName: platformclientv2.String("Cell")}, {}},
+		ContactSorts: &[]platformclientv2.Contactsort{{FieldName: platformclientv2.String("Priority")}},
+		DynamicContactQueueingSettings: &platformclientv2.Dynamiccontactqueueingsettings{
+			Sort: platformclientv2.Bool(true),
+		},
+		SkillColumns: &[]string{"Language"},
+	}))
+
+	assert.Equal(t, []ColumnReference{
+		{Path: cty.GetAttrPath("phone_columns").IndexInt(0).GetAttr("column_name"), ColumnName: "Cell"},
+		{Path: cty.GetAttrPath("contact_sorts").IndexInt(0).GetAttr("field_name"), ColumnName: "Priority", DynamicQueueing: true},
+		{Path: cty.GetAttrPath("skill_columns").IndexInt(0), ColumnName: "Language"},
+	}, references)
+}
@@ -0,0 +1,251 @@
+package outbound_contact_list
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/mail"
+	"regexp"
+	"strconv"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
+	"terraform-provider-genesyscloud/genesyscloud/validators"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nyaruka/phonenumbers"
+)
+
+/*
+The resource_genesyscloud_outbound_contact_list_csv_validation.go file contains the plan time validation of the rows of
+the contacts file against the column definitions of the contact list. Problems are reported with the CSV line they were
+found on so that the file can be fixed before it is uploaded.
+*/
+
+// maxReportedContactsCsvIssues limits the number of problems reported for a single contacts file
+const maxReportedContactsCsvIssues = 25
+
+// zipCodeRegexes holds the zip code formats checked for each default country. Zip codes of other countries are not checked.
+var zipCodeRegexes = map[string]*regexp.Regexp{
+	"US": regexp.MustCompile(`^\d{5}(-\d{4})?$`),
+}
+
+var contactsTimestampFormats = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02",
+}
+
+// contactsColumnDataType is the dynamic queueing data type declared for a column
+type contactsColumnDataType struct {
+	dataType  string
+	maxLength int
+}
+
+// contactsCsvRules holds the column definitions the rows of a contacts file are validated against
+type contactsCsvRules struct {
+	idColumn       string
+	phoneColumns   []string
+	emailColumns   []string
+	zipCodeColumn  string
+	dataTypes      map[string]contactsColumnDataType
+	defaultCountry string
+}
+
+func buildContactsCsvRules(diff *schema.ResourceDiff) contactsCsvRules {
+	rules := contactsCsvRules{
+		idColumn:       diff.Get("contacts_id_name").(string),
+		dataTypes:      make(map[string]contactsColumnDataType),
+		defaultCountry: provider.GetOrgDefaultCountryCode(),
+	}
+	if rules.defaultCountry == "" {
+		rules.defaultCountry = "US"
+	}
+
+	if phoneColumns, ok := diff.Get("phone_columns").(*schema.Set); ok {
+		for _, column := range phoneColumns.List() {
+			if columnMap, ok := column.(map[string]interface{}); ok {
+				rules.phoneColumns = append(rules.phoneColumns, columnMap["column_name"].(string))
+			}
+		}
+	}
+	if emailColumns, ok := diff.Get("email_columns").(*schema.Set); ok {
+		for _, column := range emailColumns.List() {
+			if columnMap, ok := column.(map[string]interface{}); ok {
+				rules.emailColumns = append(rules.emailColumns, columnMap["column_name"].(string))
+			}
+		}
+	}
+	if diff.Get("automatic_time_zone_mapping").(bool) {
+		rules.zipCodeColumn = diff.Get("zip_code_column_name").(string)
+	}
+	if specifications, ok := diff.Get("column_data_type_specifications").([]interface{}); ok {
+		for _, specification := range specifications {
+			specificationMap, ok := specification.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			dataType, _ := specificationMap["column_data_type"].(string)
+			maxLength, _ := specificationMap["max_length"].(int)
+			rules.dataTypes[specificationMap["column_name"].(string)] = contactsColumnDataType{dataType: dataType, maxLength: maxLength}
+		}
+	}
+	return rules
+}
+
+// validateContactsCsvRows reads every row of a contacts file and returns the problems found, with their line numbers
+func validateContactsCsvRows(r io.Reader, rules contactsCsvRules) []error {
+	reader := csv.NewReader(r)
+	reader.LazyQuotes = true
+	reader.TrimLeadingSpace = true
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return []error{fmt.Errorf("failed to read CSV headers: %s", err)}
+	}
+	columnIndexes := make(map[string]int)
+	for i, column := range header {
+		columnIndexes[column] = i
+	}
+
+	var (
+		errs      []error
+		total     int
+		seenIds   = make(map[string]int)
+		addIssues = func(line int, format string, a ...interface{}) {
+			total++
+			if total <= maxReportedContactsCsvIssues {
+				errs = append(errs, fmt.Errorf("line %d: %s", line, fmt.Sprintf(format, a...)))
+			}
+		}
+		value = func(record []string, column string) (string, bool) {
+			index, ok := columnIndexes[column]
+			if !ok || index >= len(record) {
+				return "", false
+			}
+			return strings.TrimSpace(record[index]), true
+		}
+	)
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			var parseErr *csv.ParseError
+			if errors.As(err, &parseErr) {
+				addIssues(parseErr.Line, "%s", parseErr.Err)
+				continue
+			}
+			return append(errs, err)
+		}
+		line, _ := reader.FieldPos(0)
+
+		if rules.idColumn != "" {
+			if id, ok := value(record, rules.idColumn); ok {
+				if id == "" {
+					addIssues(line, "%s is empty", rules.idColumn)
+				} else if firstLine, ok := seenIds[id]; ok {
+					addIssues(line, "%s %q is already used on line %d", rules.idColumn, id, firstLine)
+				} else {
+					seenIds[id] = line
+				}
+			}
+		}
+
+		for _, column := range rules.phoneColumns {
+			if number, ok := value(record, column); ok && number != "" {
+				if parsed, err := phonenumbers.Parse(number, rules.defaultCountry); err != nil || !phonenumbers.IsValidNumber(parsed) {
+					addIssues(line, "%s %q is not a valid phone number for default country %s", column, number, rules.defaultCountry)
+				}
+			}
+		}
+
+		for _, column := range rules.emailColumns {
+			if email, ok := value(record, column); ok && email != "" {
+				if address, err := mail.ParseAddress(email); err != nil || address.Address != email {
+					addIssues(line, "%s %q is not a valid email address", column, email)
+				}
+			}
+		}
+
+		if zipCodeRegex, ok := zipCodeRegexes[rules.defaultCountry]; ok && rules.zipCodeColumn != "" {
+			if zipCode, ok := value(record, rules.zipCodeColumn); ok && zipCode != "" && !zipCodeRegex.MatchString(zipCode) {
+				addIssues(line, "%s %q is not a valid zip code for default country %s", rules.zipCodeColumn, zipCode, rules.defaultCountry)
+			}
+		}
+
+		for column, dataType := range rules.dataTypes {
+			cell, ok := value(record, column)
+			if !ok || cell == "" {
+				continue
+			}
+			switch dataType.dataType {
+			case "NUMERIC":
+				if _, err := strconv.ParseFloat(cell, 64); err != nil {
+					addIssues(line, "%s %q is not a number", column, cell)
+				}
+			case "TIMESTAMP":
+				if !isContactsTimestamp(cell) {
+					addIssues(line, "%s %q is not a timestamp", column, cell)
+				}
+			case "TEXT":
+				if dataType.maxLength > 0 && len([]rune(cell)) > dataType.maxLength {
+					addIssues(line, "%s is longer than its max_length of %d", column, dataType.maxLength)
+				}
+			}
+		}
+	}
+
+	if total > maxReportedContactsCsvIssues {
+		errs = append(errs, fmt.Errorf("%d more problems were found", total-maxReportedContactsCsvIssues))
+	}
+	return errs
+}
+
+func isContactsTimestamp(value string) bool {
+	for _, format := range contactsTimestampFormats {
+		if _, err := time.Parse(format, value); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// validateContactsCsvContent validates the rows of the contacts file when the file or the column definitions change
+func validateContactsCsvContent(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	filePath, _ := diff.Get("contacts_filepath").(string)
+	if filePath == "" || !diff.NewValueKnown("contacts_filepath") {
+		return nil
+	}
+
+	fileChanged := validators.ValidateFileContentHashChanged("contacts_filepath", "contacts_file_content_hash")(ctx, diff, meta)
+	if !fileChanged && !diff.HasChanges("contacts_id_name", "phone_columns", "email_columns", "automatic_time_zone_mapping", "zip_code_column_name", "column_data_type_specifications") {
+		return nil
+	}
+
+	reader, file, err := files.DownloadOrOpenFile(filePath)
+	if err != nil {
+		// Reported by the contacts_filepath validation
+		return nil
+	}
+	if file != nil {
+		defer file.Close()
+	}
+
+	errs := validateContactsCsvRows(reader, buildContactsCsvRules(diff))
+	if len(errs) == 0 {
+		return nil
+	}
+	for i, err := range errs {
+		errs[i] = fmt.Errorf("contacts_filepath: %s", err)
+	}
+	return fmt.Errorf("invalid contacts in %s:\n%w", filePath, errors.Join(errs...))
+}
@@ -0,0 +1,68 @@
+package outbound_contact_list
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContactListValidateContactsCsvRows(t *testing.T) {
+	rules := contactsCsvRules{
+		idColumn:      "id",
+		phoneColumns:  []string{"cell"},
+		emailColumns:  []string{"email"},
+		zipCodeColumn: "zip",
+		dataTypes: map[string]contactsColumnDataType{
+			"balance": {dataType: "NUMERIC"},
+			"due":     {dataType: "TIMESTAMP"},
+			"name":    {dataType: "TEXT", maxLength: 5},
+		},
+		defaultCountry: "US",
+	}
+
+	valid := "id,cell,email,zip,balance,due,name\n" +
+		"1,(317) 555-0001,alice@example.com,46278,10.5,2024-03-30T17:34:00Z,Alice\n" +
+		"2,+44 20 7946 0958,,46278-1234,-3,2024-03-30,Bob\n" +
+		"3,,bob@example.com,,,,\n"
+	assert.Empty(t, validateContactsCsvRows(strings.NewReader(valid), rules))
+
+	invalid := "id,cell,email,zip,balance,due,name\n" +
+		"1,abc,alice@,4627,ten,30/03/2024,Alice\n" +
+		"1,3175550001,Bob <bob@example.com>,46278,1,2024-03-30,Robert\n" +
+		",3175550002,carol@example.com,46278,1,2024-03-30,Carol\n" +
+		"4,123,,46278,1,2024-03-30,Dan\n"
+	messages := make([]string, 0)
+	for _, err := range validateContactsCsvRows(strings.NewReader(invalid), rules) {
+		messages = append(messages, err.Error())
+	}
+	assert.ElementsMatch(t, []string{
+		`line 2: cell "abc" is not a valid phone number for default country US`,
+		`line 2: email "alice@" is not a valid email address`,
+		`line 2: zip "4627" is not a valid zip code for default country US`,
+		`line 2: balance "ten" is not a number`,
+		`line 2: due "30/03/2024" is not a timestamp`,
+		`line 3: id "1" is already used on line 2`,
+		`line 3: email "Bob <bob@example.com>" is not a valid email address`,
+		`line 3: name is longer than its max_length of 5`,
+		`line 4: id is empty`,
+		`line 5: cell "123" is not a valid phone number for default country US`,
+	}, messages)
+
+	// Zip codes are only checked for countries with a known format
+	rules.defaultCountry = "GB"
+	assert.Empty(t, validateContactsCsvRows(strings.NewReader("id,cell,zip\n1,020 7946 0958,SW1A 1AA\n"), rules))
+}
+
+func TestContactListValidateContactsCsvRowsLimit(t *testing.T) {
+	var builder strings.Builder
+	builder.WriteString("id\n")
+	for i := 0; i < maxReportedContactsCsvIssues+10; i++ {
+		builder.WriteString("1\n")
+	}
+
+	errs := validateContactsCsvRows(strings.NewReader(builder.String()), contactsCsvRules{idColumn: "id"})
+	assert.Len(t, errs, maxReportedContactsCsvIssues+1)
+	assert.EqualError(t, errs[len(errs)-1], fmt.Sprintf("%d more problems were found", 9))
+}
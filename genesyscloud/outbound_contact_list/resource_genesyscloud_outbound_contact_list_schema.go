@@ -1,6 +1,7 @@
 package outbound_contact_list
 
 import (
+	"fmt"
 	"terraform-provider-genesyscloud/genesyscloud/provider"
 	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
 	"terraform-provider-genesyscloud/genesyscloud/validators"
@@ -104,9 +105,16 @@ func ResourceOutboundContactList() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		SchemaVersion: 2,
-		CustomizeDiff: customdiff.All(
-			customdiff.ComputedIf("contacts_file_content_hash", validators.ValidateFileContentHashChanged("contacts_filepath", "contacts_file_content_hash")),
-			validators.ValidateCSVWithColumns("contacts_filepath", "column_names"),
+		// The column check runs last so that its error keeps its attribute path rather than being joined with others
+		CustomizeDiff: customdiff.Sequence(
+			customdiff.All(
+				inheritContactListTemplate,
+				customdiff.ComputedIf("contacts_file_content_hash", validators.ValidateFileContentHashChanged("contacts_filepath", "contacts_file_content_hash")),
+				customdiff.ComputedIf("contacts_sync_counts", validators.ValidateFileContentHashChanged("contacts_filepath", "contacts_file_content_hash")),
+				validators.ValidateCSVWithColumns("contacts_filepath", "column_names"),
+				validateContactsCsvContent,
+			),
+			validateContactListColumnsDiff,
 		),
 		Schema: map[string]*schema.Schema{
 			`name`: {
@@ -120,23 +128,31 @@ func ResourceOutboundContactList() *schema.Resource {
 				Computed:    true,
 				Type:        schema.TypeString,
 			},
+			`template_id`: {
+				Description: `The contact list template the contact list is created from. column_names, phone_columns, email_columns and column_data_type_specifications are inherited from the template unless they are set on the contact list. As these attributes cannot be changed, a change to them in the template replaces every contact list created from it.`,
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
 			`column_names`: {
-				Description: `The names of the contact data columns. Changing the column_names attribute will cause the outbound_contact_list object to be dropped and recreated with a new ID`,
-				Required:    true,
+				Description: `The names of the contact data columns. Changing the column_names attribute will cause the outbound_contact_list object to be dropped and recreated with a new ID. The phone, email, preview mode, zip code and dynamic queueing columns of the contact list must be listed here. Required unless template_id is set.`,
+				Optional:    true,
+				Computed:    true,
 				ForceNew:    true,
 				Type:        schema.TypeList,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
 			`phone_columns`: {
-				Description: `Indicates which columns are phone numbers. Changing the phone_columns attribute will cause the outbound_contact_list object to be dropped and recreated with a new ID. Required if email_columns is empty`,
+				Description: `Indicates which columns are phone numbers. Changing the phone_columns attribute will cause the outbound_contact_list object to be dropped and recreated with a new ID. Required if email_columns is empty. Inherited from template_id when not set`,
 				Optional:    true,
+				Computed:    true,
 				ForceNew:    true,
 				Type:        schema.TypeSet,
 				Elem:        outboundContactListContactPhoneNumberColumnResource,
 			},
 			`email_columns`: {
-				Description: `Indicates which columns are email addresses. Changing the email_columns attribute will cause the outbound_contact_list object to be dropped and recreated with a new ID. Required if phone_columns is empty`,
+				Description: `Indicates which columns are email addresses. Changing the email_columns attribute will cause the outbound_contact_list object to be dropped and recreated with a new ID. Required if phone_columns is empty. Inherited from template_id when not set`,
 				Optional:    true,
+				Computed:    true,
 				ForceNew:    true,
 				Type:        schema.TypeSet,
 				Elem:        outboundContactListEmailColumnResource,
@@ -170,8 +186,9 @@ func ResourceOutboundContactList() *schema.Resource {
 				Type:        schema.TypeString,
 			},
 			`column_data_type_specifications`: {
-				Description: `The settings of the columns selected for dynamic queueing. If updated, the contact list is dropped and recreated with a new ID`,
+				Description: `The settings of the columns selected for dynamic queueing. If updated, the contact list is dropped and recreated with a new ID. Inherited from template_id when not set`,
 				Optional:    true,
+				Computed:    true,
 				ForceNew:    true,
 				Type:        schema.TypeList,
 				Elem:        outboundContactListColumnDataTypeSpecification,
@@ -182,7 +199,7 @@ func ResourceOutboundContactList() *schema.Resource {
 				Type:        schema.TypeBool,
 			},
 			`contacts_filepath`: {
-				Description:  "The path to a CSV file containing contacts to import into the contact list. When updated, existing contacts will be removed and replaced with contacts from the new file. If not specified, an empty contact list will be created.",
+				Description:  "The path to a CSV file containing contacts to import into the contact list. When updated, existing contacts will be removed and replaced with contacts from the new file, unless contacts_sync_mode is sync. The rows are validated at plan time against the contact list: contacts_id_name values must be unique, phone columns must be valid phone numbers for the organization's default country, email columns must be valid email addresses, US zip codes must be valid when automatic_time_zone_mapping is enabled and the organization's default country is US, and numeric and timestamp column_data_type_specifications must match. If not specified, an empty contact list will be created.",
 				Optional:     true,
 				Computed:     false,
 				ForceNew:     false,
@@ -198,6 +215,13 @@ func ResourceOutboundContactList() *schema.Resource {
 				Type:         schema.TypeString,
 				RequiredWith: []string{"contacts_id_name", "contacts_filepath"},
 			},
+			`contacts_sync_mode`: {
+				Description:  fmt.Sprintf(`How contacts are updated when the contents of contacts_filepath change. %s clears the contact list and uploads the whole file, which resets the dialing history of every contact. %s exports the current contacts, compares them with the file on the contacts_id_name column and only adds, updates and deletes the contacts that changed, keeping the dialing history of the others.`, contactsSyncModeReplace, contactsSyncModeSync),
+				Optional:     true,
+				Type:         schema.TypeString,
+				Default:      contactsSyncModeReplace,
+				ValidateFunc: validation.StringInSlice([]string{contactsSyncModeReplace, contactsSyncModeSync}, false),
+			},
 			`contacts_file_content_hash`: {
 				Description: `The hash of the contacts file to import. This is retained as a computed value in the state in order to detect when a file's contents have changed.`,
 				Computed:    true,
@@ -205,6 +229,12 @@ func ResourceOutboundContactList() *schema.Resource {
 				Required:    false,
 				Type:        schema.TypeString,
 			},
+			`contacts_sync_counts`: {
+				Description: `The number of contacts added, updated, deleted and unchanged by the last change of the contacts file, keyed by added, updated, deleted and unchanged. In replace mode every existing contact is counted as deleted and every contact in the file as added.`,
+				Computed:    true,
+				Type:        schema.TypeMap,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
 			`contacts_record_count`: {
 				Description: `The number of contacts in the contact list. This is a read-only attribute and sanity check`,
 				Computed:    true,
@@ -221,11 +251,12 @@ func OutboundContactListExporter() *resourceExporter.ResourceExporter {
 		GetResourcesFunc: provider.GetAllWithPooledClient(getAllOutboundContactLists),
 		RefAttrs: map[string]*resourceExporter.RefAttrSettings{
 			"attempt_limit_id": {RefType: "genesyscloud_outbound_attempt_limit"},
+			"template_id":      {RefType: "genesyscloud_outbound_contact_list_template"},
 			"division_id":      {RefType: "genesyscloud_auth_division"},
 		},
 		CustomFileWriter: resourceExporter.CustomFileWriterSettings{
 			RetrieveAndWriteFilesFunc: ContactsExporterResolver,
-			SubDirectory:              "contacts",
+			SubDirectory:              "outbound_contacts",
 		},
 	}
 }
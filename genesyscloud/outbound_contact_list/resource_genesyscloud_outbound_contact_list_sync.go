@@ -0,0 +1,229 @@
+package outbound_contact_list
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/chunks"
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
+	"terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v154/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_outbound_contact_list_sync.go file contains the row level synchronisation of contacts.
+Instead of clearing the contact list and uploading the whole CSV file, the current contacts are exported and compared
+locally with the new file on the contacts_id_name column. Only the contacts that were added, changed or removed are
+sent to the API, so the dialing history of unchanged contacts is kept.
+*/
+
+const (
+	contactsSyncModeReplace = "replace"
+	contactsSyncModeSync    = "sync"
+
+	// exportedContactIdColumn is the column holding the contact ID in contact list exports
+	exportedContactIdColumn = "inin-outbound-id"
+
+	// The API accepts up to 1000 contacts per add request and 100 contact IDs per delete request
+	contactsUpsertChunkSize = 1000
+	contactsDeleteChunkSize = 100
+)
+
+// contactsDiff holds the contacts to add, update and delete to make a contact list match a CSV file
+type contactsDiff struct {
+	added     []platformclientv2.Writabledialercontact
+	updated   []platformclientv2.Writabledialercontact
+	deleted   []string
+	unchanged int
+}
+
+// counts returns the number of contacts of each kind of change, as stored in contacts_sync_counts
+func (c *contactsDiff) counts() map[string]interface{} {
+	return map[string]interface{}{
+		"added":     len(c.added),
+		"updated":   len(c.updated),
+		"deleted":   len(c.deleted),
+		"unchanged": c.unchanged,
+	}
+}
+
+// readContactsCsv reads the columns of a contacts CSV file keyed by the values of the id column
+func readContactsCsv(r io.Reader, idColumn string, columnNames []string, trimWhitespace bool) (map[string]map[string]string, error) {
+	reader := csv.NewReader(r)
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CSV header: %s", err)
+	}
+
+	columnIndexes := make(map[string]int)
+	for i, column := range header {
+		columnIndexes[strings.TrimSpace(column)] = i
+	}
+	idIndex, ok := columnIndexes[idColumn]
+	if !ok {
+		return nil, fmt.Errorf("CSV file has no %s column", idColumn)
+	}
+
+	contacts := make(map[string]map[string]string)
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CSV: %s", err)
+		}
+		line, _ := reader.FieldPos(0)
+
+		id := strings.TrimSpace(record[idIndex])
+		if id == "" {
+			return nil, fmt.Errorf("line %d: contact has no value in the %s column", line, idColumn)
+		}
+		if _, ok := contacts[id]; ok {
+			return nil, fmt.Errorf("line %d: contact %s is listed more than once", line, id)
+		}
+
+		data := make(map[string]string, len(columnNames))
+		for _, column := range columnNames {
+			index, ok := columnIndexes[column]
+			if !ok || index >= len(record) {
+				continue
+			}
+			value := record[index]
+			if trimWhitespace {
+				value = strings.TrimSpace(value)
+			}
+			data[column] = value
+		}
+		contacts[id] = data
+	}
+	return contacts, nil
+}
+
+// diffContacts compares the current contacts of a contact list with the contacts of the new CSV file
+func diffContacts(contactListId string, current, desired map[string]map[string]string) *contactsDiff {
+	diff := &contactsDiff{}
+	for id, data := range desired {
+		currentData, exists := current[id]
+		if exists && contactDataEqual(currentData, data) {
+			diff.unchanged++
+			continue
+		}
+
+		contact := platformclientv2.Writabledialercontact{
+			Id:            platformclientv2.String(id),
+			ContactListId: platformclientv2.String(contactListId),
+			Data:          &data,
+		}
+		if exists {
+			diff.updated = append(diff.updated, contact)
+		} else {
+			diff.added = append(diff.added, contact)
+		}
+	}
+	for id := range current {
+		if _, ok := desired[id]; !ok {
+			diff.deleted = append(diff.deleted, id)
+		}
+	}
+	return diff
+}
+
+func contactDataEqual(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for column, value := range a {
+		if other, ok := b[column]; !ok || other != value {
+			return false
+		}
+	}
+	return true
+}
+
+// syncOutboundContactListContacts applies the row level differences between the contact list and the contacts file
+func syncOutboundContactListContacts(ctx context.Context, d *schema.ResourceData, meta interface{}, filePath string) (*contactsDiff, diag.Diagnostics) {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	cp := GetOutboundContactlistProxy(sdkConfig)
+
+	contactListId := d.Id()
+	columnNames := lists.InterfaceListToStrings(d.Get("column_names").([]interface{}))
+	trimWhitespace := d.Get("trim_whitespace").(bool)
+
+	exportDirectory, err := os.MkdirTemp("", "contact-list-sync-")
+	if err != nil {
+		return nil, diag.Errorf("Failed to create directory for the current contacts of contact list %s: %v", contactListId, err)
+	}
+	defer os.RemoveAll(exportDirectory)
+
+	exportFileName := contactListId + ".csv"
+	if err := downloadContactListContacts(ctx, cp, contactListId, exportDirectory, exportFileName, sdkConfig.AccessToken); err != nil {
+		return nil, diag.Errorf("Failed to export the current contacts of contact list %s: %v", contactListId, err)
+	}
+
+	currentFile, err := os.Open(filepath.Join(exportDirectory, exportFileName))
+	if err != nil {
+		return nil, diag.Errorf("Failed to open the current contacts of contact list %s: %v", contactListId, err)
+	}
+	defer currentFile.Close()
+	current, err := readContactsCsv(currentFile, exportedContactIdColumn, columnNames, false)
+	if err != nil {
+		return nil, diag.Errorf("Failed to read the current contacts of contact list %s: %v", contactListId, err)
+	}
+
+	reader, file, err := files.DownloadOrOpenFile(filePath)
+	if err != nil {
+		return nil, diag.Errorf("Failed to open contacts file %s: %v", filePath, err)
+	}
+	if file != nil {
+		defer file.Close()
+	}
+	desired, err := readContactsCsv(reader, d.Get("contacts_id_name").(string), columnNames, trimWhitespace)
+	if err != nil {
+		return nil, diag.Errorf("Failed to read contacts file %s: %v", filePath, err)
+	}
+
+	diff := diffContacts(contactListId, current, desired)
+	log.Printf("Synchronising contact list %s: %d added, %d updated, %d deleted, %d unchanged", contactListId, len(diff.added), len(diff.updated), len(diff.deleted), diff.unchanged)
+
+	upsertChunk := func(chunk []platformclientv2.Writabledialercontact) diag.Diagnostics {
+		if len(chunk) == 0 {
+			return nil
+		}
+		if resp, err := cp.upsertContactListContacts(ctx, contactListId, chunk); err != nil {
+			return util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to synchronise contacts on contact list %s error: %s", contactListId, err), resp)
+		}
+		return nil
+	}
+	if diagErr := chunks.ProcessChunks(chunks.ChunkBy(diff.added, contactsUpsertChunkSize), upsertChunk); diagErr != nil {
+		return nil, diagErr
+	}
+	if diagErr := chunks.ProcessChunks(chunks.ChunkBy(diff.updated, contactsUpsertChunkSize), upsertChunk); diagErr != nil {
+		return nil, diagErr
+	}
+
+	deleteChunk := func(chunk []string) diag.Diagnostics {
+		if len(chunk) == 0 {
+			return nil
+		}
+		if resp, err := cp.deleteContactListContacts(ctx, contactListId, chunk); err != nil {
+			return util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to delete contacts from contact list %s error: %s", contactListId, err), resp)
+		}
+		return nil
+	}
+	if diagErr := chunks.ProcessChunks(chunks.ChunkBy(diff.deleted, contactsDeleteChunkSize), deleteChunk); diagErr != nil {
+		return nil, diagErr
+	}
+
+	return diff, nil
+}
@@ -0,0 +1,95 @@
+package outbound_contact_list
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v154/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContactListReadContactsCsv(t *testing.T) {
+	columnNames := []string{"id", "phone", "name"}
+
+	contacts, err := readContactsCsv(strings.NewReader("id,phone,name,extra\n1,+13175550001, Alice ,x\n2,+13175550002,Bob,y\n"), "id", columnNames, true)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]map[string]string{
+		"1": {"id": "1", "phone": "+13175550001", "name": "Alice"},
+		"2": {"id": "2", "phone": "+13175550002", "name": "Bob"},
+	}, contacts)
+
+	_, err = readContactsCsv(strings.NewReader("id,phone,name\n1,+13175550001,Alice\n1,+13175550002,Bob\n"), "id", columnNames, false)
+	assert.EqualError(t, err, "line 3: contact 1 is listed more than once")
+
+	_, err = readContactsCsv(strings.NewReader("phone,name\n+13175550001,Alice\n"), "id", columnNames, false)
+	assert.Error(t, err)
+}
+
+func TestContactListSyncOutboundContactListContacts(t *testing.T) {
+	tempDir := t.TempDir()
+	contactListId := "test-contact-list"
+
+	contactsFile := filepath.Join(tempDir, "contacts.csv")
+	err := os.WriteFile(contactsFile, []byte("id,phone,name\n1,+13175550001,Alice\n2,+13175550099,Bob\n4,+13175550004,Dan\n"), 0644)
+	assert.NoError(t, err)
+
+	var (
+		upserted []string
+		deleted  []string
+	)
+	testProxy := &OutboundContactlistProxy{
+		initiateContactListContactsExportAttr: func(_ context.Context, p *OutboundContactlistProxy, contactListId string) (*platformclientv2.APIResponse, error) {
+			return &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+		},
+		getContactListContactsExportUrlAttr: func(_ context.Context, p *OutboundContactlistProxy, contactListId string) (string, *platformclientv2.APIResponse, error) {
+			return "http://test-url.com/export", &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+		},
+		upsertContactListContactsAttr: func(_ context.Context, p *OutboundContactlistProxy, id string, contacts []platformclientv2.Writabledialercontact) (*platformclientv2.APIResponse, error) {
+			assert.Equal(t, contactListId, id)
+			for _, contact := range contacts {
+				upserted = append(upserted, *contact.Id)
+			}
+			return &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+		},
+		deleteContactListContactsAttr: func(_ context.Context, p *OutboundContactlistProxy, id string, contactIds []string) (*platformclientv2.APIResponse, error) {
+			deleted = append(deleted, contactIds...)
+			return &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+		},
+	}
+	internalProxy = testProxy
+	defer func() { internalProxy = nil }()
+
+	// The export holds the current contacts with system columns
+	origDownloadFile := files.DownloadExportFileWithAccessToken
+	files.DownloadExportFileWithAccessToken = func(directory, filename, url, accessToken string) (*platformclientv2.APIResponse, error) {
+		content := "inin-outbound-id,id,phone,name,ContactCallable\n1,1,+13175550001,Alice,1\n2,2,+13175550002,Bob,1\n3,3,+13175550003,Carol,1\n"
+		return nil, os.WriteFile(filepath.Join(directory, filename), []byte(content), 0644)
+	}
+	defer func() { files.DownloadExportFileWithAccessToken = origDownloadFile }()
+
+	d := schema.TestResourceDataRaw(t, ResourceOutboundContactList().Schema, map[string]interface{}{
+		"name":               "test",
+		"column_names":       []interface{}{"id", "phone", "name"},
+		"contacts_filepath":  contactsFile,
+		"contacts_id_name":   "id",
+		"contacts_sync_mode": contactsSyncModeSync,
+	})
+	d.SetId(contactListId)
+
+	diff, diagErr := syncOutboundContactListContacts(context.Background(), d, &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}, contactsFile)
+	assert.False(t, diagErr.HasError())
+
+	sort.Strings(upserted)
+	assert.Equal(t, []string{"2", "4"}, upserted)
+	assert.Equal(t, []string{"3"}, deleted)
+	assert.Equal(t, map[string]interface{}{"added": 1, "updated": 1, "deleted": 1, "unchanged": 1}, diff.counts())
+}
@@ -0,0 +1,108 @@
+package outbound_contact_list
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v154/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_outbound_contact_list_template_inheritance.go file resolves the columns a contact list inherits
+from the contact list template referenced by template_id. Attributes set in the configuration override the template.
+The inherited values are planned like configured ones, so a change to the template shows up as a diff, and as a
+replacement, on every contact list created from it.
+*/
+
+// templateInheritedAttributes are the attributes a contact list inherits from its template unless they are configured
+var templateInheritedAttributes = []string{"column_names", "phone_columns", "email_columns", "column_data_type_specifications"}
+
+// inheritContactListTemplate plans the attributes that are not configured with the values of the template
+func inheritContactListTemplate(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	rawConfig := diff.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return nil
+	}
+
+	// Blocks that are not configured are empty rather than null
+	var inherited []string
+	for _, attribute := range templateInheritedAttributes {
+		if value := rawConfig.GetAttr(attribute); value.IsKnown() && (value.IsNull() || value.LengthInt() == 0) {
+			inherited = append(inherited, attribute)
+		}
+	}
+	if len(inherited) == 0 {
+		return nil
+	}
+
+	// The values of a template that is being created or replaced are only known once it has been applied
+	if !diff.NewValueKnown("template_id") {
+		for _, attribute := range inherited {
+			if err := diff.SetNewComputed(attribute); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	templateId := diff.Get("template_id").(string)
+	values := contactListTemplateValues(nil)
+	if templateId == "" {
+		if lists.ItemInSlice("column_names", inherited) {
+			return fmt.Errorf("column_names: must be set unless template_id is set")
+		}
+	} else {
+		providerMeta, ok := meta.(*provider.ProviderMeta)
+		if !ok || providerMeta == nil {
+			return nil
+		}
+		proxy := GetOutboundContactlistProxy(providerMeta.ClientConfig)
+		template, resp, err := proxy.getContactListTemplateById(ctx, templateId)
+		if err != nil {
+			if util.IsStatus404(resp) {
+				return fmt.Errorf("template_id: genesyscloud_outbound_contact_list_template %s does not exist", templateId)
+			}
+			return fmt.Errorf("template_id: failed to read genesyscloud_outbound_contact_list_template %s: %s", templateId, err)
+		}
+		values = contactListTemplateValues(template)
+	}
+
+	// Attributes that are not configured and have no template value are planned empty, as they were before inheritance
+	for _, attribute := range inherited {
+		if err := diff.SetNew(attribute, values[attribute]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// contactListTemplateValues flattens the inherited attributes of a template, or returns empty values without a template
+func contactListTemplateValues(template *platformclientv2.Contactlisttemplate) map[string]interface{} {
+	values := map[string]interface{}{
+		"column_names":                    []interface{}{},
+		"phone_columns":                   []interface{}{},
+		"email_columns":                   []interface{}{},
+		"column_data_type_specifications": []interface{}{},
+	}
+	if template == nil {
+		return values
+	}
+
+	if template.ColumnNames != nil {
+		values["column_names"] = lists.StringListToInterfaceList(*template.ColumnNames)
+	}
+	if template.PhoneColumns != nil && len(*template.PhoneColumns) > 0 {
+		values["phone_columns"] = flattenSdkOutboundContactListContactPhoneNumberColumnSlice(*template.PhoneColumns).List()
+	}
+	if template.EmailColumns != nil && len(*template.EmailColumns) > 0 {
+		values["email_columns"] = flattenSdkOutboundContactListContactEmailAddressColumnSlice(*template.EmailColumns).List()
+	}
+	if template.ColumnDataTypeSpecifications != nil && len(*template.ColumnDataTypeSpecifications) > 0 {
+		values["column_data_type_specifications"] = flattenSdkOutboundContactListColumnDataTypeSpecifications(*template.ColumnDataTypeSpecifications)
+	}
+	return values
+}
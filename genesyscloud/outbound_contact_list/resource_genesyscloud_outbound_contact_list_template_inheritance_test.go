@@ -0,0 +1,109 @@
+package outbound_contact_list
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v154/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContactListInheritTemplate(t *testing.T) {
+	internalProxy = &OutboundContactlistProxy{
+		getContactListTemplateByIdAttr: func(_ context.Context, p *OutboundContactlistProxy, templateId string) (*platformclientv2.Contactlisttemplate, *platformclientv2.APIResponse, error) {
+			if templateId != "template-id" {
+				return nil, &platformclientv2.APIResponse{StatusCode: http.StatusNotFound}, assert.AnError
+			}
+			return &platformclientv2.Contactlisttemplate{
+				ColumnNames: &[]string{"id", "Cell", "Email"},
+				PhoneColumns: &[]platformclientv2.Contactphonenumbercolumn{
+					{ColumnName: platformclientv2.String("Cell"), VarType: platformclientv2.String("cell")},
+				},
+				EmailColumns: &[]platformclientv2.Emailcolumn{
+					{ColumnName: platformclientv2.String("Email"), VarType: platformclientv2.String("work")},
+				},
+			}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+		},
+	}
+	defer func() { internalProxy = nil }()
+	meta := &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}
+
+	// Columns that are not configured are inherited from the template
+	diff, err := diffContactList(t, meta, map[string]interface{}{"name": "test", "template_id": "template-id"})
+	assert.NoError(t, err)
+	assert.Equal(t, "3", diff.Attributes["column_names.#"].New)
+	assert.Equal(t, "Cell", diff.Attributes["column_names.1"].New)
+	assert.Equal(t, "1", diff.Attributes["phone_columns.#"].New)
+	assert.Equal(t, "1", diff.Attributes["email_columns.#"].New)
+
+	// A template change replaces the contact lists created from it
+	diff, err = diffContactListState(t, meta, map[string]interface{}{"name": "test", "template_id": "template-id"}, map[string]string{
+		"id":             "list-id",
+		"name":           "test",
+		"template_id":    "template-id",
+		"column_names.#": "2",
+		"column_names.0": "id",
+		"column_names.1": "Cell",
+	})
+	assert.NoError(t, err)
+	assert.True(t, diff.RequiresNew())
+	assert.True(t, diff.Attributes["column_names.#"].RequiresNew)
+
+	// Configured columns override the template
+	diff, err = diffContactList(t, meta, map[string]interface{}{"name": "test", "template_id": "template-id", "column_names": []interface{}{"id", "Cell", "Email", "Extra"}})
+	assert.NoError(t, err)
+	assert.Equal(t, "4", diff.Attributes["column_names.#"].New)
+	assert.Equal(t, "1", diff.Attributes["phone_columns.#"].New)
+
+	// column_names is required without a template
+	_, err = diffContactList(t, meta, map[string]interface{}{"name": "test"})
+	assert.ErrorContains(t, err, "column_names: must be set unless template_id is set")
+
+	_, err = diffContactList(t, meta, map[string]interface{}{"name": "test", "template_id": "missing-id"})
+	assert.ErrorContains(t, err, "template_id: genesyscloud_outbound_contact_list_template missing-id does not exist")
+}
+
+// diffContactList plans a new contact list with the given configuration
+func diffContactList(t *testing.T, meta interface{}, config map[string]interface{}) (*terraform.InstanceDiff, error) {
+	return diffContactListState(t, meta, config, nil)
+}
+
+// diffContactListState plans a contact list with the given configuration and state attributes
+func diffContactListState(t *testing.T, meta interface{}, config map[string]interface{}, stateAttributes map[string]string) (*terraform.InstanceDiff, error) {
+	resource := ResourceOutboundContactList()
+	objectType := resource.CoreConfigSchema().ImpliedType()
+
+	attributes := make(map[string]cty.Value)
+	for name, attributeType := range objectType.AttributeTypes() {
+		switch {
+		case attributeType.IsSetType():
+			attributes[name] = cty.SetValEmpty(attributeType.ElementType())
+		case attributeType.IsListType() && attributeType.ElementType().IsObjectType():
+			attributes[name] = cty.ListValEmpty(attributeType.ElementType())
+		default:
+			attributes[name] = cty.NullVal(attributeType)
+		}
+	}
+	for name, value := range config {
+		switch v := value.(type) {
+		case string:
+			attributes[name] = cty.StringVal(v)
+		case []interface{}:
+			values := make([]cty.Value, 0, len(v))
+			for _, item := range v {
+				values = append(values, cty.StringVal(item.(string)))
+			}
+			attributes[name] = cty.ListVal(values)
+		default:
+			t.Fatalf("unsupported config value %v", value)
+		}
+	}
+
+	state := &terraform.InstanceState{ID: stateAttributes["id"], Attributes: stateAttributes, RawConfig: cty.ObjectVal(attributes)}
+	return resource.Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), meta)
+}
@@ -203,7 +203,41 @@ func ContactsExporterResolver(resourceId, exportDirectory, subDirectory string,
 		return fmt.Errorf("failed to create directory %s: %w", fullDirectoryPath, err)
 	}
 
-	ctx := context.Background()
+	if err := downloadContactListContacts(context.Background(), cp, contactListId, fullDirectoryPath, exportFileName, sdkConfig.AccessToken); err != nil {
+		return err
+	}
+
+	fullCurrentPath := filepath.Join(fullDirectoryPath, exportFileName)
+	fullRelativePath := filepath.Join(subDirectory, exportFileName)
+	configMap["contacts_filepath"] = fullRelativePath
+	configMap["contacts_id_name"] = exportedContactIdColumn
+
+	// Remove read only attributes from the config file
+	delete(configMap, "contacts_file_content_hash")
+	delete(configMap, "contacts_record_count")
+	delete(configMap, "contacts_sync_counts")
+	hash, err := files.HashFileContent(fullCurrentPath)
+	if err != nil {
+		log.Printf("Error calculating file content hash: %v", err)
+		return err
+	}
+	resource.State.Attributes["contacts_file_content_hash"] = hash
+
+	recordCount, err := files.GetCSVRecordCount(fullCurrentPath)
+	if err != nil {
+		log.Printf("Error getting CSV record count: %v", err)
+		return err
+	}
+	resource.State.Attributes["contacts_record_count"] = strconv.Itoa(recordCount)
+
+	resource.State.Attributes["contacts_filepath"] = fullRelativePath
+	resource.State.Attributes["contacts_id_name"] = exportedContactIdColumn
+
+	return nil
+}
+
+// downloadContactListContacts exports the contacts of a contact list and downloads them as a CSV file
+func downloadContactListContacts(ctx context.Context, cp *OutboundContactlistProxy, contactListId, directory, fileName, accessToken string) error {
 	var exportUrl string
 	diagErr := util.RetryWhen(util.IsStatus404, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
 		resp, err := cp.initiateContactListContactsExport(ctx, contactListId)
@@ -231,7 +265,7 @@ func ContactsExporterResolver(resourceId, exportDirectory, subDirectory string,
 		return fmt.Errorf(`Error retrieving contact list export url: %v`, diagErr)
 	}
 	diagErr = util.RetryWhen(util.IsStatus404, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
-		resp, err := files.DownloadExportFileWithAccessToken(fullDirectoryPath, exportFileName, exportUrl, sdkConfig.AccessToken)
+		resp, err := files.DownloadExportFileWithAccessToken(directory, fileName, exportUrl, accessToken)
 		if err != nil {
 			return resp, diag.FromErr(err)
 		}
@@ -240,32 +274,6 @@ func ContactsExporterResolver(resourceId, exportDirectory, subDirectory string,
 	if diagErr != nil {
 		return fmt.Errorf(`Error downloading exported contacts: %v`, diagErr)
 	}
-
-	fullCurrentPath := filepath.Join(fullDirectoryPath, exportFileName)
-	fullRelativePath := filepath.Join(subDirectory, exportFileName)
-	configMap["contacts_filepath"] = fullRelativePath
-	configMap["contacts_id_name"] = "inin-outbound-id"
-
-	// Remove read only attributes from the config file
-	delete(configMap, "contacts_file_content_hash")
-	delete(configMap, "contacts_record_count")
-	hash, err := files.HashFileContent(fullCurrentPath)
-	if err != nil {
-		log.Printf("Error calculating file content hash: %v", err)
-		return err
-	}
-	resource.State.Attributes["contacts_file_content_hash"] = hash
-
-	recordCount, err := files.GetCSVRecordCount(fullCurrentPath)
-	if err != nil {
-		log.Printf("Error getting CSV record count: %v", err)
-		return err
-	}
-	resource.State.Attributes["contacts_record_count"] = strconv.Itoa(recordCount)
-
-	resource.State.Attributes["contacts_filepath"] = fullRelativePath
-	resource.State.Attributes["contacts_id_name"] = "inin-outbound-id"
-
 	return nil
 }
 
@@ -128,6 +128,7 @@ func ResourceOutboundContactlistfilter() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		SchemaVersion: 1,
+		CustomizeDiff: validateContactlistfilterColumnsDiff,
 		Schema: map[string]*schema.Schema{
 			`name`: {
 				Description: `The name of the list.`,
@@ -135,7 +136,7 @@ func ResourceOutboundContactlistfilter() *schema.Resource {
 				Type:        schema.TypeString,
 			},
 			`contact_list_id`: {
-				Description:  `The contact list the filter is based on. Mutually exclusive to 'contact_list_template_id', however, one of the two must be specified`,
+				Description:  `The contact list the filter is based on. Mutually exclusive to 'contact_list_template_id', however, one of the two must be specified. The predicate columns must exist in the column_names of the contact list, which is verified at plan time, or during apply when the contact list is created in the same apply.`,
 				Optional:     true,
 				Type:         schema.TypeString,
 				ExactlyOneOf: []string{"contact_list_id", "contact_list_template_id"},
@@ -1,11 +1,14 @@
 package outbound_contactlistfilter
 
 import (
+	"context"
 	"fmt"
 	"strings"
+	obContactList "terraform-provider-genesyscloud/genesyscloud/outbound_contact_list"
 	"terraform-provider-genesyscloud/genesyscloud/util"
 	"terraform-provider-genesyscloud/genesyscloud/util/resourcedata"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/mypurecloud/platform-client-sdk-go/v154/platformclientv2"
 )
@@ -241,3 +244,30 @@ func GenerateOutboundContactListFilterPredicates(
 		}
 `, column, columnType, operator, value, inverted, varRangeBlock)
 }
+
+// validateContactlistfilterColumnsDiff checks that the columns of the predicates exist in the contact list of the filter
+func validateContactlistfilterColumnsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("contact_list_id") || !diff.HasChanges("contact_list_id", "clauses") {
+		return nil
+	}
+	return obContactList.ValidateColumnReferences(ctx, meta, diff.Get("contact_list_id").(string), buildContactlistfilterColumnReferences(diff.Get("clauses").([]interface{})))
+}
+
+// buildContactlistfilterColumnReferences returns the contact list columns referenced by the predicates of the clauses
+func buildContactlistfilterColumnReferences(clauses []interface{}) []obContactList.ColumnReference {
+	var references []obContactList.ColumnReference
+	for i, clause := range clauses {
+		clauseMap, ok := clause.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		predicates, _ := clauseMap["predicates"].([]interface{})
+		for j, predicate := range predicates {
+			if predicateMap, ok := predicate.(map[string]interface{}); ok {
+				path := cty.GetAttrPath("clauses").IndexInt(i).GetAttr("predicates").IndexInt(j).GetAttr("column")
+				references = append(references, obContactList.NewColumnReference(path, predicateMap["column"], false)...)
+			}
+		}
+	}
+	return references
+}
@@ -0,0 +1,114 @@
+package outbound_digitalruleset
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/lists"
+	"terraform-provider-genesyscloud/genesyscloud/util/resourcedata"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v154/platformclientv2"
+)
+
+/*
+   The data_source_genesyscloud_outbound_digitalruleset_evaluation.go contains the data source implementation
+   for evaluating the rules of a digital rule set against a sample contact.
+*/
+
+// dataSourceOutboundDigitalrulesetEvaluationRead evaluates the rules of the digital rule set against the contact
+func dataSourceOutboundDigitalrulesetEvaluationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var rules []platformclientv2.Digitalrule
+	if rulesetId := d.Get("ruleset_id").(string); rulesetId != "" {
+		sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+		proxy := getOutboundDigitalrulesetProxy(sdkConfig)
+
+		ruleset, resp, err := proxy.getOutboundDigitalrulesetById(ctx, rulesetId)
+		if err != nil {
+			return util.BuildAPIDiagnosticError(EvaluationDataSourceType, fmt.Sprintf("failed to read digital rule set %s | error: %s", rulesetId, err), resp)
+		}
+		if ruleset.Rules != nil {
+			rules = *ruleset.Rules
+		}
+	} else {
+		ruleMaps, err := resourcedata.DecodeJsonBlocks(d.Get("rules_json").(string), digitalRuleResource)
+		if err != nil {
+			return util.BuildDiagnosticError(EvaluationDataSourceType, "invalid rules_json", err)
+		}
+		rules = *buildDigitalRules(ruleMaps)
+	}
+
+	now := time.Now()
+	if evaluationTime := d.Get("evaluation_time").(string); evaluationTime != "" {
+		now, _ = time.Parse(time.RFC3339, evaluationTime)
+	}
+
+	evalCtx := digitalEvaluationContext{
+		contact:              stringMap(d.Get("contact")),
+		mediaType:            d.Get("media_type").(string),
+		contactAddressColumn: d.Get("contact_address_column").(string),
+		lastAttempts:         stringMap(d.Get("last_attempts")),
+		lastResults:          stringMap(d.Get("last_results")),
+		dataActionOutputs:    stringMap(d.Get("data_action_outputs")),
+		now:                  now,
+	}
+
+	results, actions, updatedContact, err := evaluateDigitalRules(rules, d.Get("category").(string), evalCtx)
+	if err != nil {
+		return util.BuildDiagnosticError(EvaluationDataSourceType, "failed to evaluate the rules", err)
+	}
+
+	flattenedRules, firedRuleNames := flattenDigitalRuleResults(results)
+
+	id, _ := json.Marshal([]interface{}{d.Get("ruleset_id"), d.Get("rules_json"), d.Get("category"), evalCtx.contact})
+	d.SetId(fmt.Sprintf("%x", sha256.Sum256(id)))
+	_ = d.Set("rules", flattenedRules)
+	_ = d.Set("fired_rule_names", firedRuleNames)
+	_ = d.Set("actions", flattenDigitalActionResults(actions))
+	_ = d.Set("updated_contact", updatedContact)
+	return nil
+}
+
+// flattenDigitalRuleResults maps the rule results to the rules and fired_rule_names attributes
+func flattenDigitalRuleResults(results []digitalRuleResult) ([]interface{}, []string) {
+	rules := make([]interface{}, 0, len(results))
+	firedRuleNames := make([]string, 0)
+	for _, result := range results {
+		rules = append(rules, map[string]interface{}{
+			"name":              stringValue(result.rule.Name),
+			"order":             intValue(result.rule.Order),
+			"category":          stringValue(result.rule.Category),
+			"fired":             result.fired,
+			"condition_results": result.conditionResults,
+		})
+		if result.fired {
+			firedRuleNames = append(firedRuleNames, stringValue(result.rule.Name))
+		}
+	}
+	return rules, firedRuleNames
+}
+
+// flattenDigitalActionResults maps the action results to the actions attribute
+func flattenDigitalActionResults(actions []digitalActionResult) []interface{} {
+	flattened := make([]interface{}, 0, len(actions))
+	for _, action := range actions {
+		flattened = append(flattened, map[string]interface{}{
+			"rule_name":     action.ruleName,
+			"type":          action.actionType,
+			"update_option": action.updateOption,
+			"properties":    action.properties,
+		})
+	}
+	return flattened
+}
+
+// stringMap converts a TypeMap of strings to a map[string]string
+func stringMap(value interface{}) map[string]string {
+	values, _ := value.(map[string]interface{})
+	return lists.ConvertMapStringAnyToMapStringString(values)
+}
@@ -0,0 +1,103 @@
+package outbound_digitalruleset
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+// testEvaluationDigitalRulesJson is shaped like jsonencode() of the rules of a genesyscloud_outbound_digitalruleset
+const testEvaluationDigitalRulesJson = `[
+  {
+    "name": "Opted out",
+    "order": 0,
+    "category": "PreContact",
+    "conditions": [
+      {"inverted": false, "contact_column_condition_settings": [{"column_name": "opt_in", "operator": "Equals", "value": "false", "value_type": "String"}]}
+    ],
+    "actions": [
+      {"do_not_send_action_settings": "{}", "update_contact_column_action_settings": []}
+    ]
+  },
+  {
+    "name": "Recently emailed",
+    "order": 1,
+    "category": "PreContact",
+    "conditions": [
+      {"inverted": false, "last_attempt_by_column_condition_settings": [{"email_column_name": "email", "sms_column_name": "mobile", "operator": "After", "value": "P7D"}]},
+      {"inverted": true, "contact_address_type_condition_settings": [{"operator": "Equals", "value": "Sms"}]}
+    ],
+    "actions": [
+      {"update_contact_column_action_settings": [{"update_option": "Set", "properties": "{\"skipped\":\"true\"}"}]}
+    ]
+  },
+  {
+    "name": "Bounced",
+    "order": 2,
+    "category": "PostContact",
+    "conditions": [
+      {"inverted": false, "last_result_overall_condition_settings": [{"email_wrapup_codes": ["bounce"], "sms_wrapup_codes": null}]}
+    ],
+    "actions": [
+      {"update_contact_column_action_settings": [{"update_option": "Increment", "properties": "{\"bounces\":\"1\"}"}]}
+    ]
+  }
+]`
+
+func TestUnitDataSourceOutboundDigitalrulesetEvaluationRead(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, DataSourceOutboundDigitalrulesetEvaluation().Schema, map[string]interface{}{
+		"rules_json":      testEvaluationDigitalRulesJson,
+		"category":        "PreContact",
+		"media_type":      "Email",
+		"evaluation_time": "2024-06-10T12:00:00Z",
+		"contact": map[string]interface{}{
+			"opt_in": "true",
+			"email":  "user@example.com",
+		},
+		"last_attempts": map[string]interface{}{
+			"email": "2024-06-08T09:00:00Z",
+		},
+	})
+
+	diags := dataSourceOutboundDigitalrulesetEvaluationRead(context.Background(), d, nil)
+	assert.False(t, diags.HasError(), diags)
+	assert.NotEmpty(t, d.Id())
+
+	assert.Equal(t, 2, d.Get("rules.#"))
+	assert.Equal(t, false, d.Get("rules.0.fired"))
+	assert.Equal(t, []interface{}{true, true}, d.Get("rules.1.condition_results"))
+	assert.Equal(t, []interface{}{"Recently emailed"}, d.Get("fired_rule_names"))
+
+	assert.Equal(t, 1, d.Get("actions.#"))
+	assert.Equal(t, "update_contact_column", d.Get("actions.0.type"))
+	assert.Equal(t, "true", d.Get("updated_contact.skipped"))
+}
+
+func TestUnitDataSourceOutboundDigitalrulesetEvaluationPostContact(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, DataSourceOutboundDigitalrulesetEvaluation().Schema, map[string]interface{}{
+		"rules_json":   testEvaluationDigitalRulesJson,
+		"category":     "PostContact",
+		"contact":      map[string]interface{}{"bounces": "1"},
+		"last_results": map[string]interface{}{"Email": "bounce"},
+	})
+
+	diags := dataSourceOutboundDigitalrulesetEvaluationRead(context.Background(), d, nil)
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, []interface{}{"Bounced"}, d.Get("fired_rule_names"))
+	assert.Equal(t, "2", d.Get("updated_contact.bounces"))
+}
+
+func TestUnitDataSourceOutboundDigitalrulesetEvaluationMissingInput(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, DataSourceOutboundDigitalrulesetEvaluation().Schema, map[string]interface{}{
+		"rules_json": testEvaluationDigitalRulesJson,
+		"category":   "PreContact",
+		"contact":    map[string]interface{}{"opt_in": "false"},
+	})
+
+	diags := dataSourceOutboundDigitalrulesetEvaluationRead(context.Background(), d, nil)
+	if assert.True(t, diags.HasError()) {
+		assert.Contains(t, diags[0].Detail, `condition 1: a last attempt by column condition requires media_type`)
+	}
+}
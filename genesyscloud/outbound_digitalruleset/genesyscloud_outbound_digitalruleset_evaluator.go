@@ -0,0 +1,283 @@
+package outbound_digitalruleset
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	obRuleset "terraform-provider-genesyscloud/genesyscloud/outbound_ruleset"
+	"terraform-provider-genesyscloud/genesyscloud/util/lists"
+	"time"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v154/platformclientv2"
+)
+
+/*
+The genesyscloud_outbound_digitalruleset_evaluator.go file evaluates the rules of a digital rule set locally against a sample
+contact, the way digital outbound evaluates them, so that rule changes can be tested without messaging anyone.
+The comparisons are shared with the rule set evaluator of the outbound_ruleset package.
+*/
+
+const (
+	mediaTypeEmail = "Email"
+	mediaTypeSms   = "Sms"
+)
+
+// digitalEvaluationContext holds the values the conditions of a digital rule are evaluated against
+type digitalEvaluationContext struct {
+	contact              map[string]string
+	mediaType            string
+	contactAddressColumn string
+	lastAttempts         map[string]string
+	lastResults          map[string]string
+	dataActionOutputs    map[string]string
+	now                  time.Time
+}
+
+// digitalRuleResult is the outcome of evaluating a digital rule
+type digitalRuleResult struct {
+	rule             platformclientv2.Digitalrule
+	conditionResults []bool
+	fired            bool
+}
+
+// digitalActionResult is an action of a rule that fired, flattened to its type and settings
+type digitalActionResult struct {
+	ruleName     string
+	actionType   string
+	updateOption string
+	properties   map[string]string
+}
+
+// evaluateDigitalRules evaluates the rules of the category, or of every category when empty, in the order they are processed.
+// It returns the result of each rule, the actions of the rules that fired and the contact as updated by their update contact
+// column actions. Every rule is evaluated against the contact as it was before any action ran.
+func evaluateDigitalRules(rules []platformclientv2.Digitalrule, category string, evalCtx digitalEvaluationContext) ([]digitalRuleResult, []digitalActionResult, map[string]string, error) {
+	sorted := make([]platformclientv2.Digitalrule, 0, len(rules))
+	for _, rule := range rules {
+		if category == "" || (rule.Category != nil && *rule.Category == category) {
+			sorted = append(sorted, rule)
+		}
+	}
+	sort.SliceStable(sorted, func(i, j int) bool { return intValue(sorted[i].Order) < intValue(sorted[j].Order) })
+
+	updatedContact := make(map[string]string, len(evalCtx.contact))
+	for column, value := range evalCtx.contact {
+		updatedContact[column] = value
+	}
+
+	results := make([]digitalRuleResult, 0, len(sorted))
+	actions := make([]digitalActionResult, 0)
+	for _, rule := range sorted {
+		ruleName := stringValue(rule.Name)
+		result := digitalRuleResult{rule: rule, fired: true}
+		if rule.Conditions != nil {
+			for i, condition := range *rule.Conditions {
+				matched, err := evaluateDigitalCondition(condition, evalCtx)
+				if err != nil {
+					return nil, nil, nil, fmt.Errorf("rule %q condition %d: %s", ruleName, i+1, err)
+				}
+				result.conditionResults = append(result.conditionResults, matched)
+				result.fired = result.fired && matched
+			}
+		}
+		results = append(results, result)
+
+		if !result.fired || rule.Actions == nil {
+			continue
+		}
+		for i, action := range *rule.Actions {
+			actionResult := flattenDigitalActionResult(ruleName, action)
+			if settings := action.UpdateContactColumnActionSettings; settings != nil && settings.Properties != nil {
+				if err := obRuleset.ApplyContactUpdate(updatedContact, stringValue(settings.UpdateOption), *settings.Properties, evalCtx.now); err != nil {
+					return nil, nil, nil, fmt.Errorf("rule %q action %d: %s", ruleName, i+1, err)
+				}
+			}
+			actions = append(actions, actionResult)
+		}
+	}
+	return results, actions, updatedContact, nil
+}
+
+// evaluateDigitalCondition evaluates a single condition, including its inversion
+func evaluateDigitalCondition(condition platformclientv2.Digitalcondition, evalCtx digitalEvaluationContext) (bool, error) {
+	var (
+		matched bool
+		err     error
+	)
+	switch {
+	case condition.ContactColumnConditionSettings != nil:
+		settings := condition.ContactColumnConditionSettings
+		actual, ok := evalCtx.contact[stringValue(settings.ColumnName)]
+		if !ok {
+			return false, fmt.Errorf("the contact has no column %q", stringValue(settings.ColumnName))
+		}
+		matched, err = obRuleset.CompareValues(stringValue(settings.Operator), stringValue(settings.ValueType), actual, stringValue(settings.Value), evalCtx.now)
+	case condition.ContactAddressConditionSettings != nil:
+		if evalCtx.contactAddressColumn == "" {
+			return false, fmt.Errorf("a contact address condition requires contact_address_column")
+		}
+		actual, ok := evalCtx.contact[evalCtx.contactAddressColumn]
+		if !ok {
+			return false, fmt.Errorf("the contact has no column %q", evalCtx.contactAddressColumn)
+		}
+		settings := condition.ContactAddressConditionSettings
+		matched, err = obRuleset.CompareValues(stringValue(settings.Operator), "String", actual, stringValue(settings.Value), evalCtx.now)
+	case condition.ContactAddressTypeConditionSettings != nil:
+		if evalCtx.mediaType == "" {
+			return false, fmt.Errorf("a contact address type condition requires media_type")
+		}
+		settings := condition.ContactAddressTypeConditionSettings
+		matched, err = obRuleset.CompareValues(stringValue(settings.Operator), "String", strings.ToLower(evalCtx.mediaType), strings.ToLower(stringValue(settings.Value)), evalCtx.now)
+	case condition.LastAttemptByColumnConditionSettings != nil:
+		settings := condition.LastAttemptByColumnConditionSettings
+		column, err := columnForMediaType(evalCtx.mediaType, settings.EmailColumnName, settings.SmsColumnName, "a last attempt by column condition")
+		if err != nil {
+			return false, err
+		}
+		matched, err = compareLastAttempt(evalCtx.lastAttempts[column], stringValue(settings.Operator), stringValue(settings.Value), evalCtx.now)
+		if err != nil {
+			return false, err
+		}
+	case condition.LastAttemptOverallConditionSettings != nil:
+		settings := condition.LastAttemptOverallConditionSettings
+		latest := ""
+		var latestTime time.Time
+		if settings.MediaTypes != nil {
+			for _, mediaType := range *settings.MediaTypes {
+				lastAttempt := evalCtx.lastAttempts[mediaType]
+				if lastAttempt == "" {
+					continue
+				}
+				attemptTime, parseErr := parseLastAttempt(lastAttempt)
+				if parseErr != nil {
+					return false, parseErr
+				}
+				if latest == "" || attemptTime.After(latestTime) {
+					latest, latestTime = lastAttempt, attemptTime
+				}
+			}
+		}
+		matched, err = compareLastAttempt(latest, stringValue(settings.Operator), stringValue(settings.Value), evalCtx.now)
+	case condition.LastResultByColumnConditionSettings != nil:
+		settings := condition.LastResultByColumnConditionSettings
+		column, err := columnForMediaType(evalCtx.mediaType, settings.EmailColumnName, settings.SmsColumnName, "a last result by column condition")
+		if err != nil {
+			return false, err
+		}
+		codes := settings.SmsWrapupCodes
+		if strings.EqualFold(evalCtx.mediaType, mediaTypeEmail) {
+			codes = settings.EmailWrapupCodes
+		}
+		matched = column != "" && codes != nil && lists.ItemInSlice(evalCtx.lastResults[column], *codes)
+	case condition.LastResultOverallConditionSettings != nil:
+		settings := condition.LastResultOverallConditionSettings
+		matched = (settings.EmailWrapupCodes != nil && lists.ItemInSlice(evalCtx.lastResults[mediaTypeEmail], *settings.EmailWrapupCodes)) ||
+			(settings.SmsWrapupCodes != nil && lists.ItemInSlice(evalCtx.lastResults[mediaTypeSms], *settings.SmsWrapupCodes))
+	case condition.DataActionConditionSettings != nil:
+		settings := condition.DataActionConditionSettings
+		outputs, ok := evalCtx.dataActionOutputs[stringValue(settings.DataActionId)]
+		if !ok {
+			return false, fmt.Errorf("a data action condition requires data_action_outputs to contain %q", stringValue(settings.DataActionId))
+		}
+		var predicates []obRuleset.DataActionPredicate
+		if settings.Predicates != nil {
+			for _, predicate := range *settings.Predicates {
+				predicates = append(predicates, obRuleset.DataActionPredicate{
+					OutputField:                  stringValue(predicate.OutputField),
+					OutputOperator:               stringValue(predicate.OutputOperator),
+					ComparisonValue:              stringValue(predicate.ComparisonValue),
+					Inverted:                     boolValue(predicate.Inverted),
+					OutputFieldMissingResolution: boolValue(predicate.OutputFieldMissingResolution),
+				})
+			}
+		}
+		matched, err = obRuleset.EvaluateDataActionOutputs(outputs, boolValue(settings.DataNotFoundResolution), predicates, evalCtx.now)
+	default:
+		return false, fmt.Errorf("the condition has no settings")
+	}
+	if err != nil {
+		return false, err
+	}
+	return matched != boolValue(condition.Inverted), nil
+}
+
+// columnForMediaType returns the column of a by column condition for the media type being contacted
+func columnForMediaType(mediaType string, emailColumnName, smsColumnName *string, condition string) (string, error) {
+	switch {
+	case strings.EqualFold(mediaType, mediaTypeEmail):
+		return stringValue(emailColumnName), nil
+	case strings.EqualFold(mediaType, mediaTypeSms):
+		return stringValue(smsColumnName), nil
+	}
+	return "", fmt.Errorf("%s requires media_type", condition)
+}
+
+// compareLastAttempt compares the time of the last attempt with a period. Contacts never attempted match neither Before nor After.
+func compareLastAttempt(lastAttempt, operator, period string, now time.Time) (bool, error) {
+	if lastAttempt == "" {
+		return false, nil
+	}
+	return obRuleset.CompareValues(operator, "Period", lastAttempt, period, now)
+}
+
+func parseLastAttempt(lastAttempt string) (time.Time, error) {
+	parsed, err := time.Parse(time.RFC3339, lastAttempt)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("last attempt %q is not an RFC 3339 time", lastAttempt)
+	}
+	return parsed, nil
+}
+
+// flattenDigitalActionResult reports the type and settings of an action
+func flattenDigitalActionResult(ruleName string, action platformclientv2.Digitalaction) digitalActionResult {
+	result := digitalActionResult{ruleName: ruleName, properties: map[string]string{}}
+	switch {
+	case action.UpdateContactColumnActionSettings != nil:
+		result.actionType = "update_contact_column"
+		result.updateOption = stringValue(action.UpdateContactColumnActionSettings.UpdateOption)
+		if action.UpdateContactColumnActionSettings.Properties != nil {
+			result.properties = *action.UpdateContactColumnActionSettings.Properties
+		}
+	case action.DoNotSendActionSettings != nil:
+		result.actionType = "do_not_send"
+	case action.AppendToDncActionSettings != nil:
+		result.actionType = "append_to_dnc"
+		result.properties["expire"] = strconv.FormatBool(boolValue(action.AppendToDncActionSettings.Expire))
+		result.properties["expiration_duration"] = stringValue(action.AppendToDncActionSettings.ExpirationDuration)
+		result.properties["list_type"] = stringValue(action.AppendToDncActionSettings.ListType)
+	case action.MarkContactUncontactableActionSettings != nil:
+		result.actionType = "mark_contact_uncontactable"
+		if action.MarkContactUncontactableActionSettings.MediaTypes != nil {
+			result.properties["media_types"] = strings.Join(*action.MarkContactUncontactableActionSettings.MediaTypes, ",")
+		}
+	case action.MarkContactAddressUncontactableActionSettings != nil:
+		result.actionType = "mark_contact_address_uncontactable"
+	case action.SetContentTemplateActionSettings != nil:
+		result.actionType = "set_content_template"
+		result.properties["sms_content_template_id"] = stringValue(action.SetContentTemplateActionSettings.SmsContentTemplateId)
+		result.properties["email_content_template_id"] = stringValue(action.SetContentTemplateActionSettings.EmailContentTemplateId)
+	case action.SetSmsPhoneNumberActionSettings != nil:
+		result.actionType = "set_sms_phone_number"
+		result.properties["sender_sms_phone_number"] = stringValue(action.SetSmsPhoneNumberActionSettings.SenderSmsPhoneNumber)
+	}
+	return result
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+func boolValue(value *bool) bool {
+	return value != nil && *value
+}
+
+func intValue(value *int) int {
+	if value == nil {
+		return 0
+	}
+	return *value
+}
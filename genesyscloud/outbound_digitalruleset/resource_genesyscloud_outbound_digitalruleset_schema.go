@@ -19,11 +19,13 @@ resource_genesycloud_outbound_digitalruleset_schema.go holds four functions with
 4.  The resource exporter configuration for the outbound_digitalruleset exporter.
 */
 const ResourceType = "genesyscloud_outbound_digitalruleset"
+const EvaluationDataSourceType = "genesyscloud_outbound_digitalruleset_evaluation"
 
 // SetRegistrar registers all of the resources, datasources and exporters in the package
 func SetRegistrar(regInstance registrar.Registrar) {
 	regInstance.RegisterResource(ResourceType, ResourceOutboundDigitalruleset())
 	regInstance.RegisterDataSource(ResourceType, DataSourceOutboundDigitalruleset())
+	regInstance.RegisterDataSource(EvaluationDataSourceType, DataSourceOutboundDigitalrulesetEvaluation())
 	regInstance.RegisterExporter(ResourceType, OutboundDigitalrulesetExporter())
 }
 
@@ -543,3 +545,149 @@ func DataSourceOutboundDigitalruleset() *schema.Resource {
 		},
 	}
 }
+
+// DataSourceOutboundDigitalrulesetEvaluation registers the genesyscloud_outbound_digitalruleset_evaluation data source
+func DataSourceOutboundDigitalrulesetEvaluation() *schema.Resource {
+	return &schema.Resource{
+		Description: `Evaluates the rules of an outbound digital rule set locally against a sample contact and reports which rules fire and which actions would run. Nothing is sent and nothing is changed in Genesys Cloud, so rule changes can be tested in CI before they reach a live campaign. Conditions on the history of the contact (last attempts, last results and data action results) are evaluated against the values provided.`,
+		ReadContext: provider.ReadWithPooledClient(dataSourceOutboundDigitalrulesetEvaluationRead),
+		Schema: map[string]*schema.Schema{
+			`ruleset_id`: {
+				Description:  `The ID of a digital rule set in the org to evaluate.`,
+				Optional:     true,
+				Type:         schema.TypeString,
+				ExactlyOneOf: []string{"ruleset_id", "rules_json"},
+			},
+			`rules_json`: {
+				Description:  `The rules to evaluate, as the JSON encoding of the rules of a genesyscloud_outbound_digitalruleset, e.g. jsonencode(genesyscloud_outbound_digitalruleset.example.rules). Rules that are fully known at plan time are evaluated at plan time, before they are applied.`,
+				Optional:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringIsJSON,
+			},
+			`category`: {
+				Description:  `Only evaluate the rules of this category. All rules are evaluated when not set.`,
+				Optional:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{"PreContact", "PostContact"}, false),
+			},
+			`contact`: {
+				Description: `The sample contact record, keyed by contact column name.`,
+				Required:    true,
+				Type:        schema.TypeMap,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			`media_type`: {
+				Description:  `The media type of the message being sent. Required to evaluate contact address type, last attempt by column and last result by column conditions.`,
+				Optional:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{"Email", "Sms"}, true),
+			},
+			`contact_address_column`: {
+				Description: `The contact column holding the address the message is sent to. Required to evaluate contact address conditions.`,
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			`last_attempts`: {
+				Description: `The RFC 3339 times of the last attempts to reach the contact, keyed by contact column for last attempt by column conditions, or by media type (Email or Sms) for last attempt overall conditions. A contact never attempted matches no last attempt condition.`,
+				Optional:    true,
+				Type:        schema.TypeMap,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.IsRFC3339Time},
+			},
+			`last_results`: {
+				Description: `The wrap-up code IDs of the last attempts to reach the contact, keyed by contact column for last result by column conditions, or by media type (Email or Sms) for last result overall conditions.`,
+				Optional:    true,
+				Type:        schema.TypeMap,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			`data_action_outputs`: {
+				Description: `The JSON encoded output of the data actions used by data action conditions, keyed by data action ID. An output of null means the data action found no data.`,
+				Optional:    true,
+				Type:        schema.TypeMap,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			`evaluation_time`: {
+				Description:  `The RFC 3339 time Period values are counted back from and CurrentTime updates are set to. Defaults to the time of the evaluation.`,
+				Optional:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			`rules`: {
+				Description: `The result of each evaluated rule, in the order the rules are processed.`,
+				Computed:    true,
+				Type:        schema.TypeList,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						`name`: {
+							Description: `The name of the rule.`,
+							Computed:    true,
+							Type:        schema.TypeString,
+						},
+						`order`: {
+							Description: `The order of the rule.`,
+							Computed:    true,
+							Type:        schema.TypeInt,
+						},
+						`category`: {
+							Description: `The category of the rule.`,
+							Computed:    true,
+							Type:        schema.TypeString,
+						},
+						`fired`: {
+							Description: `Whether all the conditions of the rule are true, so that its actions run.`,
+							Computed:    true,
+							Type:        schema.TypeBool,
+						},
+						`condition_results`: {
+							Description: `The result of each condition of the rule, after inversion.`,
+							Computed:    true,
+							Type:        schema.TypeList,
+							Elem:        &schema.Schema{Type: schema.TypeBool},
+						},
+					},
+				},
+			},
+			`fired_rule_names`: {
+				Description: `The names of the rules that fire, in the order they are processed.`,
+				Computed:    true,
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			`actions`: {
+				Description: `The actions that would run, in the order they would run.`,
+				Computed:    true,
+				Type:        schema.TypeList,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						`rule_name`: {
+							Description: `The name of the rule the action belongs to.`,
+							Computed:    true,
+							Type:        schema.TypeString,
+						},
+						`type`: {
+							Description: `The type of the action, named after its settings block, e.g. update_contact_column.`,
+							Computed:    true,
+							Type:        schema.TypeString,
+						},
+						`update_option`: {
+							Description: `The update option of an update_contact_column action.`,
+							Computed:    true,
+							Type:        schema.TypeString,
+						},
+						`properties`: {
+							Description: `The settings of the action.`,
+							Computed:    true,
+							Type:        schema.TypeMap,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			`updated_contact`: {
+				Description: `The contact after the update_contact_column actions of the rules that fire. Every rule is evaluated against the contact as it was before any action ran.`,
+				Computed:    true,
+				Type:        schema.TypeMap,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
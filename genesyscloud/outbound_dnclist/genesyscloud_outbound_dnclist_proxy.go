@@ -3,8 +3,11 @@ package outbound_dnclist
 import (
 	"context"
 	"fmt"
+	"io"
 	"log"
+	"strings"
 	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/mypurecloud/platform-client-sdk-go/v154/platformclientv2"
@@ -20,6 +23,11 @@ type getOutboundDnclistByNameFunc func(ctx context.Context, p *outboundDnclistPr
 type updateOutboundDnclistFunc func(ctx context.Context, p *outboundDnclistProxy, dnclistId string, dnclist *platformclientv2.Dnclist) (*platformclientv2.Dnclist, *platformclientv2.APIResponse, error)
 type deleteOutboundDnclistFunc func(ctx context.Context, p *outboundDnclistProxy, dnclistId string) (*platformclientv2.APIResponse, error)
 type uploadPhoneEntriesToDncListFunc func(p *outboundDnclistProxy, dncList *platformclientv2.Dnclist, entry interface{}) (*platformclientv2.APIResponse, diag.Diagnostics)
+type uploadDncListFileFunc func(ctx context.Context, p *outboundDnclistProxy, dncListId, filePath, contactMethod string, columnNames []string) ([]byte, error)
+type getDncListImportStatusFunc func(ctx context.Context, p *outboundDnclistProxy, dncListId string) (*platformclientv2.Importstatus, *platformclientv2.APIResponse, error)
+type removeDncListEntriesFunc func(ctx context.Context, p *outboundDnclistProxy, dncListId, dncSourceType, contactMethod string, values []string) (*platformclientv2.APIResponse, error)
+type initiateDncListExportFunc func(ctx context.Context, p *outboundDnclistProxy, dncListId string) (*platformclientv2.APIResponse, error)
+type getDncListExportUrlFunc func(ctx context.Context, p *outboundDnclistProxy, dncListId string) (string, *platformclientv2.APIResponse, error)
 
 // outboundDnclistProxy contains all the methods that call genesys cloud APIs
 type outboundDnclistProxy struct {
@@ -32,6 +40,13 @@ type outboundDnclistProxy struct {
 	updateOutboundDnclistAttr       updateOutboundDnclistFunc
 	deleteOutboundDnclistAttr       deleteOutboundDnclistFunc
 	uploadPhoneEntriesToDncListAttr uploadPhoneEntriesToDncListFunc
+	uploadDncListFileAttr           uploadDncListFileFunc
+	getDncListImportStatusAttr      getDncListImportStatusFunc
+	removeDncListEntriesAttr        removeDncListEntriesFunc
+	initiateDncListExportAttr       initiateDncListExportFunc
+	getDncListExportUrlAttr         getDncListExportUrlFunc
+	basePath                        string
+	accessToken                     string
 }
 
 // newOutboundDnclistProxy initializes the dnclist proxy with the data needed for communication with the genesys cloud
@@ -47,6 +62,13 @@ func newOutboundDnclistProxy(clientConfig *platformclientv2.Configuration) *outb
 		updateOutboundDnclistAttr:       updateOutboundDnclistFn,
 		deleteOutboundDnclistAttr:       deleteOutboundDnclistFn,
 		uploadPhoneEntriesToDncListAttr: uploadPhoneEntriesToDncListFn,
+		uploadDncListFileAttr:           uploadDncListFileFn,
+		getDncListImportStatusAttr:      getDncListImportStatusFn,
+		removeDncListEntriesAttr:        removeDncListEntriesFn,
+		initiateDncListExportAttr:       initiateDncListExportFn,
+		getDncListExportUrlAttr:         getDncListExportUrlFn,
+		basePath:                        strings.Replace(api.Configuration.BasePath, "api", "apps", -1),
+		accessToken:                     api.Configuration.AccessToken,
 	}
 }
 
@@ -91,6 +113,31 @@ func (p *outboundDnclistProxy) uploadPhoneEntriesToDncList(dncList *platformclie
 	return p.uploadPhoneEntriesToDncListAttr(p, dncList, entry)
 }
 
+// uploadDncListFile uploads a CSV file of entries to a Genesys Cloud Outbound Dnclist
+func (p *outboundDnclistProxy) uploadDncListFile(ctx context.Context, dncListId, filePath, contactMethod string, columnNames []string) ([]byte, error) {
+	return p.uploadDncListFileAttr(ctx, p, dncListId, filePath, contactMethod, columnNames)
+}
+
+// getDncListImportStatus returns the status of the last import into a Genesys Cloud Outbound Dnclist
+func (p *outboundDnclistProxy) getDncListImportStatus(ctx context.Context, dncListId string) (*platformclientv2.Importstatus, *platformclientv2.APIResponse, error) {
+	return p.getDncListImportStatusAttr(ctx, p, dncListId)
+}
+
+// removeDncListEntries removes entries from a Genesys Cloud Outbound Dnclist
+func (p *outboundDnclistProxy) removeDncListEntries(ctx context.Context, dncListId, dncSourceType, contactMethod string, values []string) (*platformclientv2.APIResponse, error) {
+	return p.removeDncListEntriesAttr(ctx, p, dncListId, dncSourceType, contactMethod, values)
+}
+
+// initiateDncListExport starts an export of the entries of a Genesys Cloud Outbound Dnclist
+func (p *outboundDnclistProxy) initiateDncListExport(ctx context.Context, dncListId string) (*platformclientv2.APIResponse, error) {
+	return p.initiateDncListExportAttr(ctx, p, dncListId)
+}
+
+// getDncListExportUrl returns the download URL of the last export of a Genesys Cloud Outbound Dnclist
+func (p *outboundDnclistProxy) getDncListExportUrl(ctx context.Context, dncListId string) (string, *platformclientv2.APIResponse, error) {
+	return p.getDncListExportUrlAttr(ctx, p, dncListId)
+}
+
 func createOutboundDnclistFn(ctx context.Context, p *outboundDnclistProxy, dnclist *platformclientv2.Dnclistcreate) (*platformclientv2.Dnclist, *platformclientv2.APIResponse, error) {
 	return p.outboundApi.PostOutboundDnclists(*dnclist)
 }
@@ -172,3 +219,78 @@ func getOutboundDnclistByNameFn(ctx context.Context, p *outboundDnclistProxy, na
 	}
 	return "", true, resp, fmt.Errorf("unable to find dnc list with name %s", name)
 }
+
+// uploadDncListFileFn uploads a CSV file of entries to a DNC list, which starts an import job
+func uploadDncListFileFn(_ context.Context, p *outboundDnclistProxy, dncListId, filePath, contactMethod string, columnNames []string) ([]byte, error) {
+	fileReader, file, err := files.DownloadOrOpenFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	if file != nil {
+		defer file.Close()
+	}
+
+	// The form data structure follows the Genesys Cloud API specification for uploading DNC lists as CSV files
+	// See full documentation at: https://developer.genesys.cloud/routing/outbound/uploaddnclists
+	formData := make(map[string]io.Reader)
+	formData["file"] = fileReader
+	formData["fileType"] = strings.NewReader("dnclist")
+	formData["id"] = strings.NewReader(dncListId)
+	if len(columnNames) > 0 {
+		if contactMethod == "Email" {
+			formData["emailColumns"] = strings.NewReader(strings.Join(columnNames, ","))
+		} else {
+			formData["phoneColumns"] = strings.NewReader(strings.Join(columnNames, ","))
+		}
+	}
+
+	headers := make(map[string]string)
+	headers["Authorization"] = "Bearer " + p.accessToken
+
+	s3Uploader := files.NewS3Uploader(nil, formData, nil, headers, "POST", p.basePath+"/uploads/v2/dnclist")
+	return s3Uploader.Upload()
+}
+
+func getDncListImportStatusFn(_ context.Context, p *outboundDnclistProxy, dncListId string) (*platformclientv2.Importstatus, *platformclientv2.APIResponse, error) {
+	return p.outboundApi.GetOutboundDnclistImportstatus(dncListId)
+}
+
+// removeDncListEntriesFn removes phone numbers, email addresses or custom exclusion values from a DNC list
+func removeDncListEntriesFn(_ context.Context, p *outboundDnclistProxy, dncListId, dncSourceType, contactMethod string, values []string) (*platformclientv2.APIResponse, error) {
+	action := "Remove"
+	if dncSourceType == "rds_custom" {
+		return p.outboundApi.PatchOutboundDnclistCustomexclusioncolumns(dncListId, platformclientv2.Dncpatchcustomexclusioncolumnsrequest{
+			Action:                       &action,
+			CustomExclusionColumnEntries: &values,
+		})
+	}
+	if contactMethod == "Email" {
+		return p.outboundApi.PatchOutboundDnclistEmailaddresses(dncListId, platformclientv2.Dncpatchemailsrequest{
+			Action:         &action,
+			EmailAddresses: &values,
+		})
+	}
+	return p.outboundApi.PatchOutboundDnclistPhonenumbers(dncListId, platformclientv2.Dncpatchphonenumbersrequest{
+		Action:       &action,
+		PhoneNumbers: &values,
+	})
+}
+
+func initiateDncListExportFn(_ context.Context, p *outboundDnclistProxy, dncListId string) (*platformclientv2.APIResponse, error) {
+	_, resp, err := p.outboundApi.PostOutboundDnclistExport(dncListId)
+	if err != nil {
+		return resp, fmt.Errorf("error calling PostOutboundDnclistExport with error: %v", err)
+	}
+	return resp, nil
+}
+
+func getDncListExportUrlFn(_ context.Context, p *outboundDnclistProxy, dncListId string) (string, *platformclientv2.APIResponse, error) {
+	data, resp, err := p.outboundApi.GetOutboundDnclistExport(dncListId, "")
+	if err != nil {
+		return "", resp, fmt.Errorf("error calling GetOutboundDnclistExport with error: %v", err)
+	}
+	if data.Uri == nil {
+		return "", resp, fmt.Errorf("export of DNC list %s has no download URL", dncListId)
+	}
+	return *data.Uri, resp, nil
+}
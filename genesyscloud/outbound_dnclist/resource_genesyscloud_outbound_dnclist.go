@@ -97,6 +97,9 @@ func createOutboundDncList(ctx context.Context, d *schema.ResourceData, meta int
 			return util.BuildDiagnosticError(ResourceType, "Phone numbers can only be uploaded to internal DNC lists.", fmt.Errorf("phone numbers can only be uploaded to internal DNC Lists"))
 		}
 	}
+	if diagErr := importOutboundDncListFile(ctx, d, meta); diagErr != nil {
+		return diagErr
+	}
 	log.Printf("Created Outbound DNC list %s %s", name, *outboundDncList.Id)
 	return readOutboundDncList(ctx, d, meta)
 }
@@ -166,6 +169,9 @@ func updateOutboundDncList(ctx context.Context, d *schema.ResourceData, meta int
 	if diagErr != nil {
 		return diagErr
 	}
+	if diagErr := importOutboundDncListFile(ctx, d, meta); diagErr != nil {
+		return diagErr
+	}
 
 	log.Printf("Updated Outbound DNC list %s", name)
 	return readOutboundDncList(ctx, d, meta)
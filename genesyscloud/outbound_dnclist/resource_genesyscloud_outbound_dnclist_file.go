@@ -0,0 +1,348 @@
+package outbound_dnclist
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/chunks"
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
+	"terraform-provider-genesyscloud/genesyscloud/util/lists"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v154/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_outbound_dnclist_file.go file contains the file based import and export of DNC list entries.
+Large DNC lists are uploaded as a CSV file through the DNC import job instead of being managed inline with entries,
+and the exporter writes the entries of a DNC list to a CSV file next to the exported configuration.
+*/
+
+const (
+	dncFileModeAppend  = "append"
+	dncFileModeReplace = "replace"
+
+	dncImportStateInProgress = "IN_PROGRESS"
+	dncImportStateFailed     = "FAILED"
+)
+
+// dncImportPollInterval is the time to wait between checks of the status of a DNC import job
+var dncImportPollInterval = 5 * time.Second
+
+// dncImportTimeout is the maximum time to wait for a DNC import job to finish
+var dncImportTimeout = 30 * time.Minute
+
+// dncRemoveChunkSize is the number of entries removed from a DNC list per request
+const dncRemoveChunkSize = 1000
+
+// isFileBasedDncSourceType returns true for the DNC list types whose entries are managed in Genesys Cloud
+func isFileBasedDncSourceType(dncSourceType string) bool {
+	return dncSourceType == "rds" || dncSourceType == "rds_custom"
+}
+
+// importOutboundDncListFile uploads dnc_filepath to the DNC list when its content changed and waits for the import to finish.
+// In replace mode the entries of the DNC list that are not in the file are then removed, so the list is never left without
+// the entries of the file when the upload or the import fails.
+func importOutboundDncListFile(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	filePath := d.Get("dnc_filepath").(string)
+	if filePath == "" {
+		return nil
+	}
+
+	fileHash, err := files.HashFileContent(filePath)
+	if err != nil {
+		return diag.Errorf("Failed to read file content hash of %s: %v", filePath, err)
+	}
+	if d.Get("dnc_file_content_hash").(string) == fileHash {
+		return nil
+	}
+
+	name := d.Get("name").(string)
+	dncSourceType := d.Get("dnc_source_type").(string)
+	contactMethod := d.Get("contact_method").(string)
+	if !isFileBasedDncSourceType(dncSourceType) {
+		return util.BuildDiagnosticError(ResourceType, "DNC files can only be uploaded to internal DNC lists", fmt.Errorf("dnc_filepath is not supported for DNC lists of type %s", dncSourceType))
+	}
+
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getOutboundDnclistProxy(sdkConfig)
+
+	log.Printf("Uploading %s to Outbound DNC list %s", filePath, name)
+	columnNames := lists.InterfaceListToStrings(d.Get("dnc_file_column_names").([]interface{}))
+	if _, err := proxy.uploadDncListFile(ctx, d.Id(), filePath, contactMethod, columnNames); err != nil {
+		return diag.Errorf("Failed to upload %s to Outbound DNC list %s: %v", filePath, name, err)
+	}
+
+	if diagErr := waitForDncListImport(ctx, proxy, d.Id()); diagErr != nil {
+		return diagErr
+	}
+
+	if d.Get("dnc_file_mode").(string) == dncFileModeReplace && !d.IsNewResource() {
+		if dncSourceType == "rds_custom" {
+			columnNames = []string{d.Get("custom_exclusion_column").(string)}
+		}
+		if diagErr := removeDncEntriesNotInFile(ctx, proxy, sdkConfig, d.Id(), filePath, columnNames, dncSourceType, contactMethod); diagErr != nil {
+			return diagErr
+		}
+	}
+
+	_ = d.Set("dnc_file_content_hash", fileHash)
+	log.Printf("Imported %s to Outbound DNC list %s", filePath, name)
+	return nil
+}
+
+// removeDncEntriesNotInFile exports the entries of the DNC list and removes those that are not in the columns of the file
+func removeDncEntriesNotInFile(ctx context.Context, proxy *outboundDnclistProxy, sdkConfig *platformclientv2.Configuration, dncListId, filePath string, columnNames []string, dncSourceType, contactMethod string) diag.Diagnostics {
+	fileValues, err := readDncFileValues(filePath, columnNames)
+	if err != nil {
+		return util.BuildDiagnosticError(ResourceType, fmt.Sprintf("Failed to read the entries of %s", filePath), err)
+	}
+
+	exportDirectory, err := os.MkdirTemp("", "dnc-export-")
+	if err != nil {
+		return util.BuildDiagnosticError(ResourceType, "Failed to create the directory of the DNC list export", err)
+	}
+	defer os.RemoveAll(exportDirectory)
+
+	if err := downloadDncListExport(ctx, proxy, sdkConfig, dncListId, exportDirectory, "entries.csv"); err != nil {
+		return util.BuildDiagnosticError(ResourceType, fmt.Sprintf("Failed to export the entries of Outbound DNC list %s", dncListId), err)
+	}
+	// The values of the export are in its first column, or in the custom exclusion column of rds_custom lists
+	var exportColumns []string
+	if dncSourceType == "rds_custom" {
+		exportColumns = columnNames
+	}
+	listValues, err := readDncFileValues(filepath.Join(exportDirectory, "entries.csv"), exportColumns)
+	if err != nil {
+		return util.BuildDiagnosticError(ResourceType, fmt.Sprintf("Failed to read the export of Outbound DNC list %s", dncListId), err)
+	}
+
+	var staleValues []string
+	for key, value := range listValues {
+		if _, ok := fileValues[key]; !ok {
+			staleValues = append(staleValues, value)
+		}
+	}
+	if len(staleValues) == 0 {
+		return nil
+	}
+	sort.Strings(staleValues)
+
+	log.Printf("Removing %d entries that are not in %s from Outbound DNC list %s", len(staleValues), filePath, dncListId)
+	return chunks.ProcessChunks(chunks.ChunkBy(staleValues, dncRemoveChunkSize), func(values []string) diag.Diagnostics {
+		resp, err := proxy.removeDncListEntries(ctx, dncListId, dncSourceType, contactMethod, values)
+		if err != nil {
+			return util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to remove entries from Outbound DNC list %s error: %s", dncListId, err), resp)
+		}
+		return nil
+	})
+}
+
+// readDncFileValues returns the values of the columns of a DNC CSV file, or of its first column when no column is given.
+// The values are keyed by their normalized form: the digits of phone numbers and lower case email addresses.
+func readDncFileValues(path string, columnNames []string) (map[string]string, error) {
+	reader, file, err := files.DownloadOrOpenFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if file != nil {
+		defer file.Close()
+	}
+
+	records, err := csv.NewReader(reader).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %v", path, err)
+	}
+	if len(records) == 0 {
+		return map[string]string{}, nil
+	}
+
+	columns := []int{0}
+	if len(columnNames) > 0 {
+		columns = nil
+		for i, header := range records[0] {
+			if lists.ItemInSlice(strings.TrimSpace(header), columnNames) {
+				columns = append(columns, i)
+			}
+		}
+		if len(columns) == 0 {
+			return nil, fmt.Errorf("%s has none of the columns %s", path, strings.Join(columnNames, ", "))
+		}
+	}
+
+	values := make(map[string]string)
+	for _, record := range records[1:] {
+		for _, column := range columns {
+			if column >= len(record) {
+				continue
+			}
+			value := strings.TrimSpace(record[column])
+			if value != "" {
+				values[normalizeDncValue(value)] = value
+			}
+		}
+	}
+	return values, nil
+}
+
+// normalizeDncValue returns the digits of a phone number and the lower case form of other values
+func normalizeDncValue(value string) string {
+	if strings.Contains(value, "@") {
+		return strings.ToLower(value)
+	}
+	digits := strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, value)
+	if digits == "" {
+		return value
+	}
+	return digits
+}
+
+// waitForDncListImport polls the import status of a DNC list until the import job finished
+func waitForDncListImport(ctx context.Context, proxy *outboundDnclistProxy, dncListId string) diag.Diagnostics {
+	return util.WithRetries(ctx, dncImportTimeout, func() *retry.RetryError {
+		// Give the import job time to start before (re)checking its status
+		time.Sleep(dncImportPollInterval)
+
+		status, resp, err := proxy.getDncListImportStatus(ctx, dncListId)
+		if err != nil {
+			return retry.NonRetryableError(util.BuildWithRetriesApiDiagnosticError(ResourceType, fmt.Sprintf("failed to read the import status of Outbound DNC list %s | error: %s", dncListId, err), resp))
+		}
+		if status.State == nil {
+			return nil
+		}
+		switch *status.State {
+		case dncImportStateInProgress:
+			return retry.RetryableError(fmt.Errorf("import into Outbound DNC list %s is still in progress", dncListId))
+		case dncImportStateFailed:
+			failureReason := ""
+			if status.FailureReason != nil {
+				failureReason = *status.FailureReason
+			}
+			return retry.NonRetryableError(util.BuildWithRetriesApiDiagnosticError(ResourceType, fmt.Sprintf("import into Outbound DNC list %s failed: %s", dncListId, failureReason), resp))
+		}
+		return nil
+	})
+}
+
+// DncEntriesExporterResolver exports the entries of a DNC list to a CSV file referenced by dnc_filepath
+func DncEntriesExporterResolver(resourceId, exportDirectory, subDirectory string, configMap map[string]interface{}, meta interface{}, resource resourceExporter.ResourceInfo) error {
+	dncSourceType := resource.State.Attributes["dnc_source_type"]
+	if !isFileBasedDncSourceType(dncSourceType) {
+		return nil
+	}
+
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getOutboundDnclistProxy(sdkConfig)
+	ctx := context.Background()
+
+	exportFileName := fmt.Sprintf("%s.csv", resource.BlockLabel)
+	fullDirectoryPath := filepath.Join(exportDirectory, subDirectory)
+	if err := os.MkdirAll(fullDirectoryPath, os.ModePerm); err != nil {
+		return fmt.Errorf("failed to create directory %s: %w", fullDirectoryPath, err)
+	}
+
+	if err := downloadDncListExport(ctx, proxy, sdkConfig, resourceId, fullDirectoryPath, exportFileName); err != nil {
+		return err
+	}
+
+	fullCurrentPath := filepath.Join(fullDirectoryPath, exportFileName)
+	fullRelativePath := filepath.Join(subDirectory, exportFileName)
+	hash, err := files.HashFileContent(fullCurrentPath)
+	if err != nil {
+		return fmt.Errorf("error calculating file content hash of %s: %v", fullCurrentPath, err)
+	}
+
+	configMap["dnc_filepath"] = fullRelativePath
+	resource.State.Attributes["dnc_filepath"] = fullRelativePath
+	resource.State.Attributes["dnc_file_content_hash"] = hash
+
+	// The values of rds lists are in the first column of the export. rds_custom lists use their custom_exclusion_column.
+	if dncSourceType == "rds" {
+		columnName, err := readDncFileFirstColumn(fullCurrentPath)
+		if err != nil {
+			return err
+		}
+		configMap["dnc_file_column_names"] = []interface{}{columnName}
+		resource.State.Attributes["dnc_file_column_names.#"] = "1"
+		resource.State.Attributes["dnc_file_column_names.0"] = columnName
+	}
+
+	// The entries are in the exported file instead of the configuration
+	delete(configMap, "entries")
+	delete(configMap, "dnc_file_content_hash")
+	return nil
+}
+
+// downloadDncListExport exports the entries of a DNC list to a CSV file of directory
+func downloadDncListExport(ctx context.Context, proxy *outboundDnclistProxy, sdkConfig *platformclientv2.Configuration, dncListId, directory, fileName string) error {
+	diagErr := util.RetryWhen(util.IsStatus404, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+		resp, err := proxy.initiateDncListExport(ctx, dncListId)
+		// Sleep one second before attempting to retrieve export url to give the system time to be able to generate the URL
+		time.Sleep(time.Second)
+		if err != nil {
+			return resp, diag.FromErr(err)
+		}
+		return resp, nil
+	}, 400)
+	if diagErr != nil {
+		return fmt.Errorf("error initiating DNC list export: %v", diagErr)
+	}
+
+	var exportUrl string
+	diagErr = util.RetryWhen(util.IsStatus404, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+		var (
+			resp *platformclientv2.APIResponse
+			err  error
+		)
+		exportUrl, resp, err = proxy.getDncListExportUrl(ctx, dncListId)
+		if err != nil {
+			return resp, diag.FromErr(err)
+		}
+		return resp, nil
+	}, 400)
+	if diagErr != nil {
+		return fmt.Errorf("error retrieving DNC list export url: %v", diagErr)
+	}
+
+	diagErr = util.RetryWhen(util.IsStatus404, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+		resp, err := files.DownloadExportFileWithAccessToken(directory, fileName, exportUrl, sdkConfig.AccessToken)
+		if err != nil {
+			return resp, diag.FromErr(err)
+		}
+		return resp, nil
+	}, 400)
+	if diagErr != nil {
+		return fmt.Errorf("error downloading exported DNC entries: %v", diagErr)
+	}
+	return nil
+}
+
+func readDncFileFirstColumn(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to open %s: %v", path, err)
+	}
+	defer file.Close()
+
+	header, err := csv.NewReader(file).Read()
+	if err != nil || len(header) == 0 {
+		return "", fmt.Errorf("failed to read the header of %s: %v", path, err)
+	}
+	return strings.TrimSpace(header[0]), nil
+}
@@ -0,0 +1,159 @@
+package outbound_dnclist
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v154/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitImportOutboundDncListFile(t *testing.T) {
+	dncFile := filepath.Join(t.TempDir(), "dnc.csv")
+	assert.NoError(t, os.WriteFile(dncFile, []byte("phone\n+13175550001\n+13175550002\n"), 0644))
+
+	var (
+		calls         []string
+		removed       []string
+		uploadedPath  string
+		uploadColumns []string
+		statusChecks  int
+		uploadErr     error
+	)
+	internalProxy = &outboundDnclistProxy{
+		removeDncListEntriesAttr: func(_ context.Context, p *outboundDnclistProxy, dncListId, dncSourceType, contactMethod string, values []string) (*platformclientv2.APIResponse, error) {
+			calls = append(calls, "remove")
+			removed = append(removed, values...)
+			return nil, nil
+		},
+		uploadDncListFileAttr: func(_ context.Context, p *outboundDnclistProxy, dncListId, filePath, contactMethod string, columnNames []string) ([]byte, error) {
+			calls = append(calls, "upload")
+			uploadedPath = filePath
+			uploadColumns = columnNames
+			return nil, uploadErr
+		},
+		initiateDncListExportAttr: func(_ context.Context, p *outboundDnclistProxy, dncListId string) (*platformclientv2.APIResponse, error) {
+			calls = append(calls, "export")
+			return &platformclientv2.APIResponse{StatusCode: 200}, nil
+		},
+		getDncListExportUrlAttr: func(_ context.Context, p *outboundDnclistProxy, dncListId string) (string, *platformclientv2.APIResponse, error) {
+			return "http://test-url.com/export", &platformclientv2.APIResponse{StatusCode: 200}, nil
+		},
+		getDncListImportStatusAttr: func(_ context.Context, p *outboundDnclistProxy, dncListId string) (*platformclientv2.Importstatus, *platformclientv2.APIResponse, error) {
+			statusChecks++
+			state := dncImportStateInProgress
+			if statusChecks > 1 {
+				state = "SUCCEEDED"
+			}
+			return &platformclientv2.Importstatus{State: &state}, nil, nil
+		},
+	}
+	defer func() { internalProxy = nil }()
+
+	origPollInterval := dncImportPollInterval
+	dncImportPollInterval = 0
+	defer func() { dncImportPollInterval = origPollInterval }()
+
+	// The export holds the entries of the file, in another format, and an entry that is not in the file
+	origDownloadFile := files.DownloadExportFileWithAccessToken
+	files.DownloadExportFileWithAccessToken = func(directory, filename, url, accessToken string) (*platformclientv2.APIResponse, error) {
+		return nil, os.WriteFile(filepath.Join(directory, filename), []byte("phone_number\n13175550001\n13175550002\n13175550003\n"), 0644)
+	}
+	defer func() { files.DownloadExportFileWithAccessToken = origDownloadFile }()
+
+	meta := &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}
+	d := schema.TestResourceDataRaw(t, ResourceOutboundDncList().Schema, map[string]interface{}{
+		"name":                  "test",
+		"dnc_source_type":       "rds",
+		"contact_method":        "Phone",
+		"dnc_filepath":          dncFile,
+		"dnc_file_column_names": []interface{}{"phone"},
+		"dnc_file_mode":         dncFileModeReplace,
+	})
+	d.SetId("dnc-list-id")
+
+	diagErr := importOutboundDncListFile(context.Background(), d, meta)
+	assert.False(t, diagErr.HasError(), diagErr)
+	assert.Equal(t, []string{"upload", "export", "remove"}, calls)
+	assert.Equal(t, []string{"13175550003"}, removed)
+	assert.Equal(t, dncFile, uploadedPath)
+	assert.Equal(t, []string{"phone"}, uploadColumns)
+	assert.Equal(t, 2, statusChecks)
+
+	hash, err := files.HashFileContent(dncFile)
+	assert.NoError(t, err)
+	assert.Equal(t, hash, d.Get("dnc_file_content_hash"))
+
+	// An unchanged file is not imported again
+	uploadedPath = ""
+	assert.False(t, importOutboundDncListFile(context.Background(), d, meta).HasError())
+	assert.Empty(t, uploadedPath)
+
+	// Nothing is removed when the upload fails
+	calls = nil
+	uploadErr = errors.New("upload failed")
+	_ = d.Set("dnc_file_content_hash", "")
+	assert.True(t, importOutboundDncListFile(context.Background(), d, meta).HasError())
+	assert.Equal(t, []string{"upload"}, calls)
+
+	// Files cannot be uploaded to external DNC lists
+	d = schema.TestResourceDataRaw(t, ResourceOutboundDncList().Schema, map[string]interface{}{
+		"name":            "test",
+		"dnc_source_type": "gryphon",
+		"dnc_filepath":    dncFile,
+	})
+	assert.True(t, importOutboundDncListFile(context.Background(), d, meta).HasError())
+}
+
+func TestUnitDncEntriesExporterResolver(t *testing.T) {
+	internalProxy = &outboundDnclistProxy{
+		initiateDncListExportAttr: func(_ context.Context, p *outboundDnclistProxy, dncListId string) (*platformclientv2.APIResponse, error) {
+			return &platformclientv2.APIResponse{StatusCode: 200}, nil
+		},
+		getDncListExportUrlAttr: func(_ context.Context, p *outboundDnclistProxy, dncListId string) (string, *platformclientv2.APIResponse, error) {
+			return "http://test-url.com/export", &platformclientv2.APIResponse{StatusCode: 200}, nil
+		},
+	}
+	defer func() { internalProxy = nil }()
+
+	origDownloadFile := files.DownloadExportFileWithAccessToken
+	files.DownloadExportFileWithAccessToken = func(directory, filename, url, accessToken string) (*platformclientv2.APIResponse, error) {
+		return nil, os.WriteFile(filepath.Join(directory, filename), []byte("phone_number\n+13175550001\n"), 0644)
+	}
+	defer func() { files.DownloadExportFileWithAccessToken = origDownloadFile }()
+
+	exportDirectory := t.TempDir()
+	configMap := map[string]interface{}{
+		"name":    "Test DNC",
+		"entries": []interface{}{},
+	}
+	resource := resourceExporter.ResourceInfo{
+		State:      &terraform.InstanceState{Attributes: map[string]string{"dnc_source_type": "rds"}},
+		BlockLabel: "test_dnc",
+	}
+
+	meta := &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}
+	err := DncEntriesExporterResolver("dnc-list-id", exportDirectory, "dnc_entries", configMap, meta, resource)
+	assert.NoError(t, err)
+
+	assert.FileExists(t, filepath.Join(exportDirectory, "dnc_entries", "test_dnc.csv"))
+	assert.Equal(t, filepath.Join("dnc_entries", "test_dnc.csv"), configMap["dnc_filepath"])
+	assert.Equal(t, []interface{}{"phone_number"}, configMap["dnc_file_column_names"])
+	assert.NotContains(t, configMap, "entries")
+	assert.NotEmpty(t, resource.State.Attributes["dnc_file_content_hash"])
+
+	// External DNC lists are left as they are
+	resource.State.Attributes["dnc_source_type"] = "gryphon"
+	configMap = map[string]interface{}{"name": "Test DNC"}
+	assert.NoError(t, DncEntriesExporterResolver("dnc-list-id", exportDirectory, "dnc_entries", configMap, meta, resource))
+	assert.NotContains(t, configMap, "dnc_filepath")
+}
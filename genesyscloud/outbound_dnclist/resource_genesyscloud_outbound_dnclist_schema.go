@@ -1,11 +1,13 @@
 package outbound_dnclist
 
 import (
+	"fmt"
 	"terraform-provider-genesyscloud/genesyscloud/provider"
 	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
 	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
 	"terraform-provider-genesyscloud/genesyscloud/validators"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -31,6 +33,10 @@ func ResourceOutboundDncList() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		SchemaVersion: 1,
+		CustomizeDiff: customdiff.All(
+			customdiff.ComputedIf("dnc_file_content_hash", validators.ValidateFileContentHashChanged("dnc_filepath", "dnc_file_content_hash")),
+			validators.ValidateCSVWithColumns("dnc_filepath", "dnc_file_column_names"),
+		),
 		Schema: map[string]*schema.Schema{
 			`name`: {
 				Description: `The name of the DncList.`,
@@ -89,9 +95,10 @@ func ResourceOutboundDncList() *schema.Resource {
 				ForceNew: true,
 			},
 			`entries`: {
-				Description: `Rows to add to the DNC list. To emulate removing phone numbers, you can set expiration_date to a date in the past.`,
-				Optional:    true,
-				Type:        schema.TypeList,
+				Description:   `Rows to add to the DNC list. To emulate removing phone numbers, you can set expiration_date to a date in the past. Conflicts with dnc_filepath, as a replace import would remove the entries.`,
+				Optional:      true,
+				Type:          schema.TypeList,
+				ConflictsWith: []string{`dnc_filepath`},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						`expiration_date`: {
@@ -112,6 +119,31 @@ func ResourceOutboundDncList() *schema.Resource {
 					},
 				},
 			},
+			`dnc_filepath`: {
+				Description:   `The path to a CSV file of entries to import into the DNC list through a DNC import job. Only possible if the dncSourceType is rds or rds_custom. The file is imported again when its content changes. Conflicts with entries.`,
+				Optional:      true,
+				Type:          schema.TypeString,
+				ValidateFunc:  validators.ValidatePath,
+				ConflictsWith: []string{`entries`},
+			},
+			`dnc_file_column_names`: {
+				Description: `The columns of dnc_filepath holding the phone numbers or email addresses of the DNC list. Not used by rds_custom lists, which use custom_exclusion_column.`,
+				Optional:    true,
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			`dnc_file_mode`: {
+				Description:  fmt.Sprintf(`How the contents of dnc_filepath are imported when they change. %s adds the entries of the file to the DNC list. %s also removes the entries of the DNC list that are not in the file, once the file is imported.`, dncFileModeAppend, dncFileModeReplace),
+				Optional:     true,
+				Default:      dncFileModeAppend,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{dncFileModeAppend, dncFileModeReplace}, false),
+			},
+			`dnc_file_content_hash`: {
+				Description: `Hash value of the dnc_filepath contents. This is a read-only attribute used to detect changes to the file.`,
+				Computed:    true,
+				Type:        schema.TypeString,
+			},
 		},
 	}
 }
@@ -136,5 +168,9 @@ func OutboundDncListExporter() *resourceExporter.ResourceExporter {
 		RefAttrs: map[string]*resourceExporter.RefAttrSettings{
 			"division_id": {RefType: "genesyscloud_auth_division"},
 		},
+		CustomFileWriter: resourceExporter.CustomFileWriterSettings{
+			RetrieveAndWriteFilesFunc: DncEntriesExporterResolver,
+			SubDirectory:              "dnc_entries",
+		},
 	}
 }
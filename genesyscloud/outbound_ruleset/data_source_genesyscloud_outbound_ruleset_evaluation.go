@@ -0,0 +1,126 @@
+package outbound_ruleset
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/lists"
+	"terraform-provider-genesyscloud/genesyscloud/util/resourcedata"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v154/platformclientv2"
+)
+
+/*
+   The data_source_genesyscloud_outbound_ruleset_evaluation.go contains the data source implementation
+   for evaluating the rules of a rule set against a sample contact.
+*/
+
+// dataSourceOutboundRulesetEvaluationRead evaluates the rules of the rule set against the contact
+func dataSourceOutboundRulesetEvaluationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var rules []platformclientv2.Dialerrule
+	if rulesetId := d.Get("ruleset_id").(string); rulesetId != "" {
+		sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+		proxy := getOutboundRulesetProxy(sdkConfig)
+
+		ruleset, resp, err := proxy.getOutboundRulesetById(ctx, rulesetId)
+		if err != nil {
+			return util.BuildAPIDiagnosticError(EvaluationDataSourceType, fmt.Sprintf("failed to read rule set %s | error: %s", rulesetId, err), resp)
+		}
+		if ruleset.Rules != nil {
+			rules = *ruleset.Rules
+		}
+	} else {
+		ruleMaps, err := resourcedata.DecodeJsonBlocks(d.Get("rules_json").(string), ResourceOutboundRuleset().Schema["rules"].Elem.(*schema.Resource))
+		if err != nil {
+			return util.BuildDiagnosticError(EvaluationDataSourceType, "invalid rules_json", err)
+		}
+		rules = *buildDialerules(ruleMaps)
+	}
+
+	now := time.Now()
+	if evaluationTime := d.Get("evaluation_time").(string); evaluationTime != "" {
+		now, _ = time.Parse(time.RFC3339, evaluationTime)
+	}
+
+	evalCtx := dialerEvaluationContext{
+		contact:            stringMap(d.Get("contact")),
+		phoneNumberColumn:  d.Get("phone_number_column").(string),
+		phoneNumberType:    d.Get("phone_number_type").(string),
+		wrapupCodeId:       d.Get("wrapup_code_id").(string),
+		systemDisposition:  d.Get("system_disposition").(string),
+		callAnalysisResult: d.Get("call_analysis_result").(string),
+		contactProperties:  stringMap(d.Get("contact_properties")),
+		dataActionOutputs:  stringMap(d.Get("data_action_outputs")),
+		now:                now,
+	}
+
+	results, updatedContact, err := evaluateDialerRules(rules, d.Get("category").(string), evalCtx)
+	if err != nil {
+		return util.BuildDiagnosticError(EvaluationDataSourceType, "failed to evaluate the rules", err)
+	}
+
+	flattenedRules, firedRuleNames, actions := flattenDialerRuleResults(results)
+
+	id, _ := json.Marshal([]interface{}{d.Get("ruleset_id"), d.Get("rules_json"), d.Get("category"), evalCtx.contact})
+	d.SetId(fmt.Sprintf("%x", sha256.Sum256(id)))
+	_ = d.Set("rules", flattenedRules)
+	_ = d.Set("fired_rule_names", firedRuleNames)
+	_ = d.Set("actions", actions)
+	_ = d.Set("updated_contact", updatedContact)
+	return nil
+}
+
+// flattenDialerRuleResults maps the rule results to the rules, fired_rule_names and actions attributes
+func flattenDialerRuleResults(results []dialerRuleResult) ([]interface{}, []string, []interface{}) {
+	rules := make([]interface{}, 0, len(results))
+	firedRuleNames := make([]string, 0)
+	actions := make([]interface{}, 0)
+	for _, result := range results {
+		ruleMap := map[string]interface{}{
+			"name":              stringValue(result.rule.Name),
+			"order":             ruleOrder(result.rule.Order),
+			"category":          stringValue(result.rule.Category),
+			"fired":             result.fired,
+			"condition_results": result.conditionResults,
+		}
+		rules = append(rules, ruleMap)
+
+		if !result.fired {
+			continue
+		}
+		firedRuleNames = append(firedRuleNames, stringValue(result.rule.Name))
+		if result.rule.Actions == nil {
+			continue
+		}
+		for _, action := range *result.rule.Actions {
+			actionMap := map[string]interface{}{
+				"rule_name":        stringValue(result.rule.Name),
+				"type":             stringValue(action.VarType),
+				"action_type_name": stringValue(action.ActionTypeName),
+				"update_option":    stringValue(action.UpdateOption),
+				"properties":       map[string]interface{}{},
+				"data_action_id":   "",
+			}
+			if action.Properties != nil {
+				actionMap["properties"] = *action.Properties
+			}
+			if action.DataAction != nil {
+				actionMap["data_action_id"] = stringValue(action.DataAction.Id)
+			}
+			actions = append(actions, actionMap)
+		}
+	}
+	return rules, firedRuleNames, actions
+}
+
+// stringMap converts a TypeMap of strings to a map[string]string
+func stringMap(value interface{}) map[string]string {
+	values, _ := value.(map[string]interface{})
+	return lists.ConvertMapStringAnyToMapStringString(values)
+}
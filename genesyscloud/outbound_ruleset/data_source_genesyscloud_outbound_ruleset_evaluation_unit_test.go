@@ -0,0 +1,157 @@
+package outbound_ruleset
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+// testEvaluationRulesJson is shaped like jsonencode() of the rules of a genesyscloud_outbound_ruleset, including the null
+// values of the attributes that are not set
+const testEvaluationRulesJson = `[
+  {
+    "name": "Mark VIP",
+    "order": 1,
+    "category": "DIALER_PRECALL",
+    "conditions": [
+      {"type": "contactAttributeCondition", "attribute_name": "balance", "operator": "GREATER_THAN", "value": "1000", "value_type": "NUMERIC", "inverted": false, "codes": null, "predicates": null, "data_action_id": null, "data_not_found_resolution": null},
+      {"type": "contactAttributeCondition", "attribute_name": "state", "operator": "IN", "value": "CA, NY", "value_type": "STRING", "inverted": false}
+    ],
+    "actions": [
+      {"type": "modifyContactAttribute", "action_type_name": "MODIFY_CONTACT_ATTRIBUTE", "update_option": "SET", "properties": {"tier": "vip"}, "data_action_id": null}
+    ]
+  },
+  {
+    "name": "Skip recently called",
+    "order": 0,
+    "category": "DIALER_PRECALL",
+    "conditions": [
+      {"type": "contactAttributeCondition", "attribute_name": "last_called", "operator": "AFTER", "value": "P1D", "value_type": "PERIOD", "inverted": false}
+    ],
+    "actions": [
+      {"type": "Action", "action_type_name": "DO_NOT_DIAL", "properties": null}
+    ]
+  },
+  {
+    "name": "Count busy",
+    "order": 2,
+    "category": "DIALER_WRAPUP",
+    "conditions": [
+      {"type": "systemDispositionCondition", "codes": ["ININ-OUTBOUND-BUSY"], "inverted": false}
+    ],
+    "actions": [
+      {"type": "modifyContactAttribute", "action_type_name": "MODIFY_CONTACT_ATTRIBUTE", "update_option": "INCREMENT", "properties": {"busy_count": "1"}}
+    ]
+  }
+]`
+
+func TestUnitDataSourceOutboundRulesetEvaluationRead(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, DataSourceOutboundRulesetEvaluation().Schema, map[string]interface{}{
+		"rules_json":      testEvaluationRulesJson,
+		"category":        "DIALER_PRECALL",
+		"evaluation_time": "2024-06-10T12:00:00Z",
+		"contact": map[string]interface{}{
+			"balance":     "2500.50",
+			"state":       "NY",
+			"last_called": "2024-06-01T09:00:00Z",
+		},
+	})
+
+	diags := dataSourceOutboundRulesetEvaluationRead(context.Background(), d, nil)
+	assert.False(t, diags.HasError(), diags)
+	assert.NotEmpty(t, d.Id())
+
+	// Rules are processed by order and only the precall rules are evaluated
+	assert.Equal(t, 2, d.Get("rules.#"))
+	assert.Equal(t, "Skip recently called", d.Get("rules.0.name"))
+	assert.Equal(t, false, d.Get("rules.0.fired"))
+	assert.Equal(t, "Mark VIP", d.Get("rules.1.name"))
+	assert.Equal(t, []interface{}{true, true}, d.Get("rules.1.condition_results"))
+
+	assert.Equal(t, []interface{}{"Mark VIP"}, d.Get("fired_rule_names"))
+	assert.Equal(t, 1, d.Get("actions.#"))
+	assert.Equal(t, "MODIFY_CONTACT_ATTRIBUTE", d.Get("actions.0.action_type_name"))
+	assert.Equal(t, "vip", d.Get("updated_contact.tier"))
+	assert.Equal(t, "NY", d.Get("updated_contact.state"))
+}
+
+func TestUnitDataSourceOutboundRulesetEvaluationMissingInput(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, DataSourceOutboundRulesetEvaluation().Schema, map[string]interface{}{
+		"rules_json": testEvaluationRulesJson,
+		"category":   "DIALER_WRAPUP",
+		"contact":    map[string]interface{}{"busy_count": "2"},
+	})
+
+	diags := dataSourceOutboundRulesetEvaluationRead(context.Background(), d, nil)
+	if assert.True(t, diags.HasError()) {
+		assert.Contains(t, diags[0].Detail, `condition 1: systemDispositionCondition requires system_disposition`)
+	}
+
+	_ = d.Set("system_disposition", "ININ-OUTBOUND-BUSY")
+	diags = dataSourceOutboundRulesetEvaluationRead(context.Background(), d, nil)
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, "3", d.Get("updated_contact.busy_count"))
+}
+
+func TestUnitCompareValues(t *testing.T) {
+	now := time.Date(2024, 6, 10, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		operator, valueType, actual, expected string
+		want                                  bool
+		wantErr                               bool
+	}{
+		{"EQUALS", "STRING", "abc", "abc", true, false},
+		{"EQUALS", "", "abc", "ABC", false, false},
+		{"BEGINS_WITH", "STRING", "+13175550100", "+1317", true, false},
+		{"EndsWith", "String", "user@example.com", "@example.com", true, false},
+		{"CONTAINS", "STRING", "premium plan", "plan", true, false},
+		{"LESS_THAN", "NUMERIC", "9", "10", true, false},
+		{"GreaterThanEquals", "Numeric", "10.0", "10", true, false},
+		{"IN", "NUMERIC", "2", "1, 2, 3", true, false},
+		{"IN", "STRING", "TX", "CA,NY", false, false},
+		{"BEFORE", "DATETIME", "2024-01-01", "2024-01-02T00:00:00Z", true, false},
+		{"AFTER", "DATETIME", "01/03/2024", "2024-01-02", true, false},
+		{"BEFORE", "PERIOD", "2024-06-08T12:00:00Z", "P1D", true, false},
+		{"AFTER", "PERIOD", "2024-06-10T11:00:00Z", "PT2H", true, false},
+		{"Before", "Period", "2024-06-10T11:00:00Z", "PT30M", true, false},
+		{"BEFORE", "STRING", "a", "b", false, true},
+		{"LESS_THAN", "NUMERIC", "abc", "1", false, true},
+		{"AFTER", "PERIOD", "2024-06-10T11:00:00Z", "1 day", false, true},
+	}
+	for _, test := range tests {
+		got, err := CompareValues(test.operator, test.valueType, test.actual, test.expected, now)
+		if test.wantErr {
+			assert.Error(t, err, "%s %s %s %s", test.actual, test.operator, test.valueType, test.expected)
+			continue
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, test.want, got, "%s %s %s %s", test.actual, test.operator, test.valueType, test.expected)
+	}
+}
+
+func TestUnitEvaluateDataActionOutputs(t *testing.T) {
+	now := time.Now()
+	predicates := []DataActionPredicate{
+		{OutputField: "score", OutputOperator: "GREATER_THAN", ComparisonValue: "50"},
+		{OutputField: "segment", OutputOperator: "EQUALS", ComparisonValue: "churn", Inverted: true},
+		{OutputField: "optional", OutputOperator: "EQUALS", ComparisonValue: "x", OutputFieldMissingResolution: true},
+	}
+
+	matched, err := EvaluateDataActionOutputs(`{"score": 75, "segment": "loyal"}`, false, predicates, now)
+	assert.NoError(t, err)
+	assert.True(t, matched)
+
+	matched, err = EvaluateDataActionOutputs(`{"score": 75, "segment": "churn"}`, false, predicates, now)
+	assert.NoError(t, err)
+	assert.False(t, matched)
+
+	matched, err = EvaluateDataActionOutputs(`null`, true, predicates, now)
+	assert.NoError(t, err)
+	assert.True(t, matched)
+
+	_, err = EvaluateDataActionOutputs(`not json`, false, predicates, now)
+	assert.Error(t, err)
+}
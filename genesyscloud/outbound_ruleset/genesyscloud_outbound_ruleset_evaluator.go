@@ -0,0 +1,442 @@
+package outbound_ruleset
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v154/platformclientv2"
+)
+
+/*
+The genesyscloud_outbound_ruleset_evaluator.go file evaluates the rules of a rule set locally against a sample contact, the
+way the outbound dialer evaluates them, so that rule changes can be tested without dialing anyone.
+The comparisons, data action predicates and contact updates are exported for the digital rule set evaluator.
+*/
+
+// DataActionPredicate is a comparison of an output field of a data action
+type DataActionPredicate struct {
+	OutputField                  string
+	OutputOperator               string
+	ComparisonValue              string
+	Inverted                     bool
+	OutputFieldMissingResolution bool
+}
+
+// dialerEvaluationContext holds the values the conditions of a dialer rule are evaluated against
+type dialerEvaluationContext struct {
+	contact            map[string]string
+	phoneNumberColumn  string
+	phoneNumberType    string
+	wrapupCodeId       string
+	systemDisposition  string
+	callAnalysisResult string
+	contactProperties  map[string]string
+	dataActionOutputs  map[string]string
+	now                time.Time
+}
+
+// dialerRuleResult is the outcome of evaluating a dialer rule
+type dialerRuleResult struct {
+	rule             platformclientv2.Dialerrule
+	conditionResults []bool
+	fired            bool
+}
+
+var (
+	periodRegex = regexp.MustCompile(`^(-)?P(?:(\d+)Y)?(?:(\d+)M)?(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+(?:\.\d+)?)S)?)?$`)
+
+	dateTimeFormats = []string{time.RFC3339, "2006-01-02T15:04:05", "2006-01-02 15:04:05", "2006-01-02 15:04", "2006-01-02", "01/02/2006 15:04", "01/02/2006"}
+)
+
+// evaluateDialerRules evaluates the rules of the category, or of every category when empty, in the order they are processed
+// by the dialer. It returns the result of each rule and the contact as updated by the MODIFY_CONTACT_ATTRIBUTE actions of the
+// rules that fired. Every rule is evaluated against the contact as it was before any action ran.
+func evaluateDialerRules(rules []platformclientv2.Dialerrule, category string, evalCtx dialerEvaluationContext) ([]dialerRuleResult, map[string]string, error) {
+	sorted := make([]platformclientv2.Dialerrule, 0, len(rules))
+	for _, rule := range rules {
+		if category == "" || (rule.Category != nil && *rule.Category == category) {
+			sorted = append(sorted, rule)
+		}
+	}
+	sort.SliceStable(sorted, func(i, j int) bool { return ruleOrder(sorted[i].Order) < ruleOrder(sorted[j].Order) })
+
+	updatedContact := make(map[string]string, len(evalCtx.contact))
+	for column, value := range evalCtx.contact {
+		updatedContact[column] = value
+	}
+
+	results := make([]dialerRuleResult, 0, len(sorted))
+	for _, rule := range sorted {
+		ruleName := ""
+		if rule.Name != nil {
+			ruleName = *rule.Name
+		}
+
+		result := dialerRuleResult{rule: rule, fired: true}
+		if rule.Conditions != nil {
+			for i, condition := range *rule.Conditions {
+				matched, err := evaluateDialerCondition(condition, evalCtx)
+				if err != nil {
+					return nil, nil, fmt.Errorf("rule %q condition %d: %s", ruleName, i+1, err)
+				}
+				result.conditionResults = append(result.conditionResults, matched)
+				result.fired = result.fired && matched
+			}
+		}
+
+		if result.fired && rule.Actions != nil {
+			for i, action := range *rule.Actions {
+				if action.ActionTypeName == nil || *action.ActionTypeName != "MODIFY_CONTACT_ATTRIBUTE" || action.Properties == nil {
+					continue
+				}
+				updateOption := ""
+				if action.UpdateOption != nil {
+					updateOption = *action.UpdateOption
+				}
+				if err := ApplyContactUpdate(updatedContact, updateOption, *action.Properties, evalCtx.now); err != nil {
+					return nil, nil, fmt.Errorf("rule %q action %d: %s", ruleName, i+1, err)
+				}
+			}
+		}
+		results = append(results, result)
+	}
+	return results, updatedContact, nil
+}
+
+// evaluateDialerCondition evaluates a single condition, including its inversion
+func evaluateDialerCondition(condition platformclientv2.Condition, evalCtx dialerEvaluationContext) (bool, error) {
+	conditionType := stringValue(condition.VarType)
+	operator := stringValue(condition.Operator)
+	valueType := stringValue(condition.ValueType)
+	value := stringValue(condition.Value)
+
+	var (
+		matched bool
+		err     error
+	)
+	switch conditionType {
+	case "contactAttributeCondition":
+		attributeName := stringValue(condition.AttributeName)
+		actual, ok := evalCtx.contact[attributeName]
+		if !ok {
+			return false, fmt.Errorf("the contact has no column %q", attributeName)
+		}
+		matched, err = CompareValues(operator, valueType, actual, value, evalCtx.now)
+	case "phoneNumberCondition":
+		if evalCtx.phoneNumberColumn == "" {
+			return false, fmt.Errorf("%s requires phone_number_column", conditionType)
+		}
+		actual, ok := evalCtx.contact[evalCtx.phoneNumberColumn]
+		if !ok {
+			return false, fmt.Errorf("the contact has no column %q", evalCtx.phoneNumberColumn)
+		}
+		matched, err = CompareValues(operator, valueType, actual, value, evalCtx.now)
+	case "phoneNumberTypeCondition":
+		if evalCtx.phoneNumberType == "" {
+			return false, fmt.Errorf("%s requires phone_number_type", conditionType)
+		}
+		matched, err = CompareValues(operator, valueType, evalCtx.phoneNumberType, value, evalCtx.now)
+	case "wrapupCondition":
+		if evalCtx.wrapupCodeId == "" {
+			return false, fmt.Errorf("%s requires wrapup_code_id", conditionType)
+		}
+		matched, err = matchCodesOrValue(condition, evalCtx.wrapupCodeId, evalCtx.now)
+	case "systemDispositionCondition":
+		if evalCtx.systemDisposition == "" {
+			return false, fmt.Errorf("%s requires system_disposition", conditionType)
+		}
+		matched, err = matchCodesOrValue(condition, evalCtx.systemDisposition, evalCtx.now)
+	case "callAnalysisCondition":
+		if evalCtx.callAnalysisResult == "" {
+			return false, fmt.Errorf("%s requires call_analysis_result", conditionType)
+		}
+		matched, err = matchCodesOrValue(condition, evalCtx.callAnalysisResult, evalCtx.now)
+	case "contactPropertyCondition":
+		key := stringValue(condition.PropertyType)
+		if strings.HasSuffix(key, "_BY_COLUMN") {
+			key = fmt.Sprintf("%s:%s", key, stringValue(condition.Property))
+		}
+		actual, ok := evalCtx.contactProperties[key]
+		if !ok {
+			return false, fmt.Errorf("%s requires contact_properties to contain %q", conditionType, key)
+		}
+		matched, err = CompareValues(operator, valueType, actual, value, evalCtx.now)
+	case "dataActionCondition":
+		dataActionId := ""
+		if condition.DataAction != nil {
+			dataActionId = stringValue(condition.DataAction.Id)
+		}
+		outputs, ok := evalCtx.dataActionOutputs[dataActionId]
+		if !ok {
+			return false, fmt.Errorf("%s requires data_action_outputs to contain %q", conditionType, dataActionId)
+		}
+		var predicates []DataActionPredicate
+		if condition.Predicates != nil {
+			for _, predicate := range *condition.Predicates {
+				predicates = append(predicates, DataActionPredicate{
+					OutputField:                  stringValue(predicate.OutputField),
+					OutputOperator:               stringValue(predicate.OutputOperator),
+					ComparisonValue:              stringValue(predicate.ComparisonValue),
+					Inverted:                     boolValue(predicate.Inverted),
+					OutputFieldMissingResolution: boolValue(predicate.OutputFieldMissingResolution),
+				})
+			}
+		}
+		matched, err = EvaluateDataActionOutputs(outputs, boolValue(condition.DataNotFoundResolution), predicates, evalCtx.now)
+	default:
+		return false, fmt.Errorf("unsupported condition type %q", conditionType)
+	}
+	if err != nil {
+		return false, err
+	}
+	return matched != boolValue(condition.Inverted), nil
+}
+
+// matchCodesOrValue matches the actual value against the codes of a condition or, without codes, against its value
+func matchCodesOrValue(condition platformclientv2.Condition, actual string, now time.Time) (bool, error) {
+	if condition.Codes != nil && len(*condition.Codes) > 0 {
+		for _, code := range *condition.Codes {
+			if code == actual {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+	return CompareValues(stringValue(condition.Operator), stringValue(condition.ValueType), actual, stringValue(condition.Value), now)
+}
+
+// CompareValues compares the value of a contact with the value of a condition. Operators and value types are accepted in
+// the notation of dialer rule sets (LESS_THAN, NUMERIC) and of digital rule sets (LessThan, Numeric). PERIOD values are
+// ISO-8601 durations counted back from now, so BEFORE P1D matches times more than a day before now.
+func CompareValues(operator, valueType, actual, expected string, now time.Time) (bool, error) {
+	operator = normalizeEnum(operator)
+	valueType = normalizeEnum(valueType)
+	if operator == "" {
+		operator = "EQUALS"
+	}
+
+	if operator == "IN" {
+		for _, item := range strings.Split(expected, ",") {
+			matched, err := CompareValues("EQUALS", valueType, actual, strings.TrimSpace(item), now)
+			if err != nil {
+				return false, err
+			}
+			if matched {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+
+	var comparison int
+	switch valueType {
+	case "", "STRING":
+		switch operator {
+		case "CONTAINS":
+			return strings.Contains(actual, expected), nil
+		case "BEGINSWITH":
+			return strings.HasPrefix(actual, expected), nil
+		case "ENDSWITH":
+			return strings.HasSuffix(actual, expected), nil
+		case "BEFORE", "AFTER":
+			return false, fmt.Errorf("operator %s cannot compare STRING values", operator)
+		}
+		comparison = strings.Compare(actual, expected)
+	case "NUMERIC":
+		actualNumber, err := strconv.ParseFloat(strings.TrimSpace(actual), 64)
+		if err != nil {
+			return false, fmt.Errorf("%q is not a NUMERIC value", actual)
+		}
+		expectedNumber, err := strconv.ParseFloat(strings.TrimSpace(expected), 64)
+		if err != nil {
+			return false, fmt.Errorf("%q is not a NUMERIC value", expected)
+		}
+		comparison = compareFloats(actualNumber, expectedNumber)
+	case "DATETIME", "PERIOD":
+		actualTime, err := parseDateTime(actual)
+		if err != nil {
+			return false, err
+		}
+		var expectedTime time.Time
+		if valueType == "DATETIME" {
+			expectedTime, err = parseDateTime(expected)
+		} else {
+			expectedTime, err = periodStart(expected, now)
+		}
+		if err != nil {
+			return false, err
+		}
+		comparison = actualTime.Compare(expectedTime)
+	default:
+		return false, fmt.Errorf("unsupported value type %q", valueType)
+	}
+
+	switch operator {
+	case "EQUALS":
+		return comparison == 0, nil
+	case "LESSTHAN", "BEFORE":
+		return comparison < 0, nil
+	case "LESSTHANEQUALS":
+		return comparison <= 0, nil
+	case "GREATERTHAN", "AFTER":
+		return comparison > 0, nil
+	case "GREATERTHANEQUALS":
+		return comparison >= 0, nil
+	}
+	return false, fmt.Errorf("operator %s cannot compare %s values", operator, valueType)
+}
+
+// EvaluateDataActionOutputs evaluates the predicates of a data action condition against the JSON encoded output of the data
+// action. An output of null means the data action found no data, which resolves to dataNotFoundResolution.
+func EvaluateDataActionOutputs(outputsJson string, dataNotFoundResolution bool, predicates []DataActionPredicate, now time.Time) (bool, error) {
+	var outputs map[string]interface{}
+	if err := json.Unmarshal([]byte(outputsJson), &outputs); err != nil {
+		return false, fmt.Errorf("invalid data action output %s: %s", outputsJson, err)
+	}
+	if outputs == nil {
+		return dataNotFoundResolution, nil
+	}
+
+	for _, predicate := range predicates {
+		output, ok := outputs[predicate.OutputField]
+		if !ok || output == nil {
+			if !predicate.OutputFieldMissingResolution {
+				return false, nil
+			}
+			continue
+		}
+
+		var actual string
+		switch value := output.(type) {
+		case string:
+			actual = value
+		case float64:
+			actual = strconv.FormatFloat(value, 'f', -1, 64)
+		default:
+			encoded, _ := json.Marshal(value)
+			actual = string(encoded)
+		}
+
+		// Output fields are untyped, so numbers are compared as numbers
+		valueType := "STRING"
+		if _, err := strconv.ParseFloat(actual, 64); err == nil {
+			if _, err := strconv.ParseFloat(predicate.ComparisonValue, 64); err == nil {
+				valueType = "NUMERIC"
+			}
+		}
+		matched, err := CompareValues(predicate.OutputOperator, valueType, actual, predicate.ComparisonValue, now)
+		if err != nil {
+			return false, fmt.Errorf("output field %s: %s", predicate.OutputField, err)
+		}
+		if matched == predicate.Inverted {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// ApplyContactUpdate applies a contact attribute update to the contact. Update options are accepted in the notation of dialer
+// rule sets (CURRENT_TIME) and of digital rule sets (CurrentTime).
+func ApplyContactUpdate(contact map[string]string, updateOption string, properties map[string]string, now time.Time) error {
+	for column, value := range properties {
+		switch normalizeEnum(updateOption) {
+		case "", "SET":
+			contact[column] = value
+		case "INCREMENT", "DECREMENT":
+			current, err := strconv.ParseFloat(strings.TrimSpace(contact[column]), 64)
+			if strings.TrimSpace(contact[column]) == "" {
+				current, err = 0, nil
+			}
+			if err != nil {
+				return fmt.Errorf("column %q value %q is not numeric", column, contact[column])
+			}
+			delta := 1.0
+			if strings.TrimSpace(value) != "" {
+				if delta, err = strconv.ParseFloat(strings.TrimSpace(value), 64); err != nil {
+					return fmt.Errorf("%s value %q of column %q is not numeric", updateOption, value, column)
+				}
+			}
+			if normalizeEnum(updateOption) == "DECREMENT" {
+				delta = -delta
+			}
+			contact[column] = strconv.FormatFloat(current+delta, 'f', -1, 64)
+		case "CURRENTTIME":
+			contact[column] = now.UTC().Format(time.RFC3339)
+		default:
+			return fmt.Errorf("unsupported update option %q", updateOption)
+		}
+	}
+	return nil
+}
+
+// periodStart returns the time an ISO-8601 duration before now. A negative duration (-P1D) is after now.
+func periodStart(period string, now time.Time) (time.Time, error) {
+	match := periodRegex.FindStringSubmatch(strings.ToUpper(strings.TrimSpace(period)))
+	hasComponent := false
+	parts := make([]float64, len(match))
+	for i := 2; i < len(match); i++ {
+		if match[i] != "" {
+			hasComponent = true
+			parts[i], _ = strconv.ParseFloat(match[i], 64)
+		}
+	}
+	if !hasComponent {
+		return time.Time{}, fmt.Errorf("%q is not an ISO-8601 duration", period)
+	}
+	sign := -1
+	if match[1] == "-" {
+		sign = 1
+	}
+	start := now.AddDate(sign*int(parts[2]), sign*int(parts[3]), sign*(int(parts[4])*7+int(parts[5])))
+	duration := time.Duration(parts[6])*time.Hour + time.Duration(parts[7])*time.Minute + time.Duration(parts[8]*float64(time.Second))
+	return start.Add(time.Duration(sign) * duration), nil
+}
+
+// parseDateTime parses the date and time formats commonly used in contact lists
+func parseDateTime(value string) (time.Time, error) {
+	for _, format := range dateTimeFormats {
+		if parsed, err := time.Parse(format, strings.TrimSpace(value)); err == nil {
+			return parsed, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("%q is not a DATETIME value", value)
+}
+
+func compareFloats(a, b float64) int {
+	switch {
+	case math.Abs(a-b) < 1e-9:
+		return 0
+	case a < b:
+		return -1
+	}
+	return 1
+}
+
+// normalizeEnum maps LESS_THAN and LessThan to LESSTHAN
+func normalizeEnum(value string) string {
+	return strings.ToUpper(strings.ReplaceAll(value, "_", ""))
+}
+
+func ruleOrder(order *int) int {
+	if order == nil {
+		return 0
+	}
+	return *order
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+func boolValue(value *bool) bool {
+	return value != nil && *value
+}
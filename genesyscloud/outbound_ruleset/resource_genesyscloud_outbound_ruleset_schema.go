@@ -18,11 +18,13 @@ resource_genesyscloud_outbound_ruleset_schema.go holds four functions within it:
 4.  The resource exporter configuration for the outbound_ruleset exporter.
 */
 const ResourceType = "genesyscloud_outbound_ruleset"
+const EvaluationDataSourceType = "genesyscloud_outbound_ruleset_evaluation"
 
 // SetRegistrar registers all of the resources, datasources and exporters in the package
 func SetRegistrar(regInstance registrar.Registrar) {
 	regInstance.RegisterResource(ResourceType, ResourceOutboundRuleset())
 	regInstance.RegisterDataSource(ResourceType, DataSourceOutboundRuleset())
+	regInstance.RegisterDataSource(EvaluationDataSourceType, DataSourceOutboundRulesetEvaluation())
 	regInstance.RegisterExporter(ResourceType, OutboundRulesetExporter())
 }
 
@@ -269,6 +271,7 @@ func ResourceOutboundRuleset() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		SchemaVersion: 1,
+		CustomizeDiff: validateRulesetColumnsDiff,
 		Schema: map[string]*schema.Schema{
 			`name`: {
 				Description: `The name of the RuleSet.`,
@@ -276,7 +279,7 @@ func ResourceOutboundRuleset() *schema.Resource {
 				Type:        schema.TypeString,
 			},
 			`contact_list_id`: {
-				Description: `A ContactList to provide user-interface suggestions for contact columns on relevant conditions and actions.`,
+				Description: `A ContactList to provide user-interface suggestions for contact columns on relevant conditions and actions. When set, the contact columns referenced by the conditions and actions must exist in the column_names of the contact list, which is verified at plan time, or during apply when the contact list is created in the same apply.`,
 				Optional:    true,
 				Type:        schema.TypeString,
 			},
@@ -341,3 +344,167 @@ func DataSourceOutboundRuleset() *schema.Resource {
 		},
 	}
 }
+
+// DataSourceOutboundRulesetEvaluation registers the genesyscloud_outbound_ruleset_evaluation data source
+func DataSourceOutboundRulesetEvaluation() *schema.Resource {
+	return &schema.Resource{
+		Description: `Evaluates the rules of an outbound rule set locally against a sample contact and reports which rules fire and which actions would run. Nothing is dialed and nothing is changed in Genesys Cloud, so rule changes can be tested in CI before they reach a live campaign. Conditions are evaluated the way the outbound dialer evaluates them; conditions on values only known during a call (wrap-up code, system disposition, call analysis result, contact properties and data action results) are evaluated against the values provided.`,
+		ReadContext: provider.ReadWithPooledClient(dataSourceOutboundRulesetEvaluationRead),
+		Schema: map[string]*schema.Schema{
+			`ruleset_id`: {
+				Description:  `The ID of a rule set in the org to evaluate.`,
+				Optional:     true,
+				Type:         schema.TypeString,
+				ExactlyOneOf: []string{"ruleset_id", "rules_json"},
+			},
+			`rules_json`: {
+				Description:  `The rules to evaluate, as the JSON encoding of the rules of a genesyscloud_outbound_ruleset, e.g. jsonencode(genesyscloud_outbound_ruleset.example.rules). Rules that are fully known at plan time are evaluated at plan time, before they are applied.`,
+				Optional:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringIsJSON,
+			},
+			`category`: {
+				Description:  `Only evaluate the rules of this category. All rules are evaluated when not set.`,
+				Optional:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{`DIALER_PRECALL`, `DIALER_WRAPUP`}, false),
+			},
+			`contact`: {
+				Description: `The sample contact record, keyed by contact column name.`,
+				Required:    true,
+				Type:        schema.TypeMap,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			`phone_number_column`: {
+				Description: `The contact column holding the phone number being dialed. Required to evaluate a phoneNumberCondition.`,
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			`phone_number_type`: {
+				Description: `The type of the phone number being dialed. Required to evaluate a phoneNumberTypeCondition.`,
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			`wrapup_code_id`: {
+				Description: `The wrap-up code of the call. Required to evaluate a wrapupCondition.`,
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			`system_disposition`: {
+				Description: `The system disposition of the call, e.g. ININ-OUTBOUND-BUSY. Required to evaluate a systemDispositionCondition.`,
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			`call_analysis_result`: {
+				Description: `The call analysis result of the call. Required to evaluate a callAnalysisCondition.`,
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			`contact_properties`: {
+				Description: `The values of the contact properties used by contactPropertyCondition conditions, keyed by property type (e.g. LAST_WRAPUP_OVERALL) or, for the _BY_COLUMN property types, by property type and column separated by a colon (e.g. LAST_ATTEMPT_BY_COLUMN:phone).`,
+				Optional:    true,
+				Type:        schema.TypeMap,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			`data_action_outputs`: {
+				Description: `The JSON encoded output of the data actions used by dataActionCondition conditions, keyed by data action ID. An output of null means the data action found no data.`,
+				Optional:    true,
+				Type:        schema.TypeMap,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			`evaluation_time`: {
+				Description:  `The RFC 3339 time PERIOD values are counted back from and CURRENT_TIME updates are set to. Defaults to the time of the evaluation.`,
+				Optional:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			`rules`: {
+				Description: `The result of each evaluated rule, in the order the rules are processed.`,
+				Computed:    true,
+				Type:        schema.TypeList,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						`name`: {
+							Description: `The name of the rule.`,
+							Computed:    true,
+							Type:        schema.TypeString,
+						},
+						`order`: {
+							Description: `The order of the rule.`,
+							Computed:    true,
+							Type:        schema.TypeInt,
+						},
+						`category`: {
+							Description: `The category of the rule.`,
+							Computed:    true,
+							Type:        schema.TypeString,
+						},
+						`fired`: {
+							Description: `Whether all the conditions of the rule are true, so that its actions run.`,
+							Computed:    true,
+							Type:        schema.TypeBool,
+						},
+						`condition_results`: {
+							Description: `The result of each condition of the rule, after inversion.`,
+							Computed:    true,
+							Type:        schema.TypeList,
+							Elem:        &schema.Schema{Type: schema.TypeBool},
+						},
+					},
+				},
+			},
+			`fired_rule_names`: {
+				Description: `The names of the rules that fire, in the order they are processed.`,
+				Computed:    true,
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			`actions`: {
+				Description: `The actions that would run, in the order they would run.`,
+				Computed:    true,
+				Type:        schema.TypeList,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						`rule_name`: {
+							Description: `The name of the rule the action belongs to.`,
+							Computed:    true,
+							Type:        schema.TypeString,
+						},
+						`type`: {
+							Description: `The type of the action.`,
+							Computed:    true,
+							Type:        schema.TypeString,
+						},
+						`action_type_name`: {
+							Description: `The action type name of the action.`,
+							Computed:    true,
+							Type:        schema.TypeString,
+						},
+						`update_option`: {
+							Description: `The update option of a MODIFY_CONTACT_ATTRIBUTE action.`,
+							Computed:    true,
+							Type:        schema.TypeString,
+						},
+						`properties`: {
+							Description: `The properties of the action.`,
+							Computed:    true,
+							Type:        schema.TypeMap,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						`data_action_id`: {
+							Description: `The data action of a dataActionBehavior action.`,
+							Computed:    true,
+							Type:        schema.TypeString,
+						},
+					},
+				},
+			},
+			`updated_contact`: {
+				Description: `The contact after the MODIFY_CONTACT_ATTRIBUTE actions of the rules that fire. Every rule is evaluated against the contact as it was before any action ran.`,
+				Computed:    true,
+				Type:        schema.TypeMap,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
@@ -3,8 +3,10 @@ package outbound_ruleset
 import (
 	"testing"
 
+	obContactList "terraform-provider-genesyscloud/genesyscloud/outbound_contact_list"
 	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/mypurecloud/platform-client-sdk-go/v154/platformclientv2"
@@ -93,3 +95,41 @@ func TestUnitDoesRuleActionsRefDeletedSkill(t *testing.T) {
 	exists = doesRuleActionsRefDeletedSkill(rule, skillMap)
 	assert.True(t, exists)
 }
+
+func TestUnitBuildRulesetColumnReferences(t *testing.T) {
+	rules := []interface{}{
+		map[string]interface{}{
+			"conditions": []interface{}{
+				map[string]interface{}{"type": "contactAttributeCondition", "attribute_name": "balance"},
+				map[string]interface{}{"type": "contactPropertyCondition", "property_type": "LAST_ATTEMPT_BY_COLUMN", "property": "phone"},
+				map[string]interface{}{"type": "contactPropertyCondition", "property_type": "LAST_ATTEMPT_OVERALL", "property": "ignored"},
+				map[string]interface{}{
+					"type": "dataActionCondition",
+					"contact_column_to_data_action_field_mappings": []interface{}{
+						map[string]interface{}{"contact_column_name": "account", "data_action_field": "accountId"},
+					},
+				},
+			},
+			"actions": []interface{}{
+				map[string]interface{}{
+					"action_type_name": "MODIFY_CONTACT_ATTRIBUTE",
+					"properties":       map[string]interface{}{"status": "called"},
+				},
+				map[string]interface{}{
+					"action_type_name": "SET_CALLER_ID",
+					"properties":       map[string]interface{}{"callerAddress": "contact.callerId", "callerName": "Acme"},
+				},
+			},
+		},
+	}
+
+	conditions := cty.GetAttrPath("rules").IndexInt(0).GetAttr("conditions")
+	actions := cty.GetAttrPath("rules").IndexInt(0).GetAttr("actions")
+	assert.Equal(t, []obContactList.ColumnReference{
+		{Path: conditions.IndexInt(0).GetAttr("attribute_name"), ColumnName: "balance"},
+		{Path: conditions.IndexInt(1).GetAttr("property"), ColumnName: "phone"},
+		{Path: conditions.IndexInt(3).GetAttr("contact_column_to_data_action_field_mappings").IndexInt(0).GetAttr("contact_column_name"), ColumnName: "account"},
+		{Path: actions.IndexInt(0).GetAttr("properties").Index(cty.StringVal("status")), ColumnName: "status"},
+		{Path: actions.IndexInt(1).GetAttr("properties").Index(cty.StringVal("callerAddress")), ColumnName: "callerId"},
+	}, buildRulesetColumnReferences(rules))
+}
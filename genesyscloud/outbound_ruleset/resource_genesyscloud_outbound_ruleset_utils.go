@@ -1,14 +1,18 @@
 package outbound_ruleset
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
+	"sort"
 	"strings"
+	obContactList "terraform-provider-genesyscloud/genesyscloud/outbound_contact_list"
 	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
 	"terraform-provider-genesyscloud/genesyscloud/util"
 	"terraform-provider-genesyscloud/genesyscloud/util/resourcedata"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/mypurecloud/platform-client-sdk-go/v154/platformclientv2"
 )
@@ -329,3 +333,80 @@ resource "%s" "%s" {
 }
 	`, ResourceType, resourceLabel, name), reference
 }
+
+// validateRulesetColumnsDiff checks that the columns referenced by the rules exist in the contact list of the rule set
+func validateRulesetColumnsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("contact_list_id") || !diff.HasChanges("contact_list_id", "rules") {
+		return nil
+	}
+	return obContactList.ValidateColumnReferences(ctx, meta, diff.Get("contact_list_id").(string), buildRulesetColumnReferences(diff.Get("rules").([]interface{})))
+}
+
+// buildRulesetColumnReferences returns the contact list columns referenced by the conditions and actions of the rules
+func buildRulesetColumnReferences(rules []interface{}) []obContactList.ColumnReference {
+	var references []obContactList.ColumnReference
+	for i, rule := range rules {
+		ruleMap, ok := rule.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		rulePath := cty.GetAttrPath("rules").IndexInt(i)
+
+		conditions, _ := ruleMap["conditions"].([]interface{})
+		for j, condition := range conditions {
+			conditionMap, ok := condition.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			conditionPath := rulePath.GetAttr("conditions").IndexInt(j)
+			switch conditionMap["type"] {
+			case "contactAttributeCondition":
+				references = append(references, obContactList.NewColumnReference(conditionPath.GetAttr("attribute_name"), conditionMap["attribute_name"], false)...)
+			case "contactPropertyCondition":
+				if propertyType, _ := conditionMap["property_type"].(string); strings.HasSuffix(propertyType, "_BY_COLUMN") {
+					references = append(references, obContactList.NewColumnReference(conditionPath.GetAttr("property"), conditionMap["property"], false)...)
+				}
+			}
+			references = append(references, buildDataActionMappingColumnReferences(conditionPath, conditionMap)...)
+		}
+
+		actions, _ := ruleMap["actions"].([]interface{})
+		for j, action := range actions {
+			actionMap, ok := action.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			actionPath := rulePath.GetAttr("actions").IndexInt(j)
+			properties, _ := actionMap["properties"].(map[string]interface{})
+			keys := make([]string, 0, len(properties))
+			for key := range properties {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			for _, key := range keys {
+				propertyPath := actionPath.GetAttr("properties").Index(cty.StringVal(key))
+				// Modified contact attributes are keyed by column, other actions may read a column as contact.Column
+				if actionMap["action_type_name"] == "MODIFY_CONTACT_ATTRIBUTE" {
+					references = append(references, obContactList.NewColumnReference(propertyPath, key, false)...)
+				}
+				if value, _ := properties[key].(string); strings.HasPrefix(value, "contact.") {
+					references = append(references, obContactList.NewColumnReference(propertyPath, strings.TrimPrefix(value, "contact."), false)...)
+				}
+			}
+			references = append(references, buildDataActionMappingColumnReferences(actionPath, actionMap)...)
+		}
+	}
+	return references
+}
+
+func buildDataActionMappingColumnReferences(path cty.Path, m map[string]interface{}) []obContactList.ColumnReference {
+	var references []obContactList.ColumnReference
+	if mappings, ok := m["contact_column_to_data_action_field_mappings"].([]interface{}); ok {
+		for i, mapping := range mappings {
+			if mappingMap, ok := mapping.(map[string]interface{}); ok {
+				references = append(references, obContactList.NewColumnReference(path.GetAttr("contact_column_to_data_action_field_mappings").IndexInt(i).GetAttr("contact_column_name"), mappingMap["contact_column_name"], false)...)
+			}
+		}
+	}
+	return references
+}
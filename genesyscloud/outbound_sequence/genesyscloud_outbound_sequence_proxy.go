@@ -24,6 +24,7 @@ type getOutboundSequenceIdByNameFunc func(ctx context.Context, p *outboundSequen
 type getOutboundSequenceByIdFunc func(ctx context.Context, p *outboundSequenceProxy, id string) (campaignSequence *platformclientv2.Campaignsequence, response *platformclientv2.APIResponse, err error)
 type updateOutboundSequenceFunc func(ctx context.Context, p *outboundSequenceProxy, id string, campaignSequence *platformclientv2.Campaignsequence) (*platformclientv2.Campaignsequence, *platformclientv2.APIResponse, error)
 type deleteOutboundSequenceFunc func(ctx context.Context, p *outboundSequenceProxy, id string) (response *platformclientv2.APIResponse, err error)
+type getOutboundCampaignByIdFunc func(ctx context.Context, p *outboundSequenceProxy, id string) (campaign *platformclientv2.Campaign, response *platformclientv2.APIResponse, err error)
 
 // outboundSequenceProxy contains all of the methods that call genesys cloud APIs.
 type outboundSequenceProxy struct {
@@ -35,6 +36,7 @@ type outboundSequenceProxy struct {
 	getOutboundSequenceByIdAttr     getOutboundSequenceByIdFunc
 	updateOutboundSequenceAttr      updateOutboundSequenceFunc
 	deleteOutboundSequenceAttr      deleteOutboundSequenceFunc
+	getOutboundCampaignByIdAttr     getOutboundCampaignByIdFunc
 }
 
 // newOutboundSequenceProxy initializes the outbound sequence proxy with all of the data needed to communicate with Genesys Cloud
@@ -49,6 +51,7 @@ func newOutboundSequenceProxy(clientConfig *platformclientv2.Configuration) *out
 		getOutboundSequenceByIdAttr:     getOutboundSequenceByIdFn,
 		updateOutboundSequenceAttr:      updateOutboundSequenceFn,
 		deleteOutboundSequenceAttr:      deleteOutboundSequenceFn,
+		getOutboundCampaignByIdAttr:     getOutboundCampaignByIdFn,
 	}
 }
 
@@ -91,6 +94,11 @@ func (p *outboundSequenceProxy) deleteOutboundSequence(ctx context.Context, id s
 	return p.deleteOutboundSequenceAttr(ctx, p, id)
 }
 
+// getOutboundCampaignById returns a single Genesys Cloud outbound campaign of the sequence by Id
+func (p *outboundSequenceProxy) getOutboundCampaignById(ctx context.Context, id string) (campaign *platformclientv2.Campaign, response *platformclientv2.APIResponse, err error) {
+	return p.getOutboundCampaignByIdAttr(ctx, p, id)
+}
+
 // createOutboundSequenceFn is an implementation function for creating a Genesys Cloud outbound sequence
 func createOutboundSequenceFn(ctx context.Context, p *outboundSequenceProxy, outboundSequence *platformclientv2.Campaignsequence) (*platformclientv2.Campaignsequence, *platformclientv2.APIResponse, error) {
 	campaignSequence, resp, err := p.outboundApi.PostOutboundSequences(*outboundSequence)
@@ -184,3 +192,12 @@ func deleteOutboundSequenceFn(ctx context.Context, p *outboundSequenceProxy, id
 	}
 	return resp, nil
 }
+
+// getOutboundCampaignByIdFn is an implementation of the function to get a Genesys Cloud outbound campaign by Id
+func getOutboundCampaignByIdFn(ctx context.Context, p *outboundSequenceProxy, id string) (campaign *platformclientv2.Campaign, response *platformclientv2.APIResponse, err error) {
+	campaign, resp, err := p.outboundApi.GetOutboundCampaign(id)
+	if err != nil {
+		return nil, resp, fmt.Errorf("Failed to retrieve outbound campaign by id %s: %s", id, err)
+	}
+	return campaign, resp, nil
+}
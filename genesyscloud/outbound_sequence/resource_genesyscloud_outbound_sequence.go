@@ -8,6 +8,7 @@ import (
 	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
 	"terraform-provider-genesyscloud/genesyscloud/util"
 	"terraform-provider-genesyscloud/genesyscloud/util/constants"
+	"terraform-provider-genesyscloud/genesyscloud/util/lifecycle"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -92,6 +93,7 @@ func readOutboundSequence(ctx context.Context, d *schema.ResourceData, meta inte
 		}
 		resourcedata.SetNillableValue(d, "status", campaignSequence.Status)
 		resourcedata.SetNillableValue(d, "repeat", campaignSequence.Repeat)
+		lifecycle.SetDefaultDrainTimeout(d)
 
 		log.Printf("Read outbound sequence %s %s", d.Id(), *campaignSequence.Name)
 		return cc.CheckState(d)
@@ -105,7 +107,17 @@ func updateOutboundSequence(ctx context.Context, d *schema.ResourceData, meta in
 	status := d.Get("status").(string)
 
 	outboundSequence := getOutboundSequenceFromResourceData(d)
-	if status != "off" {
+
+	// A running sequence is stopped before it is changed and turned back on afterwards
+	orchestrator := newOutboundSequenceOrchestrator(proxy, d)
+	wasRunning := false
+	if !d.IsNewResource() && d.HasChangesExcept("status", "drain_timeout_seconds") {
+		var diagErr diag.Diagnostics
+		if wasRunning, diagErr = orchestrator.StopIfRunning(ctx); diagErr != nil {
+			return diagErr
+		}
+	}
+	if status != "off" && !wasRunning {
 		outboundSequence.Status = &status
 	}
 
@@ -114,6 +126,9 @@ func updateOutboundSequence(ctx context.Context, d *schema.ResourceData, meta in
 	if err != nil {
 		return util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to update outbound sequence %s error: %s", *outboundSequence.Name, err), resp)
 	}
+	if diagErr := orchestrator.RestoreStatus(ctx, wasRunning, status); diagErr != nil {
+		return diagErr
+	}
 
 	log.Printf("Updated outbound sequence %s", *campaignSequence.Id)
 	return readOutboundSequence(ctx, d, meta)
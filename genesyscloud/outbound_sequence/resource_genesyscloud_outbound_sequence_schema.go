@@ -5,6 +5,7 @@ import (
 	"terraform-provider-genesyscloud/genesyscloud/provider"
 	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
 	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
+	"terraform-provider-genesyscloud/genesyscloud/util/lifecycle"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -48,7 +49,7 @@ func ResourceOutboundSequence() *schema.Resource {
 				Type:        schema.TypeString,
 			},
 			`campaign_ids`: {
-				Description: `The ordered list of Campaigns that this CampaignSequence will run.`,
+				Description: `The ordered list of Campaigns that this CampaignSequence will run. The columns referenced by the campaigns added to the sequence must exist in the column_names of their contact lists, which is verified at plan time.`,
 				Required:    true,
 				Type:        schema.TypeList,
 				Elem:        &schema.Schema{Type: schema.TypeString},
@@ -63,15 +64,25 @@ func ResourceOutboundSequence() *schema.Resource {
 					return (old == `complete` && new == `on`)
 				},
 			},
+			`drain_timeout_seconds`: {
+				Description:  `The number of seconds to wait for a running sequence to stop before its campaigns or repeat setting are changed. The sequence is turned back on once the change is applied.`,
+				Optional:     true,
+				Default:      lifecycle.DefaultDrainTimeoutSeconds,
+				Type:         schema.TypeInt,
+				ValidateFunc: validation.IntBetween(30, 3600),
+			},
 			`repeat`: {
 				Description: `Indicates if a sequence should repeat from the beginning after the last campaign completes. Default is false.`,
 				Optional:    true,
 				Type:        schema.TypeBool,
 			},
 		},
-		CustomizeDiff: customdiff.ForceNewIfChange("status", func(ctx context.Context, old, new, meta any) bool {
-			return new.(string) == "off" && (old.(string) == "on" || old.(string) == "complete")
-		}),
+		CustomizeDiff: customdiff.Sequence(
+			customdiff.ForceNewIfChange("status", func(ctx context.Context, old, new, meta any) bool {
+				return new.(string) == "off" && (old.(string) == "on" || old.(string) == "complete")
+			}),
+			validateSequenceColumnsDiff,
+		),
 	}
 }
 
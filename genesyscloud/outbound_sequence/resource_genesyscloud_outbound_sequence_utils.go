@@ -1,10 +1,18 @@
 package outbound_sequence
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"strings"
+	obContactList "terraform-provider-genesyscloud/genesyscloud/outbound_contact_list"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
 	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/lifecycle"
+	"terraform-provider-genesyscloud/genesyscloud/util/lists"
+	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/mypurecloud/platform-client-sdk-go/v154/platformclientv2"
 )
@@ -23,6 +31,85 @@ func getOutboundSequenceFromResourceData(d *schema.ResourceData) platformclientv
 	}
 }
 
+// newOutboundSequenceOrchestrator returns the lifecycle orchestrator used to stop a running sequence before it is changed
+func newOutboundSequenceOrchestrator(proxy *outboundSequenceProxy, d *schema.ResourceData) *lifecycle.Orchestrator {
+	sequenceId := d.Id()
+	return &lifecycle.Orchestrator{
+		ResourceType: ResourceType,
+		Name:         fmt.Sprintf("outbound sequence %s", sequenceId),
+		GetStatus: func(ctx context.Context) (string, *platformclientv2.APIResponse, error) {
+			sequence, resp, err := proxy.getOutboundSequenceById(ctx, sequenceId)
+			if err != nil {
+				return "", resp, err
+			}
+			if sequence == nil || sequence.Status == nil {
+				return "", resp, fmt.Errorf("no status returned for outbound sequence %s", sequenceId)
+			}
+			return *sequence.Status, resp, nil
+		},
+		SetStatus: func(ctx context.Context, status string) (*platformclientv2.APIResponse, error) {
+			sequence, resp, err := proxy.getOutboundSequenceById(ctx, sequenceId)
+			if err != nil {
+				return resp, err
+			}
+			sequence.Status = &status
+			_, resp, err = proxy.updateOutboundSequence(ctx, sequenceId, sequence)
+			return resp, err
+		},
+		DrainTimeout: time.Duration(d.Get("drain_timeout_seconds").(int)) * time.Second,
+	}
+}
+
+// validateSequenceColumnsDiff checks that the columns referenced by the campaigns added to the sequence exist in their
+// contact lists. Campaigns created by the same apply are verified by their own plan.
+func validateSequenceColumnsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.HasChange("campaign_ids") {
+		return nil
+	}
+	oldCampaignIds, newCampaignIds := diff.GetChange("campaign_ids")
+	previous, _ := oldCampaignIds.([]interface{})
+	campaignIds, _ := newCampaignIds.([]interface{})
+
+	for i, campaignId := range campaignIds {
+		id, _ := campaignId.(string)
+		if id == "" || !diff.NewValueKnown(fmt.Sprintf("campaign_ids.%d", i)) || lists.ItemInSlice(id, lists.InterfaceListToStrings(previous)) {
+			continue
+		}
+		err := validateCampaignColumns(ctx, meta, id)
+		if err == nil {
+			continue
+		}
+		var pathErr cty.PathError
+		if errors.As(err, &pathErr) {
+			err = fmt.Errorf("%s of campaign %s: %s", obContactList.FormatColumnReferencePath(pathErr.Path), id, pathErr.Error())
+		}
+		return cty.GetAttrPath("campaign_ids").IndexInt(i).NewError(err)
+	}
+	return nil
+}
+
+// validateCampaignColumns checks that the columns referenced by a campaign of the sequence exist in its contact list.
+// Campaigns that cannot be found are skipped, as the API reports those.
+func validateCampaignColumns(ctx context.Context, meta interface{}, campaignId string) error {
+	providerMeta, ok := meta.(*provider.ProviderMeta)
+	if !ok || providerMeta == nil {
+		return nil
+	}
+
+	proxy := getOutboundSequenceProxy(providerMeta.ClientConfig)
+	campaign, resp, err := proxy.getOutboundCampaignById(ctx, campaignId)
+	if err != nil {
+		if util.IsStatus404(resp) {
+			return nil
+		}
+		return err
+	}
+	if campaign.ContactList == nil || campaign.ContactList.Id == nil {
+		return nil
+	}
+	return obContactList.ValidateColumnReferences(ctx, meta, *campaign.ContactList.Id, obContactList.BuildCampaignColumnReferences(obContactList.CampaignColumnsGetter(campaign)))
+}
+
 func GenerateOutboundSequence(
 	resourceLabel string,
 	name string,
@@ -34,6 +34,17 @@ var (
 			},
 		},
 	}
+
+	autoIncludeResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			`flags`: {
+				Description: `The set of wrap-up flags mapped to the wrap-up codes that are not listed in mappings. An empty set maps them without any flag instead of using default_set.`,
+				Required:    true,
+				Type:        schema.TypeSet,
+				Elem:        flagsSchema,
+			},
+		},
+	}
 )
 
 // SetRegistrar registers the resource objects and the exporter.  Note:  There is no datasource implementation
@@ -67,6 +78,7 @@ func ResourceOutboundWrapUpCodeMappings() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		SchemaVersion: 1,
+		CustomizeDiff: customizeAutoIncludedWrapupCodesDiff,
 		Schema: map[string]*schema.Schema{
 			`default_set`: {
 				Description: `The default set of wrap-up flags. These will be used if there is no entry for a given wrap-up code in the mapping.`,
@@ -80,6 +92,19 @@ func ResourceOutboundWrapUpCodeMappings() *schema.Resource {
 				Type:        schema.TypeSet,
 				Elem:        mappingResource,
 			},
+			`auto_include`: {
+				Description: `When set, every wrap-up code in the org that is not listed in mappings is mapped to the flags of this block, including wrap-up codes created outside of this configuration. Wrap-up codes created by the same configuration are included once they exist; use depends_on to include them in the same apply. When not set, a warning lists the wrap-up codes of the org that are not listed in mappings when the configuration is planned.`,
+				Optional:    true,
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Elem:        autoIncludeResource,
+			},
+			`auto_included_wrapup_code_ids`: {
+				Description: `The IDs of the wrap-up codes mapped through auto_include.`,
+				Computed:    true,
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 			`placeholder`: {
 				Description:  `Placeholder data used internally by the provider.`,
 				Optional:     true,
@@ -88,5 +113,6 @@ func ResourceOutboundWrapUpCodeMappings() *schema.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 		},
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{validateUnmappedWrapupCodesConfig},
 	}
 }
@@ -0,0 +1,117 @@
+package outbound_wrapupcode_mappings
+
+import (
+	"context"
+	"net/http"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v154/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func buildTestWrapupCodeMappingsProxy(mapping *platformclientv2.Wrapupcodemapping) *outboundWrapupCodeMappingsProxy {
+	return &outboundWrapupCodeMappingsProxy{
+		getAllOutboundWrapupCodeMappingsAttr: func(ctx context.Context, p *outboundWrapupCodeMappingsProxy) (*platformclientv2.Wrapupcodemapping, *platformclientv2.APIResponse, error) {
+			return mapping, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+		},
+		updateOutboundWrapUpCodeMappingsAttr: func(ctx context.Context, p *outboundWrapupCodeMappingsProxy, update *platformclientv2.Wrapupcodemapping) (*platformclientv2.Wrapupcodemapping, *platformclientv2.APIResponse, error) {
+			mapping.DefaultSet = update.DefaultSet
+			mapping.Mapping = update.Mapping
+			return mapping, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+		},
+		getAllWrapupCodesAttr: func(ctx context.Context, p *outboundWrapupCodeMappingsProxy) (*[]platformclientv2.Wrapupcode, *platformclientv2.APIResponse, error) {
+			return &[]platformclientv2.Wrapupcode{
+				{Id: platformclientv2.String("wuc-1"), Name: platformclientv2.String("Sale")},
+				{Id: platformclientv2.String("wuc-2"), Name: platformclientv2.String("Wrong number")},
+				{Id: platformclientv2.String("wuc-3"), Name: platformclientv2.String("Callback")},
+			}, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+		},
+	}
+}
+
+func TestUnitOutboundWrapupCodeMappingsAutoInclude(t *testing.T) {
+	mapping := &platformclientv2.Wrapupcodemapping{Version: platformclientv2.Int(1)}
+	internalProxy = buildTestWrapupCodeMappingsProxy(mapping)
+	defer func() { internalProxy = nil }()
+
+	d := schema.TestResourceDataRaw(t, ResourceOutboundWrapUpCodeMappings().Schema, map[string]interface{}{
+		"default_set": []interface{}{"CONTACT_UNCALLABLE"},
+		"mappings": []interface{}{
+			map[string]interface{}{"wrapup_code_id": "wuc-1", "flags": []interface{}{"BUSINESS_SUCCESS"}},
+		},
+		"auto_include": []interface{}{
+			map[string]interface{}{"flags": []interface{}{"BUSINESS_NEUTRAL"}},
+		},
+	})
+
+	diags := createOutboundWrapUpCodeMappings(context.Background(), d, &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}})
+	assert.False(t, diags.HasError(), diags)
+	assert.Empty(t, diags)
+
+	assert.Equal(t, map[string][]string{
+		"wuc-1": {"BUSINESS_SUCCESS"},
+		"wuc-2": {"BUSINESS_NEUTRAL"},
+		"wuc-3": {"BUSINESS_NEUTRAL"},
+	}, *mapping.Mapping)
+	assert.ElementsMatch(t, []interface{}{"wuc-2", "wuc-3"}, d.Get("auto_included_wrapup_code_ids").(*schema.Set).List())
+	assert.Equal(t, 1, d.Get("mappings").(*schema.Set).Len())
+
+	// A mapping changed outside of the configuration is no longer reported as auto included
+	(*mapping.Mapping)["wuc-3"] = []string{"RIGHT_PARTY_CONTACT"}
+	diags = readOutboundWrapUpCodeMappings(context.Background(), d, &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}})
+	assert.False(t, diags.HasError(), diags)
+	assert.ElementsMatch(t, []interface{}{"wuc-2"}, d.Get("auto_included_wrapup_code_ids").(*schema.Set).List())
+}
+
+func TestUnitOutboundWrapupCodeMappingsUnmappedWarning(t *testing.T) {
+	mapping := &platformclientv2.Wrapupcodemapping{Version: platformclientv2.Int(1)}
+	internalProxy = buildTestWrapupCodeMappingsProxy(mapping)
+	defer func() { internalProxy = nil }()
+
+	clientConfig := &platformclientv2.Configuration{}
+	buildConfig := func(mappings, autoInclude cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"mappings":     mappings,
+			"auto_include": autoInclude,
+		})
+	}
+	mappingType := cty.Object(map[string]cty.Type{"wrapup_code_id": cty.String, "flags": cty.Set(cty.String)})
+	autoIncludeType := cty.List(cty.Object(map[string]cty.Type{"flags": cty.Set(cty.String)}))
+	mappings := cty.SetVal([]cty.Value{
+		cty.ObjectVal(map[string]cty.Value{
+			"wrapup_code_id": cty.StringVal("wuc-1"),
+			"flags":          cty.SetVal([]cty.Value{cty.StringVal("BUSINESS_SUCCESS")}),
+		}),
+	})
+
+	diags := getUnmappedWrapupCodesWarning(context.Background(), clientConfig, buildConfig(mappings, cty.NullVal(autoIncludeType)))
+	if assert.Len(t, diags, 1) {
+		assert.Equal(t, diag.Warning, diags[0].Severity)
+		assert.Equal(t, cty.GetAttrPath("mappings"), diags[0].AttributePath)
+		assert.Contains(t, diags[0].Detail, "Callback (wuc-3), Wrong number (wuc-2)")
+		assert.NotContains(t, diags[0].Detail, "wuc-1")
+	}
+
+	// Wrap-up codes mapped through auto_include are not reported
+	autoInclude := cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{"flags": cty.SetValEmpty(cty.String)})})
+	assert.Empty(t, getUnmappedWrapupCodesWarning(context.Background(), clientConfig, buildConfig(mappings, autoInclude)))
+
+	// Mappings of wrap-up codes created by the same configuration are checked once they are known
+	assert.Empty(t, getUnmappedWrapupCodesWarning(context.Background(), clientConfig, buildConfig(cty.UnknownVal(cty.Set(mappingType)), cty.NullVal(autoIncludeType))))
+
+	// Reading the mappings does not warn
+	d := schema.TestResourceDataRaw(t, ResourceOutboundWrapUpCodeMappings().Schema, map[string]interface{}{
+		"default_set": []interface{}{"CONTACT_UNCALLABLE"},
+		"mappings": []interface{}{
+			map[string]interface{}{"wrapup_code_id": "wuc-1", "flags": []interface{}{"BUSINESS_SUCCESS"}},
+		},
+	})
+	diags = createOutboundWrapUpCodeMappings(context.Background(), d, &provider.ProviderMeta{ClientConfig: clientConfig})
+	assert.Empty(t, diags)
+	assert.Equal(t, map[string][]string{"wuc-1": {"BUSINESS_SUCCESS"}}, *mapping.Mapping)
+	assert.Equal(t, 0, d.Get("auto_included_wrapup_code_ids").(*schema.Set).Len())
+}
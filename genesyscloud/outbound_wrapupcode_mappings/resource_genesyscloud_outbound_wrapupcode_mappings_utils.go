@@ -1,8 +1,13 @@
 package outbound_wrapupcode_mappings
 
 import (
+	"fmt"
+	"sort"
+	"strings"
 	lists "terraform-provider-genesyscloud/genesyscloud/util/lists"
 
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/mypurecloud/platform-client-sdk-go/v154/platformclientv2"
 )
@@ -70,3 +75,97 @@ func buildWrapupCodeMappings(d *schema.ResourceData) *map[string][]string {
 	}
 	return &wrapupCodeMappings
 }
+
+// getConfiguredWrapupCodeIds returns the wrap-up code identifiers listed in mappings
+func getConfiguredWrapupCodeIds(mappings *schema.Set) []string {
+	ids := make([]string, 0)
+	if mappings == nil {
+		return ids
+	}
+	for _, m := range mappings.List() {
+		if mapping, ok := m.(map[string]interface{}); ok {
+			if id, _ := mapping["wrapup_code_id"].(string); id != "" {
+				ids = append(ids, id)
+			}
+		}
+	}
+	return ids
+}
+
+// getAutoIncludeFlags returns the flags of the auto_include block and whether the block is set
+func getAutoIncludeFlags(autoInclude interface{}) ([]string, bool) {
+	autoIncludeList, _ := autoInclude.([]interface{})
+	if len(autoIncludeList) == 0 {
+		return nil, false
+	}
+	flags := make([]string, 0)
+	if autoIncludeMap, ok := autoIncludeList[0].(map[string]interface{}); ok {
+		if flagSet, ok := autoIncludeMap["flags"].(*schema.Set); ok {
+			flags = lists.InterfaceListToStrings(flagSet.List())
+		}
+	}
+	return flags, true
+}
+
+// buildAutoIncludedWrapupCodeIds returns the sorted identifiers of the wrap-up codes in the org that are not configured
+func buildAutoIncludedWrapupCodeIds(wrapupCodes *[]platformclientv2.Wrapupcode, configuredIds []string) []string {
+	ids := make([]string, 0)
+	if wrapupCodes == nil {
+		return ids
+	}
+	for _, wuc := range *wrapupCodes {
+		if wuc.Id != nil && !lists.ItemInSlice(*wuc.Id, configuredIds) {
+			ids = append(ids, *wuc.Id)
+		}
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// flattenAutoIncludedWrapupCodeIds returns the wrap-up codes that are not configured and are mapped to the auto_include flags.
+// Mappings changed outside of the configuration are left out so that the next plan maps them again.
+func flattenAutoIncludedWrapupCodeIds(sdkWrapupcodemapping *platformclientv2.Wrapupcodemapping, wrapupCodes *[]platformclientv2.Wrapupcode, configuredIds, flags []string) []string {
+	ids := make([]string, 0)
+	if sdkWrapupcodemapping.Mapping == nil {
+		return ids
+	}
+	for _, id := range buildAutoIncludedWrapupCodeIds(wrapupCodes, configuredIds) {
+		if sdkFlags, ok := (*sdkWrapupcodemapping.Mapping)[id]; ok && equivalentFlags(sdkFlags, flags) {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// equivalentFlags compares two sets of wrap-up flags regardless of order and case
+func equivalentFlags(a, b []string) bool {
+	return lists.AreEquivalent(lists.Map(a, strings.ToUpper), lists.Map(b, strings.ToUpper))
+}
+
+// buildUnmappedWrapupCodesWarning warns about the wrap-up codes in the org that are not listed in mappings
+func buildUnmappedWrapupCodesWarning(wrapupCodes *[]platformclientv2.Wrapupcode, configuredIds []string) diag.Diagnostics {
+	if wrapupCodes == nil {
+		return nil
+	}
+	var unmapped []string
+	for _, wuc := range *wrapupCodes {
+		if wuc.Id == nil || lists.ItemInSlice(*wuc.Id, configuredIds) {
+			continue
+		}
+		name := ""
+		if wuc.Name != nil {
+			name = *wuc.Name
+		}
+		unmapped = append(unmapped, fmt.Sprintf("%s (%s)", name, *wuc.Id))
+	}
+	if len(unmapped) == 0 {
+		return nil
+	}
+	sort.Strings(unmapped)
+	return diag.Diagnostics{{
+		Severity:      diag.Warning,
+		Summary:       fmt.Sprintf("%d wrap-up codes are not listed in the mappings of %s", len(unmapped), ResourceType),
+		AttributePath: cty.GetAttrPath("mappings"),
+		Detail:        fmt.Sprintf("The following wrap-up codes exist in the org but are not listed in mappings. Wrap-up codes without a mapping use default_set. Add them to mappings or set auto_include to map them: %s", strings.Join(unmapped, ", ")),
+	}}
+}
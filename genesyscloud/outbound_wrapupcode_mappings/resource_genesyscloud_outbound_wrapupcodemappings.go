@@ -16,6 +16,7 @@ import (
 	lists "terraform-provider-genesyscloud/genesyscloud/util/lists"
 	"terraform-provider-genesyscloud/genesyscloud/util/resourcedata"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -88,6 +89,13 @@ func readOutboundWrapUpCodeMappings(ctx context.Context, d *schema.ResourceData,
 			_ = d.Set("mappings", flattenOutboundWrapupCodeMappings(d, sdkWrapupCodeMappings, &existingWrapupCodes))
 		}
 
+		if flags, ok := getAutoIncludeFlags(d.Get("auto_include")); ok {
+			configuredIds := getConfiguredWrapupCodeIds(d.Get("mappings").(*schema.Set))
+			_ = d.Set("auto_included_wrapup_code_ids", flattenAutoIncludedWrapupCodeIds(sdkWrapupCodeMappings, wrapupCodes, configuredIds, flags))
+		} else {
+			_ = d.Set("auto_included_wrapup_code_ids", []string{})
+		}
+
 		log.Print("Read Outbound Wrap-up Code Mappings")
 		return cc.CheckState(d)
 	})
@@ -105,9 +113,21 @@ func updateOutboundWrapUpCodeMappings(ctx context.Context, d *schema.ResourceDat
 			return resp, util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to get  wrap-up code mappings error: %s", err), resp)
 		}
 
+		mapping := buildWrapupCodeMappings(d)
+		if flags, ok := getAutoIncludeFlags(d.Get("auto_include")); ok {
+			wrapupCodes, resp, err := proxy.getAllWrapupCodes(ctx)
+			if err != nil {
+				return resp, util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to get wrap-up codes error: %s", err), resp)
+			}
+			configuredIds := getConfiguredWrapupCodeIds(d.Get("mappings").(*schema.Set))
+			for _, id := range buildAutoIncludedWrapupCodeIds(wrapupCodes, configuredIds) {
+				(*mapping)[id] = flags
+			}
+		}
+
 		wrapupCodeUpdate := platformclientv2.Wrapupcodemapping{
 			DefaultSet: lists.BuildSdkStringList(d, "default_set"),
-			Mapping:    buildWrapupCodeMappings(d),
+			Mapping:    mapping,
 			Version:    wrapupCodeMappings.Version,
 		}
 		_, resp, err = proxy.updateOutboundWrapUpCodeMappings(ctx, wrapupCodeUpdate)
@@ -129,3 +149,73 @@ func deleteOutboundWrapUpCodeMappings(_ context.Context, _ *schema.ResourceData,
 	// Does not delete the wrap-up code mappings. This resource will just no longer manage them.
 	return nil
 }
+
+// customizeAutoIncludedWrapupCodesDiff plans the wrap-up codes of the org that auto_include maps, so that wrap-up codes
+// created since the last apply show up as a change
+func customizeAutoIncludedWrapupCodesDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if _, ok := getAutoIncludeFlags(diff.Get("auto_include")); !ok {
+		if old, _ := diff.GetChange("auto_included_wrapup_code_ids"); old.(*schema.Set).Len() > 0 {
+			return diff.SetNew("auto_included_wrapup_code_ids", []string{})
+		}
+		return nil
+	}
+	if !diff.NewValueKnown("mappings") {
+		return diff.SetNewComputed("auto_included_wrapup_code_ids")
+	}
+
+	providerMeta, ok := meta.(*provider.ProviderMeta)
+	if !ok || providerMeta == nil {
+		return nil
+	}
+	proxy := getOutboundWrapupCodeMappingsProxy(providerMeta.ClientConfig)
+	wrapupCodes, _, err := proxy.getAllWrapupCodes(ctx)
+	if err != nil {
+		return fmt.Errorf("auto_include: failed to get wrap-up codes: %s", err)
+	}
+
+	configuredIds := getConfiguredWrapupCodeIds(diff.Get("mappings").(*schema.Set))
+	return diff.SetNew("auto_included_wrapup_code_ids", buildAutoIncludedWrapupCodeIds(wrapupCodes, configuredIds))
+}
+
+// validateUnmappedWrapupCodesConfig warns about the wrap-up codes in the org that are not listed in mappings when
+// auto_include is not set. The provider is only configured once the configuration is planned, so the warning is not
+// reported by terraform validate.
+func validateUnmappedWrapupCodesConfig(ctx context.Context, req schema.ValidateResourceConfigFuncRequest, resp *schema.ValidateResourceConfigFuncResponse) {
+	providerMeta := provider.GetProviderMeta()
+	if providerMeta == nil || providerMeta.ClientConfig == nil {
+		return
+	}
+	resp.Diagnostics = append(resp.Diagnostics, getUnmappedWrapupCodesWarning(ctx, providerMeta.ClientConfig, req.RawConfig)...)
+}
+
+func getUnmappedWrapupCodesWarning(ctx context.Context, clientConfig *platformclientv2.Configuration, rawConfig cty.Value) diag.Diagnostics {
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return nil
+	}
+	if autoInclude := rawConfig.GetAttr("auto_include"); !autoInclude.IsKnown() || (!autoInclude.IsNull() && autoInclude.LengthInt() > 0) {
+		return nil
+	}
+	mappings := rawConfig.GetAttr("mappings")
+	if !mappings.IsWhollyKnown() {
+		// Wrap-up codes created by the same configuration are checked once their IDs are known
+		return nil
+	}
+
+	configuredIds := make([]string, 0)
+	if !mappings.IsNull() {
+		for it := mappings.ElementIterator(); it.Next(); {
+			_, mapping := it.Element()
+			if id := mapping.GetAttr("wrapup_code_id"); !id.IsNull() {
+				configuredIds = append(configuredIds, id.AsString())
+			}
+		}
+	}
+
+	proxy := getOutboundWrapupCodeMappingsProxy(clientConfig)
+	wrapupCodes, _, err := proxy.getAllWrapupCodes(ctx)
+	if err != nil {
+		log.Printf("Failed to get wrap-up codes to check for unmapped wrap-up codes: %s", err)
+		return nil
+	}
+	return buildUnmappedWrapupCodesWarning(wrapupCodes, configuredIds)
+}
@@ -5,6 +5,7 @@ import (
 	gcloud "terraform-provider-genesyscloud/genesyscloud"
 	dt "terraform-provider-genesyscloud/genesyscloud/architect_datatable"
 	dtr "terraform-provider-genesyscloud/genesyscloud/architect_datatable_row"
+	architectDependencies "terraform-provider-genesyscloud/genesyscloud/architect_dependencies"
 	emergencyGroup "terraform-provider-genesyscloud/genesyscloud/architect_emergencygroup"
 	flow "terraform-provider-genesyscloud/genesyscloud/architect_flow"
 	grammar "terraform-provider-genesyscloud/genesyscloud/architect_grammar"
@@ -89,10 +90,13 @@ import (
 	routingLanguage "terraform-provider-genesyscloud/genesyscloud/routing_language"
 	routingQueue "terraform-provider-genesyscloud/genesyscloud/routing_queue"
 	routingQueueConditionalGroupRouting "terraform-provider-genesyscloud/genesyscloud/routing_queue_conditional_group_routing"
+	routingQueueMember "terraform-provider-genesyscloud/genesyscloud/routing_queue_member"
 	routingQueueOutboundEmailAddress "terraform-provider-genesyscloud/genesyscloud/routing_queue_outbound_email_address"
+	routingQueueSimulation "terraform-provider-genesyscloud/genesyscloud/routing_queue_simulation"
 	routingSettings "terraform-provider-genesyscloud/genesyscloud/routing_settings"
 	routingSkill "terraform-provider-genesyscloud/genesyscloud/routing_skill"
 	routingSkillGroup "terraform-provider-genesyscloud/genesyscloud/routing_skill_group"
+	routingSkillPolicy "terraform-provider-genesyscloud/genesyscloud/routing_skill_policy"
 	smsAddresses "terraform-provider-genesyscloud/genesyscloud/routing_sms_addresses"
 	routingUtilization "terraform-provider-genesyscloud/genesyscloud/routing_utilization"
 	routingUtilizationLabel "terraform-provider-genesyscloud/genesyscloud/routing_utilization_label"
@@ -199,6 +203,7 @@ func registerResources() {
 	oauth.SetRegistrar(regInstance)                                        //Registering oauth_client
 	dt.SetRegistrar(regInstance)                                           //Registering architect data table
 	dtr.SetRegistrar(regInstance)                                          //Registering architect data table row
+	architectDependencies.SetRegistrar(regInstance)                        //Registering architect dependencies
 	emergencyGroup.SetRegistrar(regInstance)                               //Registering architect emergency group
 	architectSchedulegroups.SetRegistrar(regInstance)                      //Registering architect schedule groups
 	architectSchedules.SetRegistrar(regInstance)                           //Registering architect schedules
@@ -288,7 +293,10 @@ func registerResources() {
 	userPrompt.SetRegistrar(regInstance)                                   //Registering user prompt
 	routingQueue.SetRegistrar(regInstance)                                 //Registering routing queue
 	routingQueueConditionalGroupRouting.SetRegistrar(regInstance)          //Registering routing queue conditional group routing
+	routingQueueMember.SetRegistrar(regInstance)                           //Registering routing queue member
+	routingSkillPolicy.SetRegistrar(regInstance)                           //Registering routing skill policy
 	routingQueueOutboundEmailAddress.SetRegistrar(regInstance)             //Registering routing queue outbound email address
+	routingQueueSimulation.SetRegistrar(regInstance)                       //Registering routing queue simulation
 	outboundContactListContact.SetRegistrar(regInstance)                   //Registering outbound contact list contact
 	routingSettings.SetRegistrar(regInstance)                              //Registering routing Settings
 	routingUtilization.SetRegistrar(regInstance)                           //Registering routing utilization
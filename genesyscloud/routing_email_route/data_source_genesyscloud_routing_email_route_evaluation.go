@@ -0,0 +1,92 @@
+package routing_email_route
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v154/platformclientv2"
+)
+
+/*
+   The data_source_genesyscloud_routing_email_route_evaluation.go contains the data source implementation
+   for evaluating which inbound domain and route of the org would handle a sample email.
+*/
+
+// dataSourceRoutingEmailRouteEvaluationRead evaluates the sample email against the inbound domains and routes of the org
+func dataSourceRoutingEmailRouteEvaluationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getRoutingEmailRouteProxy(sdkConfig)
+
+	domains, resp, err := proxy.getAllRoutingEmailDomains(ctx, "")
+	if err != nil {
+		return util.BuildAPIDiagnosticError(EvaluationDataSourceType, fmt.Sprintf("failed to read routing email domains | error: %s", err), resp)
+	}
+	routes, resp, err := proxy.getAllRoutingEmailRoute(ctx, "", "")
+	if err != nil {
+		return util.BuildAPIDiagnosticError(EvaluationDataSourceType, fmt.Sprintf("failed to read routing email routes | error: %s", err), resp)
+	}
+	if routes == nil {
+		routes = &map[string][]platformclientv2.Inboundroute{}
+	}
+
+	email := sampleEmail{
+		to:   d.Get("to").(string),
+		from: d.Get("from").(string),
+	}
+	evaluation, err := evaluateEmailRoute(*domains, *routes, email)
+	if err != nil {
+		return util.BuildDiagnosticError(EvaluationDataSourceType, "failed to evaluate the email", err)
+	}
+
+	id, _ := json.Marshal([]interface{}{email.to, email.from, d.Get("subject")})
+	d.SetId(fmt.Sprintf("%x", sha256.Sum256(id)))
+	_ = d.Set("domain_id", evaluation.domainId)
+	_ = d.Set("matched", evaluation.route != nil)
+	_ = d.Set("reply_from_email", evaluation.replyFromEmail)
+	_ = d.Set("warnings", evaluation.warnings)
+	flattenRoutingEmailRouteEvaluation(d, evaluation.route)
+
+	var diags diag.Diagnostics
+	for _, warning := range evaluation.warnings {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Email to %s: %s", email.to, warning),
+		})
+	}
+	return diags
+}
+
+// flattenRoutingEmailRouteEvaluation sets the attributes of the route that handles the email, or clears them when no route matches
+func flattenRoutingEmailRouteEvaluation(d *schema.ResourceData, route *platformclientv2.Inboundroute) {
+	if route == nil {
+		route = &platformclientv2.Inboundroute{}
+	}
+	skillIds := make([]string, 0)
+	if route.Skills != nil {
+		for _, skill := range *route.Skills {
+			skillIds = append(skillIds, stringValue(skill.Id))
+		}
+	}
+
+	_ = d.Set("route_id", stringValue(route.Id))
+	_ = d.Set("pattern", stringValue(route.Pattern))
+	_ = d.Set("flow_id", referenceId(route.Flow))
+	_ = d.Set("queue_id", referenceId(route.Queue))
+	_ = d.Set("priority", route.Priority)
+	_ = d.Set("skill_ids", skillIds)
+	_ = d.Set("language_id", referenceId(route.Language))
+	_ = d.Set("spam_flow_id", referenceId(route.SpamFlow))
+}
+
+func referenceId(reference *platformclientv2.Domainentityref) string {
+	if reference == nil {
+		return ""
+	}
+	return stringValue(reference.Id)
+}
@@ -0,0 +1,132 @@
+package routing_email_route
+
+import (
+	"context"
+	"net/http"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v154/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func buildTestInboundRoute(id string, pattern string) platformclientv2.Inboundroute {
+	return platformclientv2.Inboundroute{Id: platformclientv2.String(id), Pattern: platformclientv2.String(pattern)}
+}
+
+func TestUnitEvaluateEmailRoute(t *testing.T) {
+	domains := []platformclientv2.Inbounddomain{
+		{Id: platformclientv2.String("example.com"), MxRecordStatus: platformclientv2.String("VALID")},
+		{Id: platformclientv2.String("broken.com"), MxRecordStatus: platformclientv2.String("INVALID")},
+		{Id: platformclientv2.String("acme.mypurecloud.com"), MxRecordStatus: platformclientv2.String("NOT_AVAILABLE"), SubDomain: platformclientv2.Bool(true)},
+	}
+
+	support := buildTestInboundRoute("route-support", "Support")
+	support.Queue = &platformclientv2.Domainentityref{Id: platformclientv2.String("queue-1")}
+	sales := buildTestInboundRoute("route-sales", "sales")
+	sales.Flow = &platformclientv2.Domainentityref{Id: platformclientv2.String("flow-1")}
+	sales.ReplyEmailAddress = &platformclientv2.Queueemailaddress{Domain: &platformclientv2.Domainentityref{Id: platformclientv2.String("example.com")}}
+	replyRoute := &platformclientv2.Inboundroute{Id: platformclientv2.String("route-support")}
+	sales.ReplyEmailAddress.Route = &replyRoute
+	billing := buildTestInboundRoute("route-billing", "billing")
+	billing.Flow = &platformclientv2.Domainentityref{Id: platformclientv2.String("flow-2")}
+	billing.Queue = &platformclientv2.Domainentityref{Id: platformclientv2.String("queue-2")}
+
+	routes := map[string][]platformclientv2.Inboundroute{
+		"example.com":          {support, sales, billing, buildTestInboundRoute("route-sales-2", "SALES"), buildTestInboundRoute("route-full", "info@example.com")},
+		"broken.com":           {buildTestInboundRoute("route-broken", "info")},
+		"acme.mypurecloud.com": {buildTestInboundRoute("route-acme", "info")},
+	}
+
+	evaluation, err := evaluateEmailRoute(domains, routes, sampleEmail{to: "Support Team <support@Example.com>", from: "customer@gmail.com"})
+	assert.NoError(t, err)
+	assert.Equal(t, "example.com", evaluation.domainId)
+	assert.Equal(t, "route-support", *evaluation.route.Id, "patterns match the mailbox name ignoring case")
+	assert.Equal(t, []string{
+		`the pattern "info@example.com" of route route-full is not a mailbox name and would never match an email`,
+		"routes route-sales, route-sales-2 of domain example.com all match the mailbox sales",
+	}, evaluation.warnings)
+
+	evaluation, err = evaluateEmailRoute(domains, routes, sampleEmail{to: "sales@example.com"})
+	assert.NoError(t, err)
+	assert.Equal(t, "route-sales", *evaluation.route.Id)
+	assert.Equal(t, "Support@example.com", evaluation.replyFromEmail)
+
+	evaluation, err = evaluateEmailRoute(domains, routes, sampleEmail{to: "billing@example.com", from: "sales@example.com"})
+	assert.NoError(t, err)
+	assert.Contains(t, evaluation.warnings, "route route-billing sets both a flow and a queue, the email would be processed by flow flow-2")
+	assert.Contains(t, evaluation.warnings, "the sender sales@example.com is handled by route route-sales, replies to the email would be routed back into the org")
+
+	evaluation, err = evaluateEmailRoute(domains, routes, sampleEmail{to: "nobody@example.com"})
+	assert.NoError(t, err)
+	assert.Nil(t, evaluation.route)
+	assert.Contains(t, evaluation.warnings, "no route of domain example.com matches the mailbox nobody, the email would be rejected")
+
+	evaluation, err = evaluateEmailRoute(domains, routes, sampleEmail{to: "info@broken.com"})
+	assert.NoError(t, err)
+	assert.Contains(t, evaluation.warnings, "the MX record of domain broken.com is INVALID, the email would not be delivered to Genesys Cloud")
+	assert.Contains(t, evaluation.warnings, "route route-broken sets neither a flow nor a queue, the email would not be routed")
+
+	evaluation, err = evaluateEmailRoute(domains, routes, sampleEmail{to: "info@acme.mypurecloud.com"})
+	assert.NoError(t, err)
+	assert.NotContains(t, evaluation.warnings, "the MX record of domain acme.mypurecloud.com is NOT_AVAILABLE, the email would not be delivered to Genesys Cloud", "sub-domains have no MX record to check")
+
+	evaluation, err = evaluateEmailRoute(domains, routes, sampleEmail{to: "info@unknown.com"})
+	assert.NoError(t, err)
+	assert.Equal(t, "", evaluation.domainId)
+	assert.Equal(t, []string{"unknown.com is not an inbound email domain of the org, the email would be rejected"}, evaluation.warnings)
+
+	_, err = evaluateEmailRoute(domains, routes, sampleEmail{to: "not an address"})
+	assert.ErrorContains(t, err, "to: invalid email address")
+}
+
+func TestUnitDataSourceRoutingEmailRouteEvaluation(t *testing.T) {
+	internalProxyCopy := internalProxy
+	defer func() {
+		internalProxy = internalProxyCopy
+	}()
+
+	ok := &platformclientv2.APIResponse{StatusCode: http.StatusOK}
+	route := buildTestInboundRoute("route-1", "support")
+	route.Flow = &platformclientv2.Domainentityref{Id: platformclientv2.String("flow-1")}
+	route.SpamFlow = &platformclientv2.Domainentityref{Id: platformclientv2.String("flow-spam")}
+	route.Skills = &[]platformclientv2.Domainentityref{{Id: platformclientv2.String("skill-1")}}
+	route.Priority = platformclientv2.Int(5)
+	route.FromEmail = platformclientv2.String("noreply@example.com")
+
+	internalProxy = &routingEmailRouteProxy{
+		getAllRoutingEmailDomainsAttr: func(_ context.Context, _ *routingEmailRouteProxy, _ string) (*[]platformclientv2.Inbounddomain, *platformclientv2.APIResponse, error) {
+			return &[]platformclientv2.Inbounddomain{{Id: platformclientv2.String("example.com"), MxRecordStatus: platformclientv2.String("VALID")}}, ok, nil
+		},
+		getAllRoutingEmailRouteAttr: func(_ context.Context, _ *routingEmailRouteProxy, _, _ string) (*map[string][]platformclientv2.Inboundroute, *platformclientv2.APIResponse, error) {
+			return &map[string][]platformclientv2.Inboundroute{"example.com": {route}}, ok, nil
+		},
+	}
+	meta := &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}
+
+	d := schema.TestResourceDataRaw(t, DataSourceRoutingEmailRouteEvaluation().Schema, map[string]interface{}{"to": "support@example.com", "subject": "Order 123"})
+	diags := dataSourceRoutingEmailRouteEvaluationRead(context.Background(), d, meta)
+	assert.False(t, diags.HasError(), diags)
+	assert.Empty(t, diags)
+	assert.NotEmpty(t, d.Id())
+	assert.Equal(t, true, d.Get("matched"))
+	assert.Equal(t, "example.com", d.Get("domain_id"))
+	assert.Equal(t, "route-1", d.Get("route_id"))
+	assert.Equal(t, "flow-1", d.Get("flow_id"))
+	assert.Equal(t, "", d.Get("queue_id"))
+	assert.Equal(t, "flow-spam", d.Get("spam_flow_id"))
+	assert.Equal(t, 5, d.Get("priority"))
+	assert.Equal(t, []interface{}{"skill-1"}, d.Get("skill_ids"))
+	assert.Equal(t, "noreply@example.com", d.Get("reply_from_email"))
+
+	d = schema.TestResourceDataRaw(t, DataSourceRoutingEmailRouteEvaluation().Schema, map[string]interface{}{"to": "sales@example.com"})
+	diags = dataSourceRoutingEmailRouteEvaluationRead(context.Background(), d, meta)
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, false, d.Get("matched"))
+	assert.Equal(t, "", d.Get("route_id"))
+	assert.Equal(t, []interface{}{"no route of domain example.com matches the mailbox sales, the email would be rejected"}, d.Get("warnings"))
+	if assert.Len(t, diags, 1) {
+		assert.Equal(t, "Email to sales@example.com: no route of domain example.com matches the mailbox sales, the email would be rejected", diags[0].Summary)
+	}
+}
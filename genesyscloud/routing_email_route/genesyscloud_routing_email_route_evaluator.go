@@ -0,0 +1,182 @@
+package routing_email_route
+
+import (
+	"fmt"
+	"net/mail"
+	"sort"
+	"strings"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v154/platformclientv2"
+)
+
+/*
+The genesyscloud_routing_email_route_evaluator.go file evaluates locally which inbound domain and route of the org would
+handle a sample email, the way inbound email routing selects them: the domain of the recipient address selects the inbound
+domain and the mailbox name selects the route whose pattern matches it, ignoring case. Nothing is sent, so route changes can
+be tested without emailing anyone. The misconfigurations that make an email bounce or land on an unpredictable route are
+reported as warnings.
+*/
+
+const mxRecordStatusValid = "VALID"
+
+// sampleEmail holds the addresses of the email being evaluated
+type sampleEmail struct {
+	to   string
+	from string
+}
+
+// emailRouteEvaluation is the outcome of evaluating a sample email against the inbound domains and routes of the org
+type emailRouteEvaluation struct {
+	domainId       string
+	route          *platformclientv2.Inboundroute
+	replyFromEmail string
+	warnings       []string
+}
+
+// evaluateEmailRoute returns the domain and route that would handle the email. The routes are keyed by domain ID.
+func evaluateEmailRoute(domains []platformclientv2.Inbounddomain, routes map[string][]platformclientv2.Inboundroute, email sampleEmail) (*emailRouteEvaluation, error) {
+	mailbox, domainName, err := splitEmailAddress(email.to)
+	if err != nil {
+		return nil, fmt.Errorf("to: %s", err)
+	}
+	evaluation := &emailRouteEvaluation{}
+
+	if email.from != "" {
+		fromMailbox, fromDomain, err := splitEmailAddress(email.from)
+		if err != nil {
+			return nil, fmt.Errorf("from: %s", err)
+		}
+		if route := findEmailRoute(routes[findEmailDomainId(domains, fromDomain)], fromMailbox); route != nil {
+			evaluation.warnings = append(evaluation.warnings, fmt.Sprintf("the sender %s is handled by route %s, replies to the email would be routed back into the org", email.from, stringValue(route.Id)))
+		}
+	}
+
+	domainId := findEmailDomainId(domains, domainName)
+	if domainId == "" {
+		evaluation.warnings = append(evaluation.warnings, fmt.Sprintf("%s is not an inbound email domain of the org, the email would be rejected", domainName))
+		return evaluation, nil
+	}
+	evaluation.domainId = domainId
+
+	for _, domain := range domains {
+		if stringValue(domain.Id) != domainId {
+			continue
+		}
+		// Sub-domains of the org are hosted by Genesys Cloud and have no MX record of their own to check
+		isSubDomain := domain.SubDomain != nil && *domain.SubDomain
+		if !isSubDomain && domain.MxRecordStatus != nil && *domain.MxRecordStatus != mxRecordStatusValid {
+			evaluation.warnings = append(evaluation.warnings, fmt.Sprintf("the MX record of domain %s is %s, the email would not be delivered to Genesys Cloud", domainId, *domain.MxRecordStatus))
+		}
+	}
+
+	domainRoutes := routes[domainId]
+	evaluation.warnings = append(evaluation.warnings, emailRoutePatternWarnings(domainId, domainRoutes)...)
+
+	evaluation.route = findEmailRoute(domainRoutes, mailbox)
+	if evaluation.route == nil {
+		evaluation.warnings = append(evaluation.warnings, fmt.Sprintf("no route of domain %s matches the mailbox %s, the email would be rejected", domainId, mailbox))
+		return evaluation, nil
+	}
+
+	route := evaluation.route
+	routeId := stringValue(route.Id)
+	switch {
+	case route.Flow != nil && route.Queue != nil:
+		evaluation.warnings = append(evaluation.warnings, fmt.Sprintf("route %s sets both a flow and a queue, the email would be processed by flow %s", routeId, stringValue(route.Flow.Id)))
+	case route.Flow == nil && route.Queue == nil:
+		evaluation.warnings = append(evaluation.warnings, fmt.Sprintf("route %s sets neither a flow nor a queue, the email would not be routed", routeId))
+	}
+
+	evaluation.replyFromEmail = stringValue(route.FromEmail)
+	if route.ReplyEmailAddress != nil {
+		replyDomainId := ""
+		if route.ReplyEmailAddress.Domain != nil {
+			replyDomainId = stringValue(route.ReplyEmailAddress.Domain.Id)
+		}
+		replyRouteId := ""
+		if route.ReplyEmailAddress.Route != nil && *route.ReplyEmailAddress.Route != nil {
+			replyRouteId = stringValue((*route.ReplyEmailAddress.Route).Id)
+		}
+		replyRoute := findEmailRouteById(routes[replyDomainId], replyRouteId)
+		if replyRoute == nil {
+			evaluation.warnings = append(evaluation.warnings, fmt.Sprintf("the reply_email_address of route %s references route %s of domain %s, which does not exist", routeId, replyRouteId, replyDomainId))
+		} else {
+			evaluation.replyFromEmail = stringValue(replyRoute.Pattern) + "@" + replyDomainId
+		}
+	}
+	return evaluation, nil
+}
+
+// emailRoutePatternWarnings reports the patterns of a domain that can never match a mailbox name and the routes whose patterns
+// overlap. Which of the overlapping routes handles an email is not defined.
+func emailRoutePatternWarnings(domainId string, routes []platformclientv2.Inboundroute) []string {
+	var warnings []string
+	routeIdsByPattern := make(map[string][]string)
+	for _, route := range routes {
+		pattern := stringValue(route.Pattern)
+		if strings.Contains(pattern, "@") || strings.TrimSpace(pattern) != pattern || pattern == "" {
+			warnings = append(warnings, fmt.Sprintf("the pattern %q of route %s is not a mailbox name and would never match an email", pattern, stringValue(route.Id)))
+			continue
+		}
+		normalized := strings.ToLower(pattern)
+		routeIdsByPattern[normalized] = append(routeIdsByPattern[normalized], stringValue(route.Id))
+	}
+
+	patterns := make([]string, 0, len(routeIdsByPattern))
+	for pattern, routeIds := range routeIdsByPattern {
+		if len(routeIds) > 1 {
+			patterns = append(patterns, pattern)
+		}
+	}
+	sort.Strings(patterns)
+	for _, pattern := range patterns {
+		warnings = append(warnings, fmt.Sprintf("routes %s of domain %s all match the mailbox %s", strings.Join(routeIdsByPattern[pattern], ", "), domainId, pattern))
+	}
+	return warnings
+}
+
+// findEmailDomainId returns the ID of the inbound domain of the domain name, or an empty string when it is not an inbound domain
+func findEmailDomainId(domains []platformclientv2.Inbounddomain, domainName string) string {
+	for _, domain := range domains {
+		if strings.EqualFold(stringValue(domain.Id), domainName) || strings.EqualFold(stringValue(domain.Name), domainName) {
+			return stringValue(domain.Id)
+		}
+	}
+	return ""
+}
+
+// findEmailRoute returns the first route whose pattern matches the mailbox name
+func findEmailRoute(routes []platformclientv2.Inboundroute, mailbox string) *platformclientv2.Inboundroute {
+	for i, route := range routes {
+		if strings.EqualFold(stringValue(route.Pattern), mailbox) {
+			return &routes[i]
+		}
+	}
+	return nil
+}
+
+func findEmailRouteById(routes []platformclientv2.Inboundroute, id string) *platformclientv2.Inboundroute {
+	for i, route := range routes {
+		if id != "" && stringValue(route.Id) == id {
+			return &routes[i]
+		}
+	}
+	return nil
+}
+
+// splitEmailAddress returns the mailbox name and the lower case domain of an address, e.g. "Support <support@example.com>"
+func splitEmailAddress(address string) (string, string, error) {
+	parsed, err := mail.ParseAddress(address)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid email address %q: %s", address, err)
+	}
+	at := strings.LastIndex(parsed.Address, "@")
+	return parsed.Address[:at], strings.ToLower(parsed.Address[at+1:]), nil
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
@@ -20,6 +20,7 @@ var internalProxy *routingEmailRouteProxy
 
 // Type definitions for each func on our proxy so we can easily mock them out later
 type createRoutingEmailRouteFunc func(ctx context.Context, p *routingEmailRouteProxy, domainId string, inboundRoute *platformclientv2.Inboundroute) (*platformclientv2.Inboundroute, *platformclientv2.APIResponse, error)
+type getAllRoutingEmailDomainsFunc func(ctx context.Context, p *routingEmailRouteProxy, filter string) (*[]platformclientv2.Inbounddomain, *platformclientv2.APIResponse, error)
 type getAllRoutingEmailRouteFunc func(ctx context.Context, p *routingEmailRouteProxy, domainId string, name string) (*map[string][]platformclientv2.Inboundroute, *platformclientv2.APIResponse, error)
 type getRoutingEmailRouteIdByPatternFunc func(ctx context.Context, p *routingEmailRouteProxy, pattern string, domainId string) (id string, retryable bool, response *platformclientv2.APIResponse, err error)
 type getRoutingEmailRouteByIdFunc func(ctx context.Context, p *routingEmailRouteProxy, domainId string, id string) (inboundRoute *platformclientv2.Inboundroute, response *platformclientv2.APIResponse, err error)
@@ -31,6 +32,7 @@ type routingEmailRouteProxy struct {
 	clientConfig                        *platformclientv2.Configuration
 	routingApi                          *platformclientv2.RoutingApi
 	createRoutingEmailRouteAttr         createRoutingEmailRouteFunc
+	getAllRoutingEmailDomainsAttr       getAllRoutingEmailDomainsFunc
 	getAllRoutingEmailRouteAttr         getAllRoutingEmailRouteFunc
 	getRoutingEmailRouteIdByPatternAttr getRoutingEmailRouteIdByPatternFunc
 	getRoutingEmailRouteByIdAttr        getRoutingEmailRouteByIdFunc
@@ -45,6 +47,7 @@ func newRoutingEmailRouteProxy(clientConfig *platformclientv2.Configuration) *ro
 		clientConfig:                        clientConfig,
 		routingApi:                          api,
 		createRoutingEmailRouteAttr:         createRoutingEmailRouteFn,
+		getAllRoutingEmailDomainsAttr:       getAllRoutingEmailDomainsFn,
 		getAllRoutingEmailRouteAttr:         getAllRoutingEmailRouteFn,
 		getRoutingEmailRouteIdByPatternAttr: getRoutingEmailRouteIdByPatternFn,
 		getRoutingEmailRouteByIdAttr:        getRoutingEmailRouteByIdFn,
@@ -67,6 +70,11 @@ func (p *routingEmailRouteProxy) createRoutingEmailRoute(ctx context.Context, do
 	return p.createRoutingEmailRouteAttr(ctx, p, domainId, routingEmailRoute)
 }
 
+// getAllRoutingEmailDomains retrieves all Genesys Cloud routing email domains matching the filter
+func (p *routingEmailRouteProxy) getAllRoutingEmailDomains(ctx context.Context, filter string) (*[]platformclientv2.Inbounddomain, *platformclientv2.APIResponse, error) {
+	return p.getAllRoutingEmailDomainsAttr(ctx, p, filter)
+}
+
 // getRoutingEmailRoute retrieves all Genesys Cloud routing email route
 func (p *routingEmailRouteProxy) getAllRoutingEmailRoute(ctx context.Context, domainId string, name string) (*map[string][]platformclientv2.Inboundroute, *platformclientv2.APIResponse, error) {
 	return p.getAllRoutingEmailRouteAttr(ctx, p, domainId, name)
@@ -118,28 +126,17 @@ func getAllRoutingEmailRouteByDomainIdFn(_ context.Context, p *routingEmailRoute
 
 // getAllRoutingEmailRouteFn is the implementation for retrieving all routing email route in Genesys Cloud
 func getAllRoutingEmailRouteFn(ctx context.Context, p *routingEmailRouteProxy, domainId, pattern string) (*map[string][]platformclientv2.Inboundroute, *platformclientv2.APIResponse, error) {
-	const pageSize = 100
-	var apiResponse *platformclientv2.APIResponse
-
-	var allDomains = make([]platformclientv2.Inbounddomain, 0)
-	for pageNum := 1; ; pageNum++ {
-		domains, resp, err := p.routingApi.GetRoutingEmailDomains(pageSize, pageNum, false, domainId)
-		apiResponse = resp
-		if err != nil {
-			return nil, resp, fmt.Errorf("failed to get routing email domains: %s", err.Error())
-		}
-		if domains.Entities == nil || len(*domains.Entities) == 0 {
-			break
-		}
-		allDomains = append(allDomains, *domains.Entities...)
+	allDomains, apiResponse, err := getAllRoutingEmailDomainsFn(ctx, p, domainId)
+	if err != nil {
+		return nil, apiResponse, err
 	}
 
-	if len(allDomains) == 0 {
+	if len(*allDomains) == 0 {
 		return nil, apiResponse, nil
 	}
 
 	// Get all routes for each domain
-	routes, resp, err := getAllRoutingEmailRouteByDomainIdFn(ctx, p, allDomains, pattern)
+	routes, resp, err := getAllRoutingEmailRouteByDomainIdFn(ctx, p, *allDomains, pattern)
 	if err != nil {
 		return nil, resp, fmt.Errorf("failed to get routing email domains: %s", err.Error())
 	}
@@ -153,6 +150,26 @@ func getAllRoutingEmailRouteFn(ctx context.Context, p *routingEmailRouteProxy, d
 	return routes, resp, nil
 }
 
+// getAllRoutingEmailDomainsFn is the implementation for retrieving all routing email domains matching the filter in Genesys Cloud
+func getAllRoutingEmailDomainsFn(_ context.Context, p *routingEmailRouteProxy, filter string) (*[]platformclientv2.Inbounddomain, *platformclientv2.APIResponse, error) {
+	const pageSize = 100
+	var apiResponse *platformclientv2.APIResponse
+
+	var allDomains = make([]platformclientv2.Inbounddomain, 0)
+	for pageNum := 1; ; pageNum++ {
+		domains, resp, err := p.routingApi.GetRoutingEmailDomains(pageSize, pageNum, false, filter)
+		apiResponse = resp
+		if err != nil {
+			return nil, resp, fmt.Errorf("failed to get routing email domains: %s", err.Error())
+		}
+		if domains.Entities == nil || len(*domains.Entities) == 0 {
+			break
+		}
+		allDomains = append(allDomains, *domains.Entities...)
+	}
+	return &allDomains, apiResponse, nil
+}
+
 // createRoutingEmailRouteFn is an implementation function for creating a Genesys Cloud routing email route
 func createRoutingEmailRouteFn(_ context.Context, p *routingEmailRouteProxy, domainId string, routingEmailRoute *platformclientv2.Inboundroute) (*platformclientv2.Inboundroute, *platformclientv2.APIResponse, error) {
 	inboundRoute, resp, err := p.routingApi.PostRoutingEmailDomainRoutes(domainId, *routingEmailRoute)
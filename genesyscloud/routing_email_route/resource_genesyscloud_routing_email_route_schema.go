@@ -19,6 +19,7 @@ resource_genesycloud_routing_email_route_schema.go holds four functions within i
 4.  The resource exporter configuration for the routing_email_route exporter.
 */
 const ResourceType = "genesyscloud_routing_email_route"
+const EvaluationDataSourceType = "genesyscloud_routing_email_route_evaluation"
 
 var (
 	bccEmailResource = &schema.Resource{
@@ -42,6 +43,7 @@ func SetRegistrar(regInstance registrar.Registrar) {
 	regInstance.RegisterResource(ResourceType, ResourceRoutingEmailRoute())
 	regInstance.RegisterExporter(ResourceType, RoutingEmailRouteExporter())
 	regInstance.RegisterDataSource(ResourceType, DataSourceRoutingEmailRoute())
+	regInstance.RegisterDataSource(EvaluationDataSourceType, DataSourceRoutingEmailRouteEvaluation())
 }
 
 func ResourceRoutingEmailRoute() *schema.Resource {
@@ -179,6 +181,93 @@ func DataSourceRoutingEmailRoute() *schema.Resource {
 	}
 }
 
+// DataSourceRoutingEmailRouteEvaluation registers the genesyscloud_routing_email_route_evaluation data source
+func DataSourceRoutingEmailRouteEvaluation() *schema.Resource {
+	return &schema.Resource{
+		Description: "Evaluates locally which inbound email domain and route of the org would handle a sample email, and which flow or queue it would land in. Nothing is sent, so route changes can be tested in CI before anyone emails the org. The domain of the recipient selects the inbound domain and the mailbox name selects the route whose pattern matches it, ignoring case. Misconfigurations are reported as warnings: a recipient domain that is not an inbound domain or has an invalid MX record, patterns that overlap or can never match, routes with both or neither of a flow and a queue, reply addresses referencing missing routes and senders that are routes of the org.",
+		ReadContext: provider.ReadWithPooledClient(dataSourceRoutingEmailRouteEvaluationRead),
+		Schema: map[string]*schema.Schema{
+			"to": {
+				Description: "The recipient address of the sample email, e.g. support@example.com or Support <support@example.com>.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"from": {
+				Description: "The sender address of the sample email. A warning is reported when the sender is itself handled by a route of the org.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"subject": {
+				Description: "The subject of the sample email. Routes are selected by recipient only, the subject tells apart evaluations of different emails to the same recipient.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"matched": {
+				Description: "Whether a route of the org handles the email.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"domain_id": {
+				Description: "The inbound domain of the recipient. Empty when the recipient domain is not an inbound domain of the org.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"route_id": {
+				Description: "The route that handles the email. Empty when no route matches.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"pattern": {
+				Description: "The pattern of the route that handles the email.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"flow_id": {
+				Description: "The flow the email lands in.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"queue_id": {
+				Description: "The queue the email lands in when the route does not use a flow.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"priority": {
+				Description: "The priority the email is routed with.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"skill_ids": {
+				Description: "The skills the email is routed with.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"language_id": {
+				Description: "The language the email is routed with.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"spam_flow_id": {
+				Description: "The flow the email lands in when it is marked as spam.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"reply_from_email": {
+				Description: "The address replies to the email are sent from, either the from_email of the route or the address of the route of its reply_email_address.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"warnings": {
+				Description: "The misconfigurations found while evaluating the email. They are also reported as warnings of the plan.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
 // RoutingEmailRouteExporter returns the resourceExporter object used to hold the genesyscloud_routing_email_route exporter's config
 func RoutingEmailRouteExporter() *resourceExporter.ResourceExporter {
 	return &resourceExporter.ResourceExporter{
@@ -0,0 +1,232 @@
+package routing_queue
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+The resource_genesyscloud_routing_queue_blueprint.go file contains the genesyscloud_routing_queue_blueprint resource and the
+inheritance of its settings by genesyscloud_routing_queue. A blueprint only exists in the Terraform state: its settings are
+rendered to a JSON document, versioned with the schema of the queue resource, that queues reference with their blueprint
+attribute. The blueprint attributes that are not configured on a queue are planned with the values of the blueprint, so a
+change to the blueprint shows up as a diff on every queue stamped out from it.
+*/
+
+// routingQueueBlueprintAttributes are the queue attributes that can be set by a blueprint
+var routingQueueBlueprintAttributes = []string{
+	"media_settings_call",
+	"media_settings_callback",
+	"media_settings_chat",
+	"media_settings_email",
+	"media_settings_message",
+	"routing_rules",
+	"wrapup_codes",
+	"default_script_ids",
+	"queue_flow_id",
+	"email_in_queue_flow_id",
+	"message_in_queue_flow_id",
+}
+
+// routingQueueBlueprintPlannedEmpty are the blueprint attributes that are planned empty when they are neither configured
+// nor inherited, as they were before they could be inherited. The other attributes keep the value read from the queue.
+var routingQueueBlueprintPlannedEmpty = map[string]interface{}{
+	"routing_rules":            []interface{}{},
+	"default_script_ids":       map[string]interface{}{},
+	"queue_flow_id":            "",
+	"email_in_queue_flow_id":   "",
+	"message_in_queue_flow_id": "",
+}
+
+// routingQueueBlueprintSettings is the JSON document of the settings attribute of a blueprint
+type routingQueueBlueprintSettings struct {
+	SchemaVersion int                    `json:"schema_version"`
+	Attributes    map[string]interface{} `json:"attributes"`
+}
+
+func createRoutingQueueBlueprint(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	d.SetId(uuid.NewString())
+	log.Printf("Created routing queue blueprint %s", d.Id())
+	return nil
+}
+
+// readRoutingQueueBlueprint does nothing as the blueprint only exists in the state
+func readRoutingQueueBlueprint(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	return nil
+}
+
+// updateRoutingQueueBlueprint does nothing as the settings of the blueprint are planned by planRoutingQueueBlueprintSettings
+func updateRoutingQueueBlueprint(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	log.Printf("Updated routing queue blueprint %s", d.Id())
+	return nil
+}
+
+// deleteRoutingQueueBlueprint leaves the queues created from the blueprint as they are
+func deleteRoutingQueueBlueprint(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	log.Printf("Deleted routing queue blueprint %s", d.Id())
+	return nil
+}
+
+// planRoutingQueueBlueprintSettings renders the configured attributes of the blueprint to its settings attribute
+func planRoutingQueueBlueprintSettings(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	rawConfig := diff.GetRawConfig()
+	if rawConfig.IsNull() {
+		return nil
+	}
+	// Flows, scripts and wrap-up codes that are not created yet are only known at apply time
+	if !rawConfig.IsWhollyKnown() {
+		return diff.SetNewComputed("settings")
+	}
+
+	settings := routingQueueBlueprintSettings{
+		SchemaVersion: ResourceRoutingQueue().SchemaVersion,
+		Attributes:    make(map[string]interface{}),
+	}
+	for _, attribute := range routingQueueBlueprintAttributes {
+		if isUnconfigured(rawConfig.GetAttr(attribute)) {
+			continue
+		}
+		settings.Attributes[attribute] = blueprintValue(diff.Get(attribute))
+	}
+
+	settingsJson, err := json.Marshal(settings)
+	if err != nil {
+		return fmt.Errorf("failed to render the settings of the blueprint: %s", err)
+	}
+	if string(settingsJson) == diff.Get("settings").(string) {
+		return nil
+	}
+	return diff.SetNew("settings", string(settingsJson))
+}
+
+// inheritRoutingQueueBlueprint plans the blueprint attributes that are not configured on the queue with the values of its blueprint
+func inheritRoutingQueueBlueprint(ctx context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	rawConfig := diff.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return nil
+	}
+
+	var inherited []string
+	for _, attribute := range routingQueueBlueprintAttributes {
+		if value := rawConfig.GetAttr(attribute); value.IsKnown() && isUnconfigured(value) {
+			inherited = append(inherited, attribute)
+		}
+	}
+	if len(inherited) == 0 {
+		return nil
+	}
+
+	// The settings of a blueprint that references resources being created are only known once it has been applied
+	blueprint := rawConfig.GetAttr("blueprint")
+	if !blueprint.IsKnown() {
+		for _, attribute := range inherited {
+			if err := diff.SetNewComputed(attribute); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	attributes := make(map[string]interface{})
+	if !blueprint.IsNull() && blueprint.AsString() != "" {
+		var err error
+		if attributes, err = parseRoutingQueueBlueprintSettings(ctx, blueprint.AsString()); err != nil {
+			return fmt.Errorf("blueprint: %s", err)
+		}
+	}
+
+	for _, attribute := range inherited {
+		value, ok := attributes[attribute]
+		if !ok {
+			if value, ok = routingQueueBlueprintPlannedEmpty[attribute]; !ok {
+				continue
+			}
+		}
+		if err := diff.SetNew(attribute, value); err != nil {
+			return fmt.Errorf("%s: failed to inherit the value of the blueprint: %s", attribute, err)
+		}
+	}
+	return nil
+}
+
+// parseRoutingQueueBlueprintSettings returns the attributes of the settings of a blueprint, upgraded to the current schema of the queue
+func parseRoutingQueueBlueprintSettings(ctx context.Context, settingsJson string) (map[string]interface{}, error) {
+	var settings routingQueueBlueprintSettings
+	if err := json.Unmarshal([]byte(settingsJson), &settings); err != nil {
+		return nil, fmt.Errorf("must be the settings attribute of a %s: %s", BlueprintResourceType, err)
+	}
+
+	schemaVersion := ResourceRoutingQueue().SchemaVersion
+	if settings.SchemaVersion > schemaVersion {
+		return nil, fmt.Errorf("settings of schema version %d were rendered by a newer version of the provider, this version supports up to %d", settings.SchemaVersion, schemaVersion)
+	}
+	if settings.Attributes == nil {
+		return map[string]interface{}{}, nil
+	}
+	// Settings rendered with the v1 schema of the queue go through the same upgrade as the v1 state of a queue
+	if settings.SchemaVersion < 2 {
+		upgraded, err := stateUpgraderRoutingQueueV1ToV2(ctx, settings.Attributes, nil)
+		if err != nil {
+			return nil, err
+		}
+		settings.Attributes = upgraded
+	}
+
+	var unsupported []string
+	for attribute := range settings.Attributes {
+		if !lists.ItemInSlice(attribute, routingQueueBlueprintAttributes) {
+			unsupported = append(unsupported, attribute)
+		}
+	}
+	if len(unsupported) > 0 {
+		sort.Strings(unsupported)
+		return nil, fmt.Errorf("unsupported attributes %v, a blueprint can only set %v", unsupported, routingQueueBlueprintAttributes)
+	}
+	return settings.Attributes, nil
+}
+
+// isUnconfigured returns whether an attribute is not set in the configuration. Blocks that are not configured are empty rather than null.
+func isUnconfigured(value cty.Value) bool {
+	if value.IsNull() {
+		return true
+	}
+	if !value.IsKnown() {
+		return false
+	}
+	valueType := value.Type()
+	if valueType.IsListType() || valueType.IsSetType() || valueType.IsMapType() {
+		return value.LengthInt() == 0
+	}
+	return false
+}
+
+// blueprintValue converts the sets of a value read from the blueprint to lists so that it can be rendered to JSON
+func blueprintValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case *schema.Set:
+		return blueprintValue(v.List())
+	case []interface{}:
+		values := make([]interface{}, 0, len(v))
+		for _, item := range v {
+			values = append(values, blueprintValue(item))
+		}
+		return values
+	case map[string]interface{}:
+		values := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			values[key] = blueprintValue(item)
+		}
+		return values
+	default:
+		return value
+	}
+}
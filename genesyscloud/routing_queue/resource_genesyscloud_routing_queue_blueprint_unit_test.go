@@ -0,0 +1,94 @@
+package routing_queue
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitResourceRoutingQueueBlueprintSettings(t *testing.T) {
+	diff, err := diffRoutingQueueResource(t, ResourceRoutingQueueBlueprint(), map[string]interface{}{
+		"queue_flow_id": "flow-1",
+		"wrapup_codes":  []interface{}{"code-2", "code-1"},
+		"routing_rules": []interface{}{map[string]interface{}{"operator": "ANY", "wait_seconds": 10}},
+	}, nil)
+	assert.NoError(t, err)
+
+	var settings routingQueueBlueprintSettings
+	assert.NoError(t, json.Unmarshal([]byte(diff.Attributes["settings"].New), &settings))
+	assert.Equal(t, 2, settings.SchemaVersion)
+	assert.Equal(t, "flow-1", settings.Attributes["queue_flow_id"])
+	assert.Len(t, settings.Attributes["wrapup_codes"], 2)
+	assert.Equal(t, "ANY", settings.Attributes["routing_rules"].([]interface{})[0].(map[string]interface{})["operator"])
+	assert.NotContains(t, settings.Attributes, "media_settings_call", "attributes that are not configured are left to the queues")
+	assert.NotContains(t, settings.Attributes, "email_in_queue_flow_id", "attributes that are not configured are left to the queues")
+}
+
+func TestUnitResourceRoutingQueueInheritBlueprint(t *testing.T) {
+	blueprint := `{"schema_version": 2, "attributes": {
+		"queue_flow_id": "flow-1",
+		"wrapup_codes": ["code-1", "code-2"],
+		"routing_rules": [{"operator": "ANY", "threshold": 0, "wait_seconds": 10}]
+	}}`
+
+	// Attributes that are not configured are inherited from the blueprint
+	diff, err := diffRoutingQueueResource(t, ResourceRoutingQueue(), map[string]interface{}{"name": "queue", "blueprint": blueprint, "email_in_queue_flow_id": "flow-2"}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "flow-1", diff.Attributes["queue_flow_id"].New)
+	assert.Equal(t, "flow-2", diff.Attributes["email_in_queue_flow_id"].New)
+	assert.Equal(t, "2", diff.Attributes["wrapup_codes.#"].New)
+	assert.Equal(t, "ANY", diff.Attributes["routing_rules.0.operator"].New)
+	assert.Equal(t, "10", diff.Attributes["routing_rules.0.wait_seconds"].New)
+
+	// A change to the blueprint shows up as a diff on the queue
+	state := map[string]string{
+		"id":              "queue-id",
+		"name":            "queue",
+		"blueprint":       blueprint,
+		"queue_flow_id":   "flow-1",
+		"wrapup_codes.#":  "0",
+		"routing_rules.#": "0",
+	}
+	diff, err = diffRoutingQueueResource(t, ResourceRoutingQueue(), map[string]interface{}{"name": "queue", "blueprint": `{"schema_version": 2, "attributes": {"queue_flow_id": "flow-3"}}`}, state)
+	assert.NoError(t, err)
+	assert.Equal(t, "flow-1", diff.Attributes["queue_flow_id"].Old)
+	assert.Equal(t, "flow-3", diff.Attributes["queue_flow_id"].New)
+
+	// Without a blueprint, attributes that are not configured are cleared as they were before they could be inherited
+	diff, err = diffRoutingQueueResource(t, ResourceRoutingQueue(), map[string]interface{}{"name": "queue"}, state)
+	assert.NoError(t, err)
+	assert.Equal(t, "", diff.Attributes["queue_flow_id"].New)
+
+	// Settings rendered with the v1 schema of the queue are upgraded
+	diff, err = diffRoutingQueueResource(t, ResourceRoutingQueue(), map[string]interface{}{"name": "queue", "blueprint": `{"schema_version": 1, "attributes": {
+		"media_settings_call": [{"alerting_timeout_sec": 8, "mode": "AgentFirst", "enable_auto_dial_and_end": false}]
+	}}`}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "8", diff.Attributes["media_settings_call.0.alerting_timeout_sec"].New)
+
+	_, err = diffRoutingQueueResource(t, ResourceRoutingQueue(), map[string]interface{}{"name": "queue", "blueprint": `{"schema_version": 2, "attributes": {"name": "other"}}`}, nil)
+	assert.ErrorContains(t, err, "blueprint: unsupported attributes [name]")
+
+	_, err = diffRoutingQueueResource(t, ResourceRoutingQueue(), map[string]interface{}{"name": "queue", "blueprint": `{"schema_version": 3, "attributes": {}}`}, nil)
+	assert.ErrorContains(t, err, "rendered by a newer version of the provider")
+}
+
+// diffRoutingQueueResource plans a queue or blueprint with the given configuration and state attributes
+func diffRoutingQueueResource(t *testing.T, resource *schema.Resource, config map[string]interface{}, stateAttributes map[string]string) (*terraform.InstanceDiff, error) {
+	configJson, err := json.Marshal(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rawConfig, err := ctyjson.Unmarshal(configJson, resource.CoreConfigSchema().ImpliedType())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	state := &terraform.InstanceState{ID: stateAttributes["id"], Attributes: stateAttributes, RawConfig: rawConfig}
+	return resource.Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), nil)
+}
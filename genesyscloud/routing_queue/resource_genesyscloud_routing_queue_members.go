@@ -60,7 +60,7 @@ func getRoutingQueueMembers(queueID string, memberBy string, sdkConfig *platform
 	log.Printf("%d members belong to queue %s", queueMembers, queueID)
 
 	for pageNum := 1; ; pageNum++ {
-		users, resp, err := sdkGetRoutingQueueMembers(queueID, memberBy, pageNum, 100, sdkConfig)
+		users, resp, err := SdkGetRoutingQueueMembers(queueID, memberBy, pageNum, 100, sdkConfig)
 		if err != nil || resp.StatusCode != http.StatusOK {
 			return nil, util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to query users for queue %s error: %s", queueID, err), resp)
 		}
@@ -182,7 +182,8 @@ func updateQueueUserRingNum(queueID string, userID string, ringNum int, sdkConfi
 	return nil
 }
 
-func sdkGetRoutingQueueMembers(queueID, memberBy string, pageNumber, pageSize int, sdkConfig *platformclientv2.Configuration) (*platformclientv2.Queuememberentitylisting, *platformclientv2.APIResponse, error) {
+// SdkGetRoutingQueueMembers returns a page of the members of a queue, optionally filtered by memberBy (user or group)
+func SdkGetRoutingQueueMembers(queueID, memberBy string, pageNumber, pageSize int, sdkConfig *platformclientv2.Configuration) (*platformclientv2.Queuememberentitylisting, *platformclientv2.APIResponse, error) {
 	api := platformclientv2.NewRoutingApiWithConfig(sdkConfig)
 	// SDK does not support nil values for boolean query params yet, so we must manually construct this HTTP request for now
 	apiClient := &api.Configuration.APIClient
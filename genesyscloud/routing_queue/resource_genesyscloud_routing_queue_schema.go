@@ -1,6 +1,7 @@
 package routing_queue
 
 import (
+	"context"
 	"terraform-provider-genesyscloud/genesyscloud/provider"
 	resourceExporter "terraform-provider-genesyscloud/genesyscloud/resource_exporter"
 	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
@@ -18,14 +19,19 @@ import (
 	"terraform-provider-genesyscloud/genesyscloud/team"
 	"terraform-provider-genesyscloud/genesyscloud/user"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 const ResourceType = "genesyscloud_routing_queue"
+const BlueprintResourceType = "genesyscloud_routing_queue_blueprint"
+const WrapupcodeAssignmentResourceType = "genesyscloud_routing_wrapupcode_assignment"
 
 func SetRegistrar(regInstance registrar.Registrar) {
 	regInstance.RegisterResource(ResourceType, ResourceRoutingQueue())
+	regInstance.RegisterResource(BlueprintResourceType, ResourceRoutingQueueBlueprint())
+	regInstance.RegisterResource(WrapupcodeAssignmentResourceType, ResourceRoutingWrapupcodeAssignment())
 	regInstance.RegisterDataSource(ResourceType, DataSourceRoutingQueue())
 	regInstance.RegisterExporter(ResourceType, RoutingQueueExporter())
 }
@@ -242,12 +248,19 @@ func ResourceRoutingQueue() *schema.Resource {
 				Upgrade: stateUpgraderRoutingQueueV1ToV2,
 			},
 		},
+		CustomizeDiff: inheritRoutingQueueBlueprint,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Description: "Queue name.",
 				Type:        schema.TypeString,
 				Required:    true,
 			},
+			"blueprint": {
+				Description:  "The settings attribute of the genesyscloud_routing_queue_blueprint the queue is stamped out from. The media settings, routing_rules, wrapup_codes, default_script_ids and in-queue flows that are not set on the queue are inherited from the blueprint, so a change to the blueprint shows up as a diff on every queue created from it.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
 			"division_id": {
 				Description: "The division to which this queue will belong. If not set, the home division will be used.",
 				Type:        schema.TypeString,
@@ -260,7 +273,7 @@ func ResourceRoutingQueue() *schema.Resource {
 				Optional:    true,
 			},
 			"media_settings_call": {
-				Description: "Call media settings.",
+				Description: "Call media settings. Inherited from blueprint when not set.",
 				Type:        schema.TypeList,
 				MaxItems:    1,
 				Optional:    true,
@@ -283,7 +296,7 @@ func ResourceRoutingQueue() *schema.Resource {
 				Elem:        cannedResponseLibrariesResource,
 			},
 			"media_settings_callback": {
-				Description: "Callback media settings.",
+				Description: "Callback media settings. Inherited from blueprint when not set.",
 				Type:        schema.TypeList,
 				MaxItems:    1,
 				Optional:    true,
@@ -291,7 +304,7 @@ func ResourceRoutingQueue() *schema.Resource {
 				Elem:        queueCallbackMediaSettingsResource,
 			},
 			"media_settings_chat": {
-				Description: "Chat media settings.",
+				Description: "Chat media settings. Inherited from blueprint when not set.",
 				Type:        schema.TypeList,
 				MaxItems:    1,
 				Optional:    true,
@@ -299,7 +312,7 @@ func ResourceRoutingQueue() *schema.Resource {
 				Elem:        queueMediaSettingsResource,
 			},
 			"media_settings_email": {
-				Description: "Email media settings.",
+				Description: "Email media settings. Inherited from blueprint when not set.",
 				Type:        schema.TypeList,
 				MaxItems:    1,
 				Optional:    true,
@@ -307,7 +320,7 @@ func ResourceRoutingQueue() *schema.Resource {
 				Elem:        queueMediaSettingsResource,
 			},
 			"media_settings_message": {
-				Description: "Message media settings.",
+				Description: "Message media settings. Inherited from blueprint when not set.",
 				Type:        schema.TypeList,
 				MaxItems:    1,
 				Optional:    true,
@@ -315,9 +328,10 @@ func ResourceRoutingQueue() *schema.Resource {
 				Elem:        queueMediaSettingsResource,
 			},
 			"routing_rules": {
-				Description: "The routing rules for the queue, used for routing to known or preferred agents.",
+				Description: "The routing rules for the queue, used for routing to known or preferred agents. Inherited from blueprint when not set.",
 				Type:        schema.TypeList,
 				Optional:    true,
+				Computed:    true,
 				MaxItems:    6,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -439,19 +453,22 @@ func ResourceRoutingQueue() *schema.Resource {
 				ValidateFunc: validation.StringInSlice([]string{"NONE", "BEST", "ALL"}, false),
 			},
 			"queue_flow_id": {
-				Description: "The in-queue flow ID to use for call conversations waiting in queue.",
+				Description: "The in-queue flow ID to use for call conversations waiting in queue. Inherited from blueprint when not set.",
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
 			},
 			"email_in_queue_flow_id": {
-				Description: "The in-queue flow ID to use for email conversations waiting in queue.",
+				Description: "The in-queue flow ID to use for email conversations waiting in queue. Inherited from blueprint when not set.",
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
 			},
 			"message_in_queue_flow_id": {
-				Description: "The in-queue flow ID to use for message conversations waiting in queue.",
+				Description: "The in-queue flow ID to use for message conversations waiting in queue. Inherited from blueprint when not set.",
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
 			},
 			"whisper_prompt_id": {
 				Description: "The prompt ID used for whisper on the queue, if configured.",
@@ -520,10 +537,11 @@ func ResourceRoutingQueue() *schema.Resource {
 				ValidateFunc: validation.StringInSlice([]string{"TimestampAndPriority", "PriorityOnly"}, false),
 			},
 			"default_script_ids": {
-				Description:      "The default script IDs for each communication type. Communication types: (CALL | CALLBACK | CHAT | COBROWSE | EMAIL | MESSAGE | SOCIAL_EXPRESSION | VIDEO | SCREENSHARE)",
+				Description:      "The default script IDs for each communication type. Communication types: (CALL | CALLBACK | CHAT | COBROWSE | EMAIL | MESSAGE | SOCIAL_EXPRESSION | VIDEO | SCREENSHARE). Inherited from blueprint when not set.",
 				Type:             schema.TypeMap,
 				ValidateDiagFunc: validateMapCommTypes,
 				Optional:         true,
+				Computed:         true,
 				Elem:             &schema.Schema{Type: schema.TypeString},
 			},
 			"outbound_messaging_sms_address_id": {
@@ -562,7 +580,7 @@ func ResourceRoutingQueue() *schema.Resource {
 				},
 			},
 			"members": {
-				Description: "Users in the queue. If not set, this resource will not manage members. If a user is already assigned to this queue via a group, attempting to assign them using this field will cause an error to be thrown.",
+				Description: "Users in the queue. If not set, this resource will not manage members. Leave unset when members are managed with genesyscloud_routing_queue_member. If a user is already assigned to this queue via a group, attempting to assign them using this field will cause an error to be thrown.",
 				Type:        schema.TypeSet,
 				Optional:    true,
 				Computed:    true,
@@ -570,7 +588,7 @@ func ResourceRoutingQueue() *schema.Resource {
 				Elem:        queueMemberResource,
 			},
 			"wrapup_codes": {
-				Description: "IDs of wrapup codes assigned to this queue. If not set, this resource will not manage wrapup codes.",
+				Description: "IDs of wrapup codes assigned to this queue. Inherited from blueprint when not set. If not set, and not set by the blueprint, this resource will not manage wrapup codes.",
 				Type:        schema.TypeSet,
 				Optional:    true,
 				Computed:    true,
@@ -605,6 +623,122 @@ func ResourceRoutingQueue() *schema.Resource {
 	}
 }
 
+// ResourceRoutingQueueBlueprint registers the genesyscloud_routing_queue_blueprint resource
+func ResourceRoutingQueueBlueprint() *schema.Resource {
+	queueSchema := ResourceRoutingQueue().Schema
+	blueprintSchema := map[string]*schema.Schema{
+		"settings": {
+			Description: "The configured settings of the blueprint rendered to JSON. Set it as the blueprint attribute of the genesyscloud_routing_queue resources stamped out from the blueprint.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+	for _, attribute := range routingQueueBlueprintAttributes {
+		attributeSchema := *queueSchema[attribute]
+		attributeSchema.Computed = false
+		attributeSchema.Description = routingQueueBlueprintDescriptions[attribute]
+		blueprintSchema[attribute] = &attributeSchema
+	}
+
+	return &schema.Resource{
+		Description: "Genesys Cloud Routing Queue Blueprint. Defines the media settings, routing rules, wrap-up codes, default scripts and in-queue flows shared by many queues once. Queues are stamped out from the blueprint by setting its settings attribute as their blueprint attribute, and override it with their own name, division, members, outbound email address and any blueprint attribute they set. The blueprint only exists in the Terraform state, destroying it leaves the queues as they are.",
+
+		CreateContext: createRoutingQueueBlueprint,
+		ReadContext:   readRoutingQueueBlueprint,
+		UpdateContext: updateRoutingQueueBlueprint,
+		DeleteContext: deleteRoutingQueueBlueprint,
+		SchemaVersion: 1,
+		CustomizeDiff: planRoutingQueueBlueprintSettings,
+		Schema:        blueprintSchema,
+	}
+}
+
+// ResourceRoutingWrapupcodeAssignment registers the genesyscloud_routing_wrapupcode_assignment resource
+func ResourceRoutingWrapupcodeAssignment() *schema.Resource {
+	queueSelection := []string{"queue_ids", "queue_division_ids", "queue_name_regex"}
+	return &schema.Resource{
+		Description: "Genesys Cloud Routing Wrap-up Code Assignment. Assigns a set of wrap-up codes to a set of queues, or to the queues matching a division or name pattern, without managing the other wrap-up codes of the queues. The queues matching the filters are resolved on every refresh, so that queues created or renamed since the last apply get the codes. Do not set the wrapup_codes of the genesyscloud_routing_queue resources of the assigned queues, as they would remove the codes of the assignment.",
+
+		CreateContext: provider.CreateWithPooledClient(createWrapupcodeAssignment),
+		ReadContext:   provider.ReadWithPooledClient(readWrapupcodeAssignment),
+		UpdateContext: provider.UpdateWithPooledClient(updateWrapupcodeAssignment),
+		DeleteContext: provider.DeleteWithPooledClient(deleteWrapupcodeAssignment),
+		SchemaVersion: 1,
+		CustomizeDiff: customdiff.All(
+			customdiff.ComputedIf("assignments_in_sync", wrapupcodeAssignmentOutOfSync),
+			customdiff.ComputedIf("assigned_queue_ids", wrapupcodeAssignmentOutOfSync),
+			customdiff.ComputedIf("assigned_queue_ids", func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+				return d.HasChanges(queueSelection...)
+			}),
+			customdiff.ComputedIf("added_assignments", wrapupcodeAssignmentOutOfSync),
+			customdiff.ComputedIf("added_assignments", func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+				return d.HasChanges(append([]string{"wrapupcode_ids"}, queueSelection...)...)
+			}),
+		),
+		Schema: map[string]*schema.Schema{
+			"wrapupcode_ids": {
+				Description: "IDs of the wrap-up codes assigned to the queues.",
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"queue_ids": {
+				Description:  "IDs of queues the wrap-up codes are assigned to.",
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				AtLeastOneOf: queueSelection,
+			},
+			"queue_division_ids": {
+				Description:  "The wrap-up codes are assigned to the queues in one of these divisions. If queue_name_regex is also set, the queues must match both.",
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				AtLeastOneOf: queueSelection,
+			},
+			"queue_name_regex": {
+				Description:  "The wrap-up codes are assigned to the queues whose name matches this regular expression. If queue_division_ids is also set, the queues must match both.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+				AtLeastOneOf: queueSelection,
+			},
+			"assigned_queue_ids": {
+				Description: "IDs of the queues the wrap-up codes are assigned to, including the queues matching the filters at the last apply.",
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"added_assignments": {
+				Description: "The queue and wrap-up code pairs added by the assignment, as `<queue_id>:<wrapupcode_id>`. Codes a queue already had are not added by the assignment. Only these pairs are removed when the codes or queues of the assignment change, or when it is destroyed.",
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"assignments_in_sync": {
+				Description: "False when the last refresh found queues selected by the assignment that are missing some of its wrap-up codes, or assigned queues that are no longer selected. The next apply assigns the codes again.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+var routingQueueBlueprintDescriptions = map[string]string{
+	"media_settings_call":      "Call media settings of the queues.",
+	"media_settings_callback":  "Callback media settings of the queues.",
+	"media_settings_chat":      "Chat media settings of the queues.",
+	"media_settings_email":     "Email media settings of the queues.",
+	"media_settings_message":   "Message media settings of the queues.",
+	"routing_rules":            "The routing rules of the queues, used for routing to known or preferred agents.",
+	"wrapup_codes":             "IDs of wrapup codes assigned to the queues.",
+	"default_script_ids":       "The default script IDs of the queues for each communication type. Communication types: (CALL | CALLBACK | CHAT | COBROWSE | EMAIL | MESSAGE | SOCIAL_EXPRESSION | VIDEO | SCREENSHARE)",
+	"queue_flow_id":            "The in-queue flow ID to use for call conversations waiting in the queues.",
+	"email_in_queue_flow_id":   "The in-queue flow ID to use for email conversations waiting in the queues.",
+	"message_in_queue_flow_id": "The in-queue flow ID to use for message conversations waiting in the queues.",
+}
+
 func RoutingQueueExporter() *resourceExporter.ResourceExporter {
 	return &resourceExporter.ResourceExporter{
 		GetResourcesFunc: provider.GetAllWithPooledClient(getAllRoutingQueues),
@@ -0,0 +1,361 @@
+package routing_queue
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	chunksProcess "terraform-provider-genesyscloud/genesyscloud/util/chunks"
+	"terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v154/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_routing_wrapupcode_assignment.go file contains the genesyscloud_routing_wrapupcode_assignment
+resource. It assigns a set of wrap-up codes to the queues it selects: the queues in queue_ids and the queues matching its
+division and name filters. It is not authoritative: it only adds its own codes to the queues, and records in added_assignments
+the queue and code pairs it actually added, which are the only ones it ever removes. The queues selected by the filters are resolved on every refresh, so that codes
+are assigned to the queues created or renamed since the last apply.
+*/
+
+// wrapupcodeAssignmentQueueSelection holds the settings of an assignment selecting its queues
+type wrapupcodeAssignmentQueueSelection struct {
+	queueIds    []string
+	divisionIds []string
+	nameRegex   *regexp.Regexp
+}
+
+// wrapupcodeAssignmentChanges are the codes to add to and remove from each queue, keyed by queue ID, and the pairs the
+// assignment keeps once they are applied
+type wrapupcodeAssignmentChanges struct {
+	codesToAdd    map[string][]string
+	codesToRemove map[string][]string
+	keptPairs     []string
+}
+
+func createWrapupcodeAssignment(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := GetRoutingQueueProxy(sdkConfig)
+
+	queueIds, diagErr := resolveWrapupcodeAssignmentQueueIds(ctx, proxy, d)
+	if diagErr != nil {
+		return diagErr
+	}
+	codeIds := *lists.SetToStringList(d.Get("wrapupcode_ids").(*schema.Set))
+
+	log.Printf("Assigning %d wrap-up codes to %d queues", len(codeIds), len(queueIds))
+	addedPairs, diagErr := applyWrapupcodeAssignment(ctx, proxy, nil, queueIds, codeIds)
+	if diagErr != nil {
+		return diagErr
+	}
+
+	d.SetId(uuid.NewString())
+	_ = d.Set("assigned_queue_ids", queueIds)
+	_ = d.Set("added_assignments", addedPairs)
+	log.Printf("Assigned %d wrap-up codes to %d queues", len(codeIds), len(queueIds))
+	return readWrapupcodeAssignment(ctx, d, meta)
+}
+
+func readWrapupcodeAssignment(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := GetRoutingQueueProxy(sdkConfig)
+
+	log.Printf("Reading wrap-up code assignment %s", d.Id())
+	return util.WithRetriesForRead(ctx, d, func() *retry.RetryError {
+		queues, diagErr := getAllWrapupcodeAssignmentQueues(ctx, proxy)
+		if diagErr != nil {
+			return retry.NonRetryableError(fmt.Errorf("%v", diagErr))
+		}
+		selection, err := buildWrapupcodeAssignmentQueueSelection(d)
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+		queueIds := selectWrapupcodeAssignmentQueues(queues, selection)
+
+		// Queues deleted since the last apply are no longer assigned
+		existingQueueIds := make([]string, 0, len(queues))
+		for _, queue := range queues {
+			existingQueueIds = append(existingQueueIds, *queue.Id)
+		}
+		assignedQueueIds := intersectIds(*lists.SetToStringList(d.Get("assigned_queue_ids").(*schema.Set)), existingQueueIds)
+		_ = d.Set("assigned_queue_ids", assignedQueueIds)
+		addedPairs := make([]string, 0)
+		for _, pair := range *lists.SetToStringList(d.Get("added_assignments").(*schema.Set)) {
+			if queueId, _ := splitWrapupcodeAssignmentPair(pair); lists.ItemInSlice(queueId, existingQueueIds) {
+				addedPairs = append(addedPairs, pair)
+			}
+		}
+		_ = d.Set("added_assignments", addedPairs)
+
+		inSync := len(lists.SliceDifference(queueIds, assignedQueueIds)) == 0 && len(lists.SliceDifference(assignedQueueIds, queueIds)) == 0
+		codeIds := *lists.SetToStringList(d.Get("wrapupcode_ids").(*schema.Set))
+		for _, queueId := range queueIds {
+			if !inSync {
+				break
+			}
+			codes, resp, err := proxy.getAllRoutingQueueWrapupCodes(ctx, queueId)
+			if err != nil {
+				return retry.NonRetryableError(util.BuildWithRetriesApiDiagnosticError(WrapupcodeAssignmentResourceType, fmt.Sprintf("Failed to read wrap-up codes of queue %s | error: %s", queueId, err), resp))
+			}
+			if len(lists.SliceDifference(codeIds, getWrapupCodeIds(codes))) > 0 {
+				log.Printf("Wrap-up codes of assignment %s are missing from queue %s", d.Id(), queueId)
+				inSync = false
+			}
+		}
+		_ = d.Set("assignments_in_sync", inSync)
+
+		log.Printf("Read wrap-up code assignment %s", d.Id())
+		return nil
+	})
+}
+
+func updateWrapupcodeAssignment(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := GetRoutingQueueProxy(sdkConfig)
+
+	queueIds, diagErr := resolveWrapupcodeAssignmentQueueIds(ctx, proxy, d)
+	if diagErr != nil {
+		return diagErr
+	}
+	oldAddedPairs, _ := d.GetChange("added_assignments")
+	codeIds := *lists.SetToStringList(d.Get("wrapupcode_ids").(*schema.Set))
+
+	log.Printf("Updating wrap-up code assignment %s", d.Id())
+	addedPairs, diagErr := applyWrapupcodeAssignment(ctx, proxy, *lists.SetToStringList(oldAddedPairs.(*schema.Set)), queueIds, codeIds)
+	_ = d.Set("added_assignments", addedPairs)
+	if diagErr != nil {
+		return diagErr
+	}
+
+	_ = d.Set("assigned_queue_ids", queueIds)
+	log.Printf("Updated wrap-up code assignment %s", d.Id())
+	return readWrapupcodeAssignment(ctx, d, meta)
+}
+
+func deleteWrapupcodeAssignment(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := GetRoutingQueueProxy(sdkConfig)
+
+	addedPairs := *lists.SetToStringList(d.Get("added_assignments").(*schema.Set))
+
+	// Only the codes the assignment added are removed, the codes the queues already had are kept
+	log.Printf("Removing the %d wrap-up codes added to queues by assignment %s", len(addedPairs), d.Id())
+	if _, diagErr := applyWrapupcodeAssignment(ctx, proxy, addedPairs, nil, nil); diagErr != nil {
+		return diagErr
+	}
+	log.Printf("Removed wrap-up code assignment %s", d.Id())
+	return nil
+}
+
+// applyWrapupcodeAssignment assigns the codes to the queues and removes the pairs added before that are no longer assigned.
+// It returns the queue and code pairs added by the assignment, including those applied before a failure.
+func applyWrapupcodeAssignment(ctx context.Context, proxy *RoutingQueueProxy, addedPairs, queueIds, codeIds []string) ([]string, diag.Diagnostics) {
+	currentCodeIds := make(map[string][]string)
+	readQueueIds := make([]string, 0, len(addedPairs)+len(queueIds))
+	for _, pair := range addedPairs {
+		queueId, _ := splitWrapupcodeAssignmentPair(pair)
+		readQueueIds = append(readQueueIds, queueId)
+	}
+	readQueueIds = append(readQueueIds, queueIds...)
+	for _, queueId := range readQueueIds {
+		if _, ok := currentCodeIds[queueId]; ok {
+			continue
+		}
+		codes, resp, err := proxy.getAllRoutingQueueWrapupCodes(ctx, queueId)
+		if err != nil {
+			if util.IsStatus404(resp) && !lists.ItemInSlice(queueId, queueIds) {
+				// Queues deleted since they were assigned no longer need their codes removed
+				currentCodeIds[queueId] = []string{}
+				continue
+			}
+			return addedPairs, util.BuildAPIDiagnosticError(WrapupcodeAssignmentResourceType, fmt.Sprintf("Failed to read wrap-up codes of queue %s: %s", queueId, err), resp)
+		}
+		currentCodeIds[queueId] = getWrapupCodeIds(codes)
+	}
+
+	changes := planWrapupcodeAssignmentChanges(currentCodeIds, addedPairs, queueIds, codeIds)
+	// Until every change is applied, the pairs to remove are still added by the assignment
+	added := append([]string{}, changes.keptPairs...)
+	for _, queueId := range sortedKeys(changes.codesToRemove) {
+		for _, codeId := range changes.codesToRemove[queueId] {
+			added = append(added, buildWrapupcodeAssignmentPair(queueId, codeId))
+		}
+	}
+	for _, queueId := range sortedKeys(changes.codesToRemove) {
+		for _, codeId := range changes.codesToRemove[queueId] {
+			resp, err := proxy.deleteRoutingQueueWrapupCode(ctx, queueId, codeId)
+			if err != nil && !util.IsStatus404(resp) {
+				return added, util.BuildAPIDiagnosticError(WrapupcodeAssignmentResourceType, fmt.Sprintf("Failed to remove wrap-up code %s from queue %s: %s", codeId, queueId, err), resp)
+			}
+			added = lists.SliceDifference(added, []string{buildWrapupcodeAssignmentPair(queueId, codeId)})
+		}
+	}
+	for _, queueId := range sortedKeys(changes.codesToAdd) {
+		// API restricts wrapup code adds to 100 per call
+		chunks := chunksProcess.ChunkItems(changes.codesToAdd[queueId], platformWrapupCodeReferenceFunc, 100)
+		diagErr := chunksProcess.ProcessChunks(chunks, func(chunk []platformclientv2.Wrapupcodereference) diag.Diagnostics {
+			_, resp, err := proxy.createRoutingQueueWrapupCode(ctx, queueId, chunk)
+			if err != nil {
+				return util.BuildAPIDiagnosticError(WrapupcodeAssignmentResourceType, fmt.Sprintf("Failed to add wrap-up codes to queue %s: %s", queueId, err), resp)
+			}
+			for _, code := range chunk {
+				added = append(added, buildWrapupcodeAssignmentPair(queueId, *code.Id))
+			}
+			return nil
+		})
+		if diagErr != nil {
+			return added, diagErr
+		}
+	}
+	sort.Strings(added)
+	return added, nil
+}
+
+// planWrapupcodeAssignmentChanges returns the changes assigning the codes to the queues, given the current codes of the queues.
+// Only the pairs the assignment added that are no longer assigned are removed, codes the queues already had are left as they
+// are and are not recorded as added.
+func planWrapupcodeAssignmentChanges(currentCodeIds map[string][]string, addedPairs, queueIds, codeIds []string) wrapupcodeAssignmentChanges {
+	changes := wrapupcodeAssignmentChanges{
+		codesToAdd:    make(map[string][]string),
+		codesToRemove: make(map[string][]string),
+		keptPairs:     make([]string, 0),
+	}
+	for _, pair := range addedPairs {
+		queueId, codeId := splitWrapupcodeAssignmentPair(pair)
+		if !lists.ItemInSlice(codeId, currentCodeIds[queueId]) {
+			// Codes removed since they were added are added again if they are still assigned
+			continue
+		}
+		if lists.ItemInSlice(queueId, queueIds) && lists.ItemInSlice(codeId, codeIds) {
+			changes.keptPairs = append(changes.keptPairs, pair)
+			continue
+		}
+		changes.codesToRemove[queueId] = append(changes.codesToRemove[queueId], codeId)
+	}
+	for _, queueId := range queueIds {
+		if codesToAdd := lists.SliceDifference(codeIds, currentCodeIds[queueId]); len(codesToAdd) > 0 {
+			changes.codesToAdd[queueId] = codesToAdd
+		}
+	}
+	return changes
+}
+
+// buildWrapupcodeAssignmentPair returns the added_assignments item of a code added to a queue
+func buildWrapupcodeAssignmentPair(queueId, codeId string) string {
+	return fmt.Sprintf("%s:%s", queueId, codeId)
+}
+
+// splitWrapupcodeAssignmentPair returns the queue and code IDs of an added_assignments item
+func splitWrapupcodeAssignmentPair(pair string) (queueId string, codeId string) {
+	split := strings.SplitN(pair, ":", 2)
+	if len(split) == 2 {
+		return split[0], split[1]
+	}
+	return "", ""
+}
+
+// resolveWrapupcodeAssignmentQueueIds returns the IDs of the queues currently selected by the assignment
+func resolveWrapupcodeAssignmentQueueIds(ctx context.Context, proxy *RoutingQueueProxy, d *schema.ResourceData) ([]string, diag.Diagnostics) {
+	selection, err := buildWrapupcodeAssignmentQueueSelection(d)
+	if err != nil {
+		return nil, util.BuildDiagnosticError(WrapupcodeAssignmentResourceType, "Invalid queue selection", err)
+	}
+	// Queues listed by ID are assigned whether or not they can be listed yet
+	if selection.nameRegex == nil && len(selection.divisionIds) == 0 {
+		return selectWrapupcodeAssignmentQueues(nil, selection), nil
+	}
+	queues, diagErr := getAllWrapupcodeAssignmentQueues(ctx, proxy)
+	if diagErr != nil {
+		return nil, diagErr
+	}
+	return selectWrapupcodeAssignmentQueues(queues, selection), nil
+}
+
+func buildWrapupcodeAssignmentQueueSelection(d *schema.ResourceData) (wrapupcodeAssignmentQueueSelection, error) {
+	selection := wrapupcodeAssignmentQueueSelection{
+		queueIds:    *lists.SetToStringList(d.Get("queue_ids").(*schema.Set)),
+		divisionIds: *lists.SetToStringList(d.Get("queue_division_ids").(*schema.Set)),
+	}
+	if nameRegex := d.Get("queue_name_regex").(string); nameRegex != "" {
+		compiled, err := regexp.Compile(nameRegex)
+		if err != nil {
+			return selection, fmt.Errorf("queue_name_regex: %s", err)
+		}
+		selection.nameRegex = compiled
+	}
+	return selection, nil
+}
+
+// selectWrapupcodeAssignmentQueues returns the sorted IDs of the queues in queue_ids and of the queues matching every filter
+// of the selection. Queues are not matched by filters when no filter is set.
+func selectWrapupcodeAssignmentQueues(queues []platformclientv2.Queue, selection wrapupcodeAssignmentQueueSelection) []string {
+	selected := make(map[string]bool)
+	for _, queueId := range selection.queueIds {
+		selected[queueId] = true
+	}
+
+	if selection.nameRegex != nil || len(selection.divisionIds) > 0 {
+		for _, queue := range queues {
+			if queue.Id == nil {
+				continue
+			}
+			if len(selection.divisionIds) > 0 && (queue.Division == nil || queue.Division.Id == nil || !lists.ItemInSlice(*queue.Division.Id, selection.divisionIds)) {
+				continue
+			}
+			if selection.nameRegex != nil && (queue.Name == nil || !selection.nameRegex.MatchString(*queue.Name)) {
+				continue
+			}
+			selected[*queue.Id] = true
+		}
+	}
+	return sortedKeys(selected)
+}
+
+// getAllWrapupcodeAssignmentQueues returns every queue of the org, with or without a peer
+func getAllWrapupcodeAssignmentQueues(ctx context.Context, proxy *RoutingQueueProxy) ([]platformclientv2.Queue, diag.Diagnostics) {
+	var allQueues []platformclientv2.Queue
+	for _, hasPeer := range []bool{false, true} {
+		queues, resp, err := proxy.GetAllRoutingQueues(ctx, "", hasPeer)
+		if err != nil {
+			return nil, util.BuildAPIDiagnosticError(WrapupcodeAssignmentResourceType, fmt.Sprintf("Failed to get routing queues: %s", err), resp)
+		}
+		if queues != nil {
+			allQueues = append(allQueues, *queues...)
+		}
+	}
+	return allQueues, nil
+}
+
+// wrapupcodeAssignmentOutOfSync is true when the last refresh found selected queues missing codes of the assignment,
+// or queues that are no longer selected
+func wrapupcodeAssignmentOutOfSync(_ context.Context, d *schema.ResourceDiff, _ interface{}) bool {
+	return d.Id() != "" && !d.Get("assignments_in_sync").(bool)
+}
+
+func intersectIds(a []string, b []string) []string {
+	intersection := make([]string, 0)
+	for _, item := range a {
+		if lists.ItemInSlice(item, b) {
+			intersection = append(intersection, item)
+		}
+	}
+	return intersection
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,162 @@
+package routing_queue
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"sort"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v154/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func buildTestAssignmentQueue(id, name, divisionId string) platformclientv2.Queue {
+	return platformclientv2.Queue{
+		Id:       platformclientv2.String(id),
+		Name:     platformclientv2.String(name),
+		Division: &platformclientv2.Division{Id: platformclientv2.String(divisionId)},
+	}
+}
+
+func TestUnitSelectWrapupcodeAssignmentQueues(t *testing.T) {
+	queues := []platformclientv2.Queue{
+		buildTestAssignmentQueue("queue-1", "Support EMEA", "division-1"),
+		buildTestAssignmentQueue("queue-2", "Support APAC", "division-2"),
+		buildTestAssignmentQueue("queue-3", "Sales EMEA", "division-1"),
+	}
+
+	assert.Equal(t, []string{"queue-1", "queue-2"}, selectWrapupcodeAssignmentQueues(queues, wrapupcodeAssignmentQueueSelection{
+		nameRegex: regexp.MustCompile("^Support "),
+	}))
+	assert.Equal(t, []string{"queue-1"}, selectWrapupcodeAssignmentQueues(queues, wrapupcodeAssignmentQueueSelection{
+		divisionIds: []string{"division-1"},
+		nameRegex:   regexp.MustCompile("^Support "),
+	}), "queues must match every filter")
+	assert.Equal(t, []string{"queue-1", "queue-3", "queue-9"}, selectWrapupcodeAssignmentQueues(queues, wrapupcodeAssignmentQueueSelection{
+		queueIds:    []string{"queue-9"},
+		divisionIds: []string{"division-1"},
+	}), "queues listed by ID are added to the queues matching the filters")
+	assert.Equal(t, []string{"queue-2"}, selectWrapupcodeAssignmentQueues(queues, wrapupcodeAssignmentQueueSelection{
+		queueIds: []string{"queue-2"},
+	}), "queues are not matched by filters when none is set")
+}
+
+func TestUnitPlanWrapupcodeAssignmentChanges(t *testing.T) {
+	currentCodeIds := map[string][]string{
+		"queue-1": {"code-1", "code-2", "code-other"},
+		"queue-2": {"code-1"},
+		"queue-3": {"code-3"},
+	}
+	// queue-2 had code-1 before the assignment, and code-1 was removed from queue-4 outside of Terraform
+	addedPairs := []string{"queue-1:code-1", "queue-1:code-2", "queue-4:code-1"}
+
+	// code-2 is replaced by code-3, and queue-2 is replaced by queue-3
+	changes := planWrapupcodeAssignmentChanges(currentCodeIds, addedPairs,
+		[]string{"queue-1", "queue-3"}, []string{"code-1", "code-3"})
+
+	assert.Equal(t, map[string][]string{
+		"queue-1": {"code-2"},
+	}, changes.codesToRemove, "codes the assignment did not add are kept")
+	for _, codes := range changes.codesToAdd {
+		sort.Strings(codes)
+	}
+	assert.Equal(t, map[string][]string{
+		"queue-1": {"code-3"},
+		"queue-3": {"code-1"},
+	}, changes.codesToAdd)
+	assert.Equal(t, []string{"queue-1:code-1"}, changes.keptPairs, "codes a queue already had are not recorded as added")
+
+	// Removing an assignment only removes the pairs it added that are still assigned
+	changes = planWrapupcodeAssignmentChanges(currentCodeIds, addedPairs, nil, nil)
+	assert.Equal(t, map[string][]string{"queue-1": {"code-1", "code-2"}}, changes.codesToRemove)
+	assert.Empty(t, changes.codesToAdd)
+	assert.Empty(t, changes.keptPairs)
+}
+
+func TestUnitResourceRoutingWrapupcodeAssignment(t *testing.T) {
+	ok := &platformclientv2.APIResponse{StatusCode: http.StatusOK}
+	queues := []platformclientv2.Queue{
+		buildTestAssignmentQueue("queue-1", "Support EMEA", "division-1"),
+		buildTestAssignmentQueue("queue-2", "Support APAC", "division-2"),
+		buildTestAssignmentQueue("queue-3", "Sales EMEA", "division-1"),
+	}
+	queueCodes := map[string][]string{
+		"queue-1": {"code-other"},
+		"queue-2": {"code-1"},
+		"queue-3": {},
+	}
+
+	queueProxy := &RoutingQueueProxy{}
+	queueProxy.GetAllRoutingQueuesAttr = func(ctx context.Context, p *RoutingQueueProxy, name string, hasPeer bool) (*[]platformclientv2.Queue, *platformclientv2.APIResponse, error) {
+		if hasPeer {
+			return &[]platformclientv2.Queue{}, ok, nil
+		}
+		return &queues, ok, nil
+	}
+	queueProxy.getAllRoutingQueueWrapupCodesAttr = func(ctx context.Context, p *RoutingQueueProxy, queueId string) (*[]platformclientv2.Wrapupcode, *platformclientv2.APIResponse, error) {
+		codes := make([]platformclientv2.Wrapupcode, 0)
+		for _, codeId := range queueCodes[queueId] {
+			codes = append(codes, platformclientv2.Wrapupcode{Id: platformclientv2.String(codeId)})
+		}
+		return &codes, ok, nil
+	}
+	queueProxy.createRoutingQueueWrapupCodeAttr = func(ctx context.Context, p *RoutingQueueProxy, queueId string, body []platformclientv2.Wrapupcodereference) ([]platformclientv2.Wrapupcode, *platformclientv2.APIResponse, error) {
+		for _, code := range body {
+			queueCodes[queueId] = append(queueCodes[queueId], *code.Id)
+		}
+		return nil, ok, nil
+	}
+	queueProxy.deleteRoutingQueueWrapupCodeAttr = func(ctx context.Context, p *RoutingQueueProxy, queueId, codeId string) (*platformclientv2.APIResponse, error) {
+		var remaining []string
+		for _, id := range queueCodes[queueId] {
+			if id != codeId {
+				remaining = append(remaining, id)
+			}
+		}
+		queueCodes[queueId] = remaining
+		return ok, nil
+	}
+
+	err := setRoutingQueueUnitTestsEnvVar()
+	if err != nil {
+		t.Skipf("failed to set env variable %s: %s", unitTestsAreActiveEnv, err.Error())
+	}
+	internalProxy = queueProxy
+	defer func() {
+		internalProxy = nil
+		err := unsetRoutingQueueUnitTestsEnvVar()
+		if err != nil {
+			t.Logf("Failed to unset env variable %s: %s", unitTestsAreActiveEnv, err.Error())
+		}
+	}()
+
+	ctx := context.Background()
+	gcloud := &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}
+	d := schema.TestResourceDataRaw(t, ResourceRoutingWrapupcodeAssignment().Schema, map[string]interface{}{
+		"wrapupcode_ids":   []interface{}{"code-1"},
+		"queue_name_regex": "^Support ",
+	})
+
+	diags := createWrapupcodeAssignment(ctx, d, gcloud)
+	assert.False(t, diags.HasError(), diags)
+	assert.ElementsMatch(t, []string{"code-other", "code-1"}, queueCodes["queue-1"])
+	assert.Equal(t, []string{"code-1"}, queueCodes["queue-2"])
+	assert.Empty(t, queueCodes["queue-3"])
+	assert.Equal(t, 2, d.Get("assigned_queue_ids").(*schema.Set).Len())
+	assert.Equal(t, []interface{}{"queue-1:code-1"}, d.Get("added_assignments").(*schema.Set).List(), "queue-2 already had code-1")
+	assert.Equal(t, true, d.Get("assignments_in_sync"))
+
+	// A queue created with a matching name is out of sync until the next apply
+	queues = append(queues, buildTestAssignmentQueue("queue-4", "Support AMER", "division-2"))
+	diags = readWrapupcodeAssignment(ctx, d, gcloud)
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, false, d.Get("assignments_in_sync"))
+
+	diags = deleteWrapupcodeAssignment(ctx, d, gcloud)
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, []string{"code-other"}, queueCodes["queue-1"], "codes assigned some other way are kept")
+	assert.Equal(t, []string{"code-1"}, queueCodes["queue-2"], "codes the queue had before the assignment are kept")
+}
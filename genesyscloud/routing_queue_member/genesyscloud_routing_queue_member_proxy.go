@@ -0,0 +1,188 @@
+package routing_queue_member
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	routingQueue "terraform-provider-genesyscloud/genesyscloud/routing_queue"
+	"time"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v154/platformclientv2"
+)
+
+/*
+The genesyscloud_routing_queue_member_proxy.go file contains the proxy structures and methods that interact
+with the Genesys Cloud SDK. We use composition here for each function on the proxy so individual functions can be stubbed
+out during testing.
+
+The user members of a queue are listed once and cached, so that reading thousands of memberships of the same queue
+costs a single paged listing. Memberships changed by this provider are updated in the cache as they are applied.
+*/
+
+// internalProxy holds a proxy instance that can be used throughout the package
+var internalProxy *routingQueueMemberProxy
+
+// queueMembersCacheTtl is how long a listing of the user members of a queue is used before it is listed again
+const queueMembersCacheTtl = time.Minute
+
+type getRoutingQueueUserMembersFunc func(ctx context.Context, p *routingQueueMemberProxy, queueId string) (*[]platformclientv2.Queuemember, *platformclientv2.APIResponse, error)
+type addOrRemoveRoutingQueueMembersFunc func(ctx context.Context, p *routingQueueMemberProxy, queueId string, body []platformclientv2.Writableentity, delete bool) (*platformclientv2.APIResponse, error)
+type updateRoutingQueueMemberRingNumbersFunc func(ctx context.Context, p *routingQueueMemberProxy, queueId string, body []platformclientv2.Queuemember) (*platformclientv2.APIResponse, error)
+
+// queueMembersCacheEntry holds the ring number of each user member of a queue
+type queueMembersCacheEntry struct {
+	lock     sync.Mutex
+	ringNums map[string]int
+	listedAt time.Time
+}
+
+// routingQueueMemberProxy contains all of the methods that call genesys cloud APIs.
+type routingQueueMemberProxy struct {
+	clientConfig                            *platformclientv2.Configuration
+	routingApi                              *platformclientv2.RoutingApi
+	getRoutingQueueUserMembersAttr          getRoutingQueueUserMembersFunc
+	addOrRemoveRoutingQueueMembersAttr      addOrRemoveRoutingQueueMembersFunc
+	updateRoutingQueueMemberRingNumbersAttr updateRoutingQueueMemberRingNumbersFunc
+
+	membersCache     map[string]*queueMembersCacheEntry
+	membersCacheLock sync.Mutex
+}
+
+// newRoutingQueueMemberProxy initializes the routing queue member proxy with the data needed to communicate with Genesys Cloud
+func newRoutingQueueMemberProxy(clientConfig *platformclientv2.Configuration) *routingQueueMemberProxy {
+	api := platformclientv2.NewRoutingApiWithConfig(clientConfig)
+	return &routingQueueMemberProxy{
+		clientConfig:                            clientConfig,
+		routingApi:                              api,
+		getRoutingQueueUserMembersAttr:          getRoutingQueueUserMembersFn,
+		addOrRemoveRoutingQueueMembersAttr:      addOrRemoveRoutingQueueMembersFn,
+		updateRoutingQueueMemberRingNumbersAttr: updateRoutingQueueMemberRingNumbersFn,
+		membersCache:                            make(map[string]*queueMembersCacheEntry),
+	}
+}
+
+// getRoutingQueueMemberProxy acts as a singleton for the internalProxy. It also ensures
+// that we can still proxy our tests by directly setting internalProxy package variable
+func getRoutingQueueMemberProxy(clientConfig *platformclientv2.Configuration) *routingQueueMemberProxy {
+	if internalProxy == nil {
+		internalProxy = newRoutingQueueMemberProxy(clientConfig)
+	}
+	return internalProxy
+}
+
+// getRoutingQueueMemberRingNum returns the ring number of a user member of a queue, and false if the user is not a member
+func (p *routingQueueMemberProxy) getRoutingQueueMemberRingNum(ctx context.Context, queueId, userId string) (int, bool, *platformclientv2.APIResponse, error) {
+	entry := p.getMembersCacheEntry(queueId)
+	entry.lock.Lock()
+	defer entry.lock.Unlock()
+
+	if entry.ringNums == nil || time.Since(entry.listedAt) > queueMembersCacheTtl {
+		members, resp, err := p.getRoutingQueueUserMembersAttr(ctx, p, queueId)
+		if err != nil {
+			return 0, false, resp, err
+		}
+		entry.ringNums = make(map[string]int, len(*members))
+		for _, member := range *members {
+			if member.Id == nil {
+				continue
+			}
+			ringNum := 1
+			if member.RingNumber != nil {
+				ringNum = *member.RingNumber
+			}
+			entry.ringNums[*member.Id] = ringNum
+		}
+		entry.listedAt = time.Now()
+	}
+
+	ringNum, found := entry.ringNums[userId]
+	return ringNum, found, nil, nil
+}
+
+// addOrRemoveRoutingQueueMembers adds or removes up to 100 user members of a queue
+func (p *routingQueueMemberProxy) addOrRemoveRoutingQueueMembers(ctx context.Context, queueId string, body []platformclientv2.Writableentity, remove bool) (*platformclientv2.APIResponse, error) {
+	resp, err := p.addOrRemoveRoutingQueueMembersAttr(ctx, p, queueId, body, remove)
+	if err != nil {
+		return resp, err
+	}
+	p.updateMembersCache(queueId, func(ringNums map[string]int) {
+		for _, member := range body {
+			if remove {
+				delete(ringNums, *member.Id)
+			} else if _, found := ringNums[*member.Id]; !found {
+				ringNums[*member.Id] = 1
+			}
+		}
+	})
+	return resp, nil
+}
+
+// updateRoutingQueueMemberRingNumbers updates the ring numbers of up to 100 user members of a queue
+func (p *routingQueueMemberProxy) updateRoutingQueueMemberRingNumbers(ctx context.Context, queueId string, body []platformclientv2.Queuemember) (*platformclientv2.APIResponse, error) {
+	resp, err := p.updateRoutingQueueMemberRingNumbersAttr(ctx, p, queueId, body)
+	if err != nil {
+		return resp, err
+	}
+	p.updateMembersCache(queueId, func(ringNums map[string]int) {
+		for _, member := range body {
+			ringNums[*member.Id] = *member.RingNumber
+		}
+	})
+	return resp, nil
+}
+
+func (p *routingQueueMemberProxy) getMembersCacheEntry(queueId string) *queueMembersCacheEntry {
+	p.membersCacheLock.Lock()
+	defer p.membersCacheLock.Unlock()
+
+	entry, ok := p.membersCache[queueId]
+	if !ok {
+		entry = &queueMembersCacheEntry{}
+		p.membersCache[queueId] = entry
+	}
+	return entry
+}
+
+// updateMembersCache applies a change to the cached members of a queue, if they have been listed
+func (p *routingQueueMemberProxy) updateMembersCache(queueId string, update func(ringNums map[string]int)) {
+	entry := p.getMembersCacheEntry(queueId)
+	entry.lock.Lock()
+	defer entry.lock.Unlock()
+
+	if entry.ringNums != nil {
+		update(entry.ringNums)
+	}
+}
+
+// getRoutingQueueUserMembersFn is an implementation function for listing all the user members of a queue
+func getRoutingQueueUserMembersFn(ctx context.Context, p *routingQueueMemberProxy, queueId string) (*[]platformclientv2.Queuemember, *platformclientv2.APIResponse, error) {
+	var members []platformclientv2.Queuemember
+	for pageNum := 1; ; pageNum++ {
+		users, resp, err := routingQueue.SdkGetRoutingQueueMembers(queueId, "user", pageNum, 100, p.clientConfig)
+		if err != nil {
+			return nil, resp, fmt.Errorf("failed to get user members of queue %s: %s", queueId, err)
+		}
+		if users == nil || users.Entities == nil || len(*users.Entities) == 0 {
+			return &members, resp, nil
+		}
+		members = append(members, *users.Entities...)
+	}
+}
+
+// addOrRemoveRoutingQueueMembersFn is an implementation function for adding or removing user members of a queue
+func addOrRemoveRoutingQueueMembersFn(ctx context.Context, p *routingQueueMemberProxy, queueId string, body []platformclientv2.Writableentity, delete bool) (*platformclientv2.APIResponse, error) {
+	resp, err := p.routingApi.PostRoutingQueueMembers(queueId, body, delete)
+	if err != nil {
+		return resp, fmt.Errorf("failed to update members of queue %s: %s", queueId, err)
+	}
+	return resp, nil
+}
+
+// updateRoutingQueueMemberRingNumbersFn is an implementation function for updating the ring numbers of user members of a queue
+func updateRoutingQueueMemberRingNumbersFn(ctx context.Context, p *routingQueueMemberProxy, queueId string, body []platformclientv2.Queuemember) (*platformclientv2.APIResponse, error) {
+	_, resp, err := p.routingApi.PatchRoutingQueueMembers(queueId, body)
+	if err != nil {
+		return resp, fmt.Errorf("failed to update ring numbers of members of queue %s: %s", queueId, err)
+	}
+	return resp, nil
+}
@@ -0,0 +1,126 @@
+package routing_queue_member
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+The resource_genesyscloud_routing_queue_member.go contains all the methods that perform the core logic for the resource.
+Only the membership of the configured user is added, updated or removed; the other members of the queue are left alone.
+*/
+
+func createRoutingQueueMember(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getRoutingQueueMemberProxy(sdkConfig)
+	queueId := d.Get("queue_id").(string)
+	userId := d.Get("user_id").(string)
+
+	log.Printf("Adding user %s to queue %s", userId, queueId)
+	err := memberBatcher.submit(ctx, proxy, queueId, &queueMemberChange{
+		action:  queueMemberAdd,
+		userId:  userId,
+		ringNum: d.Get("ring_num").(int),
+	})
+	if err != nil {
+		return util.BuildDiagnosticError(ResourceType, fmt.Sprintf("failed to add user %s to queue %s", userId, queueId), err)
+	}
+
+	d.SetId(buildQueueMemberId(queueId, userId))
+	log.Printf("Added user %s to queue %s", userId, queueId)
+	return readRoutingQueueMember(ctx, d, meta)
+}
+
+func readRoutingQueueMember(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getRoutingQueueMemberProxy(sdkConfig)
+	queueId, userId, err := splitQueueMemberId(d.Id())
+	if err != nil {
+		return util.BuildDiagnosticError(ResourceType, "failed to read queue member", err)
+	}
+
+	log.Printf("Reading membership of user %s in queue %s", userId, queueId)
+	return util.WithRetriesForRead(ctx, d, func() *retry.RetryError {
+		ringNum, found, resp, getErr := proxy.getRoutingQueueMemberRingNum(ctx, queueId, userId)
+		if getErr != nil {
+			if util.IsStatus404(resp) {
+				return retry.RetryableError(util.BuildWithRetriesApiDiagnosticError(ResourceType, fmt.Sprintf("Failed to read queue %s | error: %s", queueId, getErr), resp))
+			}
+			return retry.NonRetryableError(util.BuildWithRetriesApiDiagnosticError(ResourceType, fmt.Sprintf("Failed to read queue %s | error: %s", queueId, getErr), resp))
+		}
+
+		if !found {
+			log.Printf("User %s is no longer a member of queue %s", userId, queueId)
+			d.SetId("")
+			return nil
+		}
+
+		_ = d.Set("queue_id", queueId)
+		_ = d.Set("user_id", userId)
+		_ = d.Set("ring_num", ringNum)
+
+		log.Printf("Read membership of user %s in queue %s", userId, queueId)
+		return nil
+	})
+}
+
+func updateRoutingQueueMember(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getRoutingQueueMemberProxy(sdkConfig)
+	queueId := d.Get("queue_id").(string)
+	userId := d.Get("user_id").(string)
+
+	log.Printf("Updating ring number of user %s in queue %s", userId, queueId)
+	err := memberBatcher.submit(ctx, proxy, queueId, &queueMemberChange{
+		action:  queueMemberSetRingNum,
+		userId:  userId,
+		ringNum: d.Get("ring_num").(int),
+	})
+	if err != nil {
+		return util.BuildDiagnosticError(ResourceType, fmt.Sprintf("failed to update ring number of user %s in queue %s", userId, queueId), err)
+	}
+
+	log.Printf("Updated ring number of user %s in queue %s", userId, queueId)
+	return readRoutingQueueMember(ctx, d, meta)
+}
+
+func deleteRoutingQueueMember(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getRoutingQueueMemberProxy(sdkConfig)
+	queueId := d.Get("queue_id").(string)
+	userId := d.Get("user_id").(string)
+
+	log.Printf("Removing user %s from queue %s", userId, queueId)
+	err := memberBatcher.submit(ctx, proxy, queueId, &queueMemberChange{
+		action: queueMemberRemove,
+		userId: userId,
+	})
+	if err != nil {
+		if _, found, resp, _ := proxy.getRoutingQueueMemberRingNum(ctx, queueId, userId); util.IsStatus404(resp) || (resp == nil && !found) {
+			log.Printf("User %s is no longer a member of queue %s", userId, queueId)
+			return nil
+		}
+		return util.BuildDiagnosticError(ResourceType, fmt.Sprintf("failed to remove user %s from queue %s", userId, queueId), err)
+	}
+
+	log.Printf("Removed user %s from queue %s", userId, queueId)
+	return nil
+}
+
+// importRoutingQueueMember imports a membership by its <queue_id>:<user_id> ID
+func importRoutingQueueMember(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	queueId, userId, err := splitQueueMemberId(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	_ = d.Set("queue_id", queueId)
+	_ = d.Set("user_id", userId)
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,62 @@
+package routing_queue_member
+
+import (
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+resource_genesyscloud_routing_queue_member_schema.go holds two functions within it:
+
+1.  The registration code that registers the Resource for the package.
+2.  The resource schema definitions for the routing_queue_member resource.
+
+Memberships are exported through the members attribute of genesyscloud_routing_queue, so this resource has no exporter.
+*/
+
+const ResourceType = "genesyscloud_routing_queue_member"
+
+// SetRegistrar registers all the resources, datasources and exporters in the package
+func SetRegistrar(regInstance registrar.Registrar) {
+	regInstance.RegisterResource(ResourceType, ResourceRoutingQueueMember())
+}
+
+// ResourceRoutingQueueMember registers the genesyscloud_routing_queue_member resource with Terraform
+func ResourceRoutingQueueMember() *schema.Resource {
+	return &schema.Resource{
+		Description: `Genesys Cloud Routing Queue Member. Manages the membership of a single user in a queue without affecting the other members of the queue, so that memberships can be managed alongside other tools. Do not set the members attribute of a genesyscloud_routing_queue whose members are managed with this resource. Changes to the memberships of the same queue are applied together in bulk.`,
+
+		CreateContext: provider.CreateWithPooledClient(createRoutingQueueMember),
+		ReadContext:   provider.ReadWithPooledClient(readRoutingQueueMember),
+		UpdateContext: provider.UpdateWithPooledClient(updateRoutingQueueMember),
+		DeleteContext: provider.DeleteWithPooledClient(deleteRoutingQueueMember),
+		Importer: &schema.ResourceImporter{
+			StateContext: importRoutingQueueMember,
+		},
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"queue_id": {
+				Description: "ID of the queue.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"user_id": {
+				Description: "ID of the user.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"ring_num": {
+				Description:  "Ring number between 1 and 6 for this user in the queue.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 6),
+			},
+		},
+	}
+}
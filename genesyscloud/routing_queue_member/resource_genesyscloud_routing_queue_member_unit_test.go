@@ -0,0 +1,180 @@
+package routing_queue_member
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v154/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+// testQueueMembers stubs the member APIs of a queue and records the calls made to them
+type testQueueMembers struct {
+	lock           sync.Mutex
+	ringNums       map[string]int
+	listCalls      int
+	postCallSizes  []int
+	patchCallSizes []int
+	removedUserIds []string
+}
+
+func (q *testQueueMembers) buildProxy() *routingQueueMemberProxy {
+	proxy := newRoutingQueueMemberProxy(&platformclientv2.Configuration{})
+	proxy.getRoutingQueueUserMembersAttr = func(ctx context.Context, p *routingQueueMemberProxy, queueId string) (*[]platformclientv2.Queuemember, *platformclientv2.APIResponse, error) {
+		q.lock.Lock()
+		defer q.lock.Unlock()
+		q.listCalls++
+		members := make([]platformclientv2.Queuemember, 0, len(q.ringNums))
+		for userId, ringNum := range q.ringNums {
+			members = append(members, platformclientv2.Queuemember{Id: platformclientv2.String(userId), RingNumber: platformclientv2.Int(ringNum)})
+		}
+		return &members, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	proxy.addOrRemoveRoutingQueueMembersAttr = func(ctx context.Context, p *routingQueueMemberProxy, queueId string, body []platformclientv2.Writableentity, remove bool) (*platformclientv2.APIResponse, error) {
+		q.lock.Lock()
+		defer q.lock.Unlock()
+		q.postCallSizes = append(q.postCallSizes, len(body))
+		for _, member := range body {
+			if remove {
+				delete(q.ringNums, *member.Id)
+				q.removedUserIds = append(q.removedUserIds, *member.Id)
+			} else if _, found := q.ringNums[*member.Id]; !found {
+				q.ringNums[*member.Id] = 1
+			}
+		}
+		return &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	proxy.updateRoutingQueueMemberRingNumbersAttr = func(ctx context.Context, p *routingQueueMemberProxy, queueId string, body []platformclientv2.Queuemember) (*platformclientv2.APIResponse, error) {
+		q.lock.Lock()
+		defer q.lock.Unlock()
+		q.patchCallSizes = append(q.patchCallSizes, len(body))
+		for _, member := range body {
+			q.ringNums[*member.Id] = *member.RingNumber
+		}
+		return &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	return proxy
+}
+
+func TestUnitRoutingQueueMemberCreateBatched(t *testing.T) {
+	queue := &testQueueMembers{ringNums: map[string]int{"existing-user": 2}}
+	internalProxy = queue.buildProxy()
+	defer func() { internalProxy = nil }()
+
+	meta := &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}
+	memberCount := 150
+
+	var wg sync.WaitGroup
+	resources := make([]*schema.ResourceData, memberCount)
+	for i := 0; i < memberCount; i++ {
+		ringNum := 1
+		if i == 0 {
+			ringNum = 3
+		}
+		resources[i] = schema.TestResourceDataRaw(t, ResourceRoutingQueueMember().Schema, map[string]interface{}{
+			"queue_id": "queue-1",
+			"user_id":  fmt.Sprintf("user-%d", i),
+			"ring_num": ringNum,
+		})
+		wg.Add(1)
+		go func(d *schema.ResourceData) {
+			defer wg.Done()
+			diags := createRoutingQueueMember(context.Background(), d, meta)
+			assert.False(t, diags.HasError(), diags)
+		}(resources[i])
+	}
+	wg.Wait()
+
+	// The memberships are added with a few bulk calls instead of one call each
+	assert.LessOrEqual(t, len(queue.postCallSizes), 4)
+	assert.Equal(t, []int{1}, queue.patchCallSizes)
+	assert.Equal(t, memberCount+1, len(queue.ringNums))
+	assert.Equal(t, 2, queue.ringNums["existing-user"], "members managed elsewhere are left alone")
+	assert.Equal(t, 3, queue.ringNums["user-0"])
+
+	// The members of the queue are listed once for all the reads
+	assert.Equal(t, 1, queue.listCalls)
+	assert.Equal(t, "queue-1:user-0", resources[0].Id())
+	assert.Equal(t, 3, resources[0].Get("ring_num"))
+	assert.Equal(t, 1, resources[1].Get("ring_num"))
+}
+
+func TestUnitRoutingQueueMemberUpdateAndDelete(t *testing.T) {
+	queue := &testQueueMembers{ringNums: map[string]int{"user-1": 1, "user-2": 4}}
+	internalProxy = queue.buildProxy()
+	defer func() { internalProxy = nil }()
+
+	meta := &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}
+	d := schema.TestResourceDataRaw(t, ResourceRoutingQueueMember().Schema, map[string]interface{}{
+		"queue_id": "queue-1",
+		"user_id":  "user-1",
+		"ring_num": 5,
+	})
+	d.SetId(buildQueueMemberId("queue-1", "user-1"))
+
+	diags := updateRoutingQueueMember(context.Background(), d, meta)
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, 5, queue.ringNums["user-1"])
+	assert.Empty(t, queue.postCallSizes)
+
+	diags = deleteRoutingQueueMember(context.Background(), d, meta)
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, []string{"user-1"}, queue.removedUserIds)
+	assert.Equal(t, map[string]int{"user-2": 4}, queue.ringNums)
+
+	// A membership removed outside of Terraform is removed from the state
+	diags = readRoutingQueueMember(context.Background(), d, meta)
+	assert.False(t, diags.HasError(), diags)
+	assert.Empty(t, d.Id())
+}
+
+func TestUnitRoutingQueueMemberChunkErrors(t *testing.T) {
+	queue := &testQueueMembers{ringNums: map[string]int{"user-removed": 1}}
+	proxy := queue.buildProxy()
+	addOrRemove := proxy.addOrRemoveRoutingQueueMembersAttr
+	proxy.addOrRemoveRoutingQueueMembersAttr = func(ctx context.Context, p *routingQueueMemberProxy, queueId string, body []platformclientv2.Writableentity, remove bool) (*platformclientv2.APIResponse, error) {
+		// The second chunk of additions fails
+		if !remove && *body[0].Id == fmt.Sprintf("user-%d", queueMemberBatchSize) {
+			return &platformclientv2.APIResponse{StatusCode: http.StatusBadRequest}, assert.AnError
+		}
+		return addOrRemove(ctx, p, queueId, body, remove)
+	}
+
+	var changes []*queueMemberChange
+	for i := 0; i < queueMemberBatchSize+10; i++ {
+		changes = append(changes, &queueMemberChange{action: queueMemberAdd, userId: fmt.Sprintf("user-%d", i), ringNum: 2})
+	}
+	removal := &queueMemberChange{action: queueMemberRemove, userId: "user-removed"}
+	changes = append(changes, removal)
+
+	errs := applyQueueMemberChanges(context.Background(), proxy, "queue-1", changes)
+
+	// Only the changes of the failed chunk report its error, and their ring numbers are not set
+	assert.NoError(t, errs[changes[0]])
+	assert.NoError(t, errs[removal])
+	assert.Equal(t, 2, queue.ringNums["user-0"])
+	assert.ErrorIs(t, errs[changes[queueMemberBatchSize]], assert.AnError)
+	assert.ErrorIs(t, errs[changes[queueMemberBatchSize+9]], assert.AnError)
+	assert.Len(t, errs, 10)
+	assert.Equal(t, []int{queueMemberBatchSize}, queue.patchCallSizes)
+	assert.NotContains(t, queue.ringNums, "user-removed")
+}
+
+func TestUnitRoutingQueueMemberImport(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, ResourceRoutingQueueMember().Schema, map[string]interface{}{})
+	d.SetId("queue-1:user-1")
+
+	resources, err := importRoutingQueueMember(context.Background(), d, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "queue-1", resources[0].Get("queue_id"))
+	assert.Equal(t, "user-1", resources[0].Get("user_id"))
+
+	d.SetId("queue-1")
+	_, err = importRoutingQueueMember(context.Background(), d, nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,194 @@
+package routing_queue_member
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	chunksProcess "terraform-provider-genesyscloud/genesyscloud/util/chunks"
+	"time"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v154/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_routing_queue_member_utils.go file contains the helpers of the routing_queue_member resource.
+
+Terraform creates, updates and deletes each membership separately. Changes to the memberships of the same queue that
+arrive within a short window are batched together, so that they are applied with a few bulk calls of up to 100 members
+each instead of one call per membership.
+*/
+
+const (
+	// queueMemberBatchWindow is how long a batch collects changes before it is applied
+	queueMemberBatchWindow = 500 * time.Millisecond
+	// queueMemberBatchSize is the number of members the bulk member APIs accept per call
+	queueMemberBatchSize = 100
+)
+
+type queueMemberAction int
+
+const (
+	queueMemberAdd queueMemberAction = iota
+	queueMemberRemove
+	queueMemberSetRingNum
+)
+
+// queueMemberChange is a change to the membership of a user, waiting in a batch to be applied
+type queueMemberChange struct {
+	action  queueMemberAction
+	userId  string
+	ringNum int
+	result  chan error
+}
+
+// queueMemberBatch holds the changes to the memberships of a queue that will be applied together
+type queueMemberBatch struct {
+	proxy   *routingQueueMemberProxy
+	changes []*queueMemberChange
+}
+
+// queueMemberBatcher batches the membership changes of each queue
+type queueMemberBatcher struct {
+	lock    sync.Mutex
+	window  time.Duration
+	pending map[string]*queueMemberBatch
+}
+
+var memberBatcher = newQueueMemberBatcher(queueMemberBatchWindow)
+
+func newQueueMemberBatcher(window time.Duration) *queueMemberBatcher {
+	return &queueMemberBatcher{
+		window:  window,
+		pending: make(map[string]*queueMemberBatch),
+	}
+}
+
+// submit adds the change to the pending batch of the queue and waits until the batch is applied
+func (b *queueMemberBatcher) submit(ctx context.Context, proxy *routingQueueMemberProxy, queueId string, change *queueMemberChange) error {
+	change.result = make(chan error, 1)
+
+	b.lock.Lock()
+	batch, ok := b.pending[queueId]
+	if !ok {
+		batch = &queueMemberBatch{proxy: proxy}
+		b.pending[queueId] = batch
+		time.AfterFunc(b.window, func() { b.flush(queueId, batch) })
+	}
+	batch.changes = append(batch.changes, change)
+	full := len(batch.changes) >= queueMemberBatchSize
+	b.lock.Unlock()
+
+	if full {
+		go b.flush(queueId, batch)
+	}
+
+	select {
+	case err := <-change.result:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// flush applies the batch, unless it has already been applied, and reports its result to each change in it
+func (b *queueMemberBatcher) flush(queueId string, batch *queueMemberBatch) {
+	b.lock.Lock()
+	if b.pending[queueId] != batch {
+		b.lock.Unlock()
+		return
+	}
+	delete(b.pending, queueId)
+	b.lock.Unlock()
+
+	log.Printf("Applying %d membership changes to queue %s", len(batch.changes), queueId)
+	errs := applyQueueMemberChanges(context.Background(), batch.proxy, queueId, batch.changes)
+	for _, change := range batch.changes {
+		change.result <- errs[change]
+	}
+}
+
+// applyQueueMemberChanges removes and adds the members and then sets their ring numbers, in chunks of up to 100 members.
+// A chunk that fails does not stop the other chunks, and its error is only returned for the changes in that chunk.
+func applyQueueMemberChanges(ctx context.Context, proxy *routingQueueMemberProxy, queueId string, changes []*queueMemberChange) map[*queueMemberChange]error {
+	var (
+		errs      = make(map[*queueMemberChange]error)
+		removals  []*queueMemberChange
+		additions []*queueMemberChange
+	)
+	for _, change := range changes {
+		switch change.action {
+		case queueMemberRemove:
+			removals = append(removals, change)
+		case queueMemberAdd:
+			additions = append(additions, change)
+		}
+	}
+
+	for _, chunk := range chunksProcess.ChunkBy(removals, queueMemberBatchSize) {
+		setQueueMemberChunkError(errs, chunk, postQueueMembers(ctx, proxy, queueId, chunk, true))
+	}
+	for _, chunk := range chunksProcess.ChunkBy(additions, queueMemberBatchSize) {
+		setQueueMemberChunkError(errs, chunk, postQueueMembers(ctx, proxy, queueId, chunk, false))
+	}
+
+	var ringNumChanges []*queueMemberChange
+	for _, change := range changes {
+		switch {
+		case change.action == queueMemberSetRingNum:
+			ringNumChanges = append(ringNumChanges, change)
+		case change.action == queueMemberAdd && errs[change] == nil && change.ringNum != 1:
+			// Members are added with the default ring number of 1
+			ringNumChanges = append(ringNumChanges, change)
+		}
+	}
+	if len(ringNumChanges) == 0 {
+		return errs
+	}
+	for _, chunk := range chunksProcess.ChunkBy(ringNumChanges, queueMemberBatchSize) {
+		members := make([]platformclientv2.Queuemember, 0, len(chunk))
+		for _, change := range chunk {
+			members = append(members, platformclientv2.Queuemember{
+				Id:         platformclientv2.String(change.userId),
+				RingNumber: platformclientv2.Int(change.ringNum),
+			})
+		}
+		_, err := proxy.updateRoutingQueueMemberRingNumbers(ctx, queueId, members)
+		setQueueMemberChunkError(errs, chunk, err)
+	}
+	return errs
+}
+
+func postQueueMembers(ctx context.Context, proxy *routingQueueMemberProxy, queueId string, chunk []*queueMemberChange, remove bool) error {
+	if len(chunk) == 0 {
+		return nil
+	}
+	members := make([]platformclientv2.Writableentity, 0, len(chunk))
+	for _, change := range chunk {
+		members = append(members, platformclientv2.Writableentity{Id: platformclientv2.String(change.userId)})
+	}
+	_, err := proxy.addOrRemoveRoutingQueueMembers(ctx, queueId, members, remove)
+	return err
+}
+
+func setQueueMemberChunkError(errs map[*queueMemberChange]error, chunk []*queueMemberChange, err error) {
+	if err == nil {
+		return
+	}
+	for _, change := range chunk {
+		errs[change] = err
+	}
+}
+
+func buildQueueMemberId(queueId, userId string) string {
+	return fmt.Sprintf("%s:%s", queueId, userId)
+}
+
+func splitQueueMemberId(id string) (string, string, error) {
+	split := strings.SplitN(id, ":", 2)
+	if len(split) != 2 || split[0] == "" || split[1] == "" {
+		return "", "", fmt.Errorf("invalid queue member ID %q, expected <queue_id>:<user_id>", id)
+	}
+	return split[0], split[1], nil
+}
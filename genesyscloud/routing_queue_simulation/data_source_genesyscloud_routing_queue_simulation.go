@@ -0,0 +1,229 @@
+package routing_queue_simulation
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v154/platformclientv2"
+)
+
+/*
+   The data_source_genesyscloud_routing_queue_simulation.go contains the data source implementation
+   for simulating which members of a queue are eligible for an interaction.
+*/
+
+// dataSourceRoutingQueueSimulationRead reads the routing configuration and members of the queue and simulates the interaction
+func dataSourceRoutingQueueSimulationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getRoutingQueueSimulationProxy(sdkConfig)
+	queueId := d.Get("queue_id").(string)
+
+	queue, resp, err := proxy.getRoutingQueue(ctx, queueId)
+	if err != nil {
+		return util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("failed to read queue %s | error: %s", queueId, err), resp)
+	}
+
+	queueMembers, resp, err := proxy.getRoutingQueueMembers(ctx, queueId)
+	if err != nil {
+		return util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("failed to read members of queue %s | error: %s", queueId, err), resp)
+	}
+
+	groupUsers := make(map[string][]string)
+	for _, group := range collectMemberGroups(queue) {
+		userIds, resp, err := proxy.getMemberGroupUserIds(ctx, *group.Id, *group.VarType)
+		if err != nil {
+			if util.IsStatus404(resp) {
+				log.Printf("%s %s of queue %s no longer exists, it has no members", *group.VarType, *group.Id, queueId)
+				continue
+			}
+			return util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("failed to read members of %s %s | error: %s", *group.VarType, *group.Id, err), resp)
+		}
+		groupUsers[*group.Id] = userIds
+	}
+
+	skillIds := *lists.SetToStringList(d.Get("skill_ids").(*schema.Set))
+	languageIds := *lists.SetToStringList(d.Get("language_ids").(*schema.Set))
+	sort.Strings(skillIds)
+	sort.Strings(languageIds)
+
+	simulation := &queueSimulation{
+		queue:           queue,
+		members:         buildSimulatedMembers(*queueMembers),
+		groupUsers:      groupUsers,
+		skillIds:        skillIds,
+		languageIds:     languageIds,
+		preferredAgents: buildPreferredAgents(d.Get("preferred_agents").(*schema.Set)),
+		queueMetrics:    buildQueueMetrics(d.Get("queue_metrics").(*schema.Set)),
+		elapsedSeconds:  d.Get("elapsed_seconds").(float64),
+	}
+	result := simulation.simulate()
+
+	id, _ := json.Marshal([]interface{}{queueId, simulation.skillIds, simulation.languageIds, simulation.preferredAgents, simulation.queueMetrics, simulation.elapsedSeconds})
+	d.SetId(fmt.Sprintf("%x", sha256.Sum256(id)))
+
+	skillEvaluationMethod := skillEvaluationAll
+	if queue.SkillEvaluationMethod != nil {
+		skillEvaluationMethod = *queue.SkillEvaluationMethod
+	}
+	_ = d.Set("skill_evaluation_method", skillEvaluationMethod)
+	_ = d.Set("routing_method", result.routingMethod)
+	_ = d.Set("preferred_agent_steps", flattenPreferredAgentSteps(result.preferredAgentSteps))
+	_ = d.Set("bullseye_rings", flattenRings(result.rings))
+	_ = d.Set("conditional_group_steps", flattenConditionalGroupSteps(result.conditionalGroupSteps))
+	_ = d.Set("current_stage", result.currentStage)
+
+	currentStep := &simulationStep{}
+	if result.currentStep != nil {
+		currentStep = result.currentStep
+	}
+	_ = d.Set("current_step_number", currentStep.number)
+	_ = d.Set("eligible_user_ids", currentStep.eligibleUserIds)
+	_ = d.Set("best_match_user_ids", currentStep.bestMatchUserIds)
+	_ = d.Set("eligible_group_ids", currentStep.groupIds)
+	return nil
+}
+
+// collectMemberGroups returns the member groups of the queue, of its bullseye rings and of its conditional group routing rules
+func collectMemberGroups(queue *platformclientv2.Queue) []platformclientv2.Membergroup {
+	var groups []platformclientv2.Membergroup
+	seen := make(map[string]bool)
+	add := func(memberGroups *[]platformclientv2.Membergroup) {
+		if memberGroups == nil {
+			return
+		}
+		for _, group := range *memberGroups {
+			if group.Id == nil || group.VarType == nil || seen[*group.Id] {
+				continue
+			}
+			seen[*group.Id] = true
+			groups = append(groups, group)
+		}
+	}
+
+	add(queue.MemberGroups)
+	if queue.Bullseye != nil && queue.Bullseye.Rings != nil {
+		for _, ring := range *queue.Bullseye.Rings {
+			add(ring.MemberGroups)
+		}
+	}
+	if queue.ConditionalGroupRouting != nil && queue.ConditionalGroupRouting.Rules != nil {
+		for _, rule := range *queue.ConditionalGroupRouting.Rules {
+			add(rule.Groups)
+		}
+	}
+	return groups
+}
+
+// buildSimulatedMembers maps the joined members of the queue to their ring number, skills and languages
+func buildSimulatedMembers(queueMembers []platformclientv2.Queuemember) map[string]*simulatedMember {
+	members := make(map[string]*simulatedMember, len(queueMembers))
+	for _, queueMember := range queueMembers {
+		if queueMember.Id == nil {
+			continue
+		}
+		member := &simulatedMember{
+			skills:    make(map[string]float64),
+			languages: make(map[string]float64),
+		}
+		if queueMember.MemberBy == nil || *queueMember.MemberBy == "user" {
+			member.ringNumber = 1
+			if queueMember.RingNumber != nil {
+				member.ringNumber = *queueMember.RingNumber
+			}
+		}
+		if queueMember.User != nil && queueMember.User.Skills != nil {
+			for _, skill := range *queueMember.User.Skills {
+				if skill.Id != nil && skill.Proficiency != nil {
+					member.skills[*skill.Id] = *skill.Proficiency
+				}
+			}
+		}
+		if queueMember.User != nil && queueMember.User.Languages != nil {
+			for _, language := range *queueMember.User.Languages {
+				if language.Id != nil && language.Proficiency != nil {
+					member.languages[*language.Id] = *language.Proficiency
+				}
+			}
+		}
+		members[*queueMember.Id] = member
+	}
+	return members
+}
+
+func buildPreferredAgents(preferredAgents *schema.Set) map[string]int {
+	scores := make(map[string]int)
+	for _, agent := range preferredAgents.List() {
+		agentMap := agent.(map[string]interface{})
+		scores[agentMap["user_id"].(string)] = agentMap["score"].(int)
+	}
+	return scores
+}
+
+func buildQueueMetrics(queueMetrics *schema.Set) map[string]map[string]float64 {
+	metrics := make(map[string]map[string]float64)
+	for _, metric := range queueMetrics.List() {
+		metricMap := metric.(map[string]interface{})
+		queueId := metricMap["queue_id"].(string)
+		if metrics[queueId] == nil {
+			metrics[queueId] = make(map[string]float64)
+		}
+		metrics[queueId][metricMap["metric"].(string)] = metricMap["value"].(float64)
+	}
+	return metrics
+}
+
+func flattenPreferredAgentSteps(steps []simulationStep) []interface{} {
+	flattened := make([]interface{}, 0, len(steps))
+	for _, step := range steps {
+		flattened = append(flattened, map[string]interface{}{
+			"rule_number":       step.number,
+			"starts_at_seconds": step.startsAtSeconds,
+			"reached":           step.reached,
+			"eligible_user_ids": step.eligibleUserIds,
+		})
+	}
+	return flattened
+}
+
+func flattenRings(steps []simulationStep) []interface{} {
+	flattened := make([]interface{}, 0, len(steps))
+	for _, step := range steps {
+		flattened = append(flattened, map[string]interface{}{
+			"ring_number":         step.number,
+			"starts_at_seconds":   step.startsAtSeconds,
+			"reached":             step.reached,
+			"required_skill_ids":  step.requiredSkillIds,
+			"group_ids":           step.groupIds,
+			"eligible_user_ids":   step.eligibleUserIds,
+			"best_match_user_ids": step.bestMatchUserIds,
+		})
+	}
+	return flattened
+}
+
+func flattenConditionalGroupSteps(steps []simulationStep) []interface{} {
+	flattened := make([]interface{}, 0, len(steps))
+	for _, step := range steps {
+		flattened = append(flattened, map[string]interface{}{
+			"rule_number":         step.number,
+			"queue_id":            step.queueId,
+			"metric":              step.metric,
+			"condition_met":       step.conditionMet,
+			"starts_at_seconds":   step.startsAtSeconds,
+			"reached":             step.reached,
+			"group_ids":           step.groupIds,
+			"eligible_user_ids":   step.eligibleUserIds,
+			"best_match_user_ids": step.bestMatchUserIds,
+		})
+	}
+	return flattened
+}
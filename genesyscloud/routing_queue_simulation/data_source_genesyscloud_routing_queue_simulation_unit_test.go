@@ -0,0 +1,192 @@
+package routing_queue_simulation
+
+import (
+	"context"
+	"net/http"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v154/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func buildTestMember(id, memberBy string, ringNumber int, skillIds ...string) platformclientv2.Queuemember {
+	skills := make([]platformclientv2.Userroutingskill, 0, len(skillIds))
+	for _, skillId := range skillIds {
+		skills = append(skills, platformclientv2.Userroutingskill{Id: platformclientv2.String(skillId), Proficiency: platformclientv2.Float64(3)})
+	}
+	return platformclientv2.Queuemember{
+		Id:         platformclientv2.String(id),
+		MemberBy:   platformclientv2.String(memberBy),
+		RingNumber: platformclientv2.Int(ringNumber),
+		User:       &platformclientv2.User{Id: platformclientv2.String(id), Skills: &skills},
+	}
+}
+
+func buildTestMemberGroups(groupIds ...string) *[]platformclientv2.Membergroup {
+	groups := make([]platformclientv2.Membergroup, 0, len(groupIds))
+	for _, groupId := range groupIds {
+		groups = append(groups, platformclientv2.Membergroup{Id: platformclientv2.String(groupId), VarType: platformclientv2.String(memberGroupTypeGroup)})
+	}
+	return &groups
+}
+
+func runTestSimulation(t *testing.T, queue *platformclientv2.Queue, members []platformclientv2.Queuemember, groupUsers map[string][]string, config map[string]interface{}) *schema.ResourceData {
+	ok := &platformclientv2.APIResponse{StatusCode: http.StatusOK}
+	testProxy := &routingQueueSimulationProxy{}
+	testProxy.getRoutingQueueAttr = func(ctx context.Context, p *routingQueueSimulationProxy, queueId string) (*platformclientv2.Queue, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, *queue.Id, queueId)
+		return queue, ok, nil
+	}
+	testProxy.getRoutingQueueMembersAttr = func(ctx context.Context, p *routingQueueSimulationProxy, queueId string) (*[]platformclientv2.Queuemember, *platformclientv2.APIResponse, error) {
+		return &members, ok, nil
+	}
+	testProxy.getMemberGroupUserIdsAttr = func(ctx context.Context, p *routingQueueSimulationProxy, groupId, groupType string) ([]string, *platformclientv2.APIResponse, error) {
+		userIds, found := groupUsers[groupId]
+		if !found {
+			return nil, &platformclientv2.APIResponse{StatusCode: http.StatusNotFound}, assert.AnError
+		}
+		return userIds, ok, nil
+	}
+	internalProxy = testProxy
+	t.Cleanup(func() { internalProxy = nil })
+
+	config["queue_id"] = *queue.Id
+	d := schema.TestResourceDataRaw(t, DataSourceRoutingQueueSimulation().Schema, config)
+	diags := dataSourceRoutingQueueSimulationRead(context.Background(), d, &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}})
+	assert.False(t, diags.HasError(), diags)
+	return d
+}
+
+func TestUnitDataSourceRoutingQueueSimulationBullseye(t *testing.T) {
+	timeout := "TIMEOUT_SECONDS"
+	queue := &platformclientv2.Queue{
+		Id:                    platformclientv2.String("queue-1"),
+		SkillEvaluationMethod: platformclientv2.String(skillEvaluationAll),
+		MemberGroups:          buildTestMemberGroups("group-1", "group-2"),
+		RoutingRules: &[]platformclientv2.Routingrule{
+			{Operator: platformclientv2.String("MEETS_THRESHOLD"), Threshold: platformclientv2.Int(50), WaitSeconds: platformclientv2.Float64(10)},
+		},
+		Bullseye: &platformclientv2.Bullseye{Rings: &[]platformclientv2.Ring{
+			{
+				ExpansionCriteria: &[]platformclientv2.Expansioncriterium{{VarType: &timeout, Threshold: platformclientv2.Float64(20)}},
+				Actions:           &platformclientv2.Actions{SkillsToRemove: &[]platformclientv2.Skillstoremove{{Id: platformclientv2.String("skill-2")}}},
+			},
+			{
+				ExpansionCriteria: &[]platformclientv2.Expansioncriterium{{VarType: &timeout, Threshold: platformclientv2.Float64(30)}},
+				MemberGroups:      buildTestMemberGroups("group-2"),
+			},
+			{ExpansionCriteria: &[]platformclientv2.Expansioncriterium{{VarType: &timeout, Threshold: platformclientv2.Float64(2)}}},
+		}},
+	}
+	members := []platformclientv2.Queuemember{
+		buildTestMember("user-1", "user", 1, "skill-1", "skill-2"),
+		buildTestMember("user-2", "user", 1, "skill-1"),
+		buildTestMember("user-3", "user", 2, "skill-1"),
+		buildTestMember("user-4", "group", 1, "skill-1", "skill-2"),
+		buildTestMember("user-5", "group", 1, "skill-1"),
+	}
+	groupUsers := map[string][]string{
+		"group-1": {"user-4"},
+		"group-2": {"user-5"},
+	}
+
+	d := runTestSimulation(t, queue, members, groupUsers, map[string]interface{}{
+		"skill_ids":       []interface{}{"skill-1", "skill-2"},
+		"elapsed_seconds": 35.0,
+		"preferred_agents": []interface{}{
+			map[string]interface{}{"user_id": "user-2", "score": 80},
+			map[string]interface{}{"user_id": "user-3", "score": 20},
+			map[string]interface{}{"user_id": "not-a-member", "score": 90},
+		},
+	})
+
+	assert.Equal(t, stageBullseye, d.Get("routing_method"))
+	assert.Equal(t, []interface{}{"user-2"}, d.Get("preferred_agent_steps.0.eligible_user_ids"))
+	assert.Equal(t, 3, d.Get("bullseye_rings.#"))
+
+	assert.Equal(t, 10.0, d.Get("bullseye_rings.0.starts_at_seconds"))
+	assert.Equal(t, []interface{}{"user-1", "user-4"}, d.Get("bullseye_rings.0.eligible_user_ids"))
+	assert.Equal(t, []interface{}{"group-1"}, d.Get("bullseye_rings.0.group_ids"))
+
+	// skill-2 is removed when leaving ring 1, and ring 2 adds user-3 and the members of group-2
+	assert.Equal(t, 30.0, d.Get("bullseye_rings.1.starts_at_seconds"))
+	assert.Equal(t, []interface{}{"skill-1"}, d.Get("bullseye_rings.1.required_skill_ids"))
+	assert.Equal(t, []interface{}{"user-1", "user-2", "user-3", "user-4", "user-5"}, d.Get("bullseye_rings.1.eligible_user_ids"))
+	assert.Equal(t, []interface{}{"group-1", "group-2"}, d.Get("bullseye_rings.1.group_ids"))
+	assert.Equal(t, false, d.Get("bullseye_rings.2.reached"))
+
+	assert.Equal(t, stageBullseye, d.Get("current_stage"))
+	assert.Equal(t, 2, d.Get("current_step_number"))
+	assert.Equal(t, d.Get("bullseye_rings.1.eligible_user_ids"), d.Get("eligible_user_ids"))
+
+	// With the BEST method every member considered is eligible, and the best matches have all the required skills
+	queue.SkillEvaluationMethod = platformclientv2.String(skillEvaluationBest)
+	d = runTestSimulation(t, queue, members, groupUsers, map[string]interface{}{
+		"skill_ids": []interface{}{"skill-1", "skill-2"},
+	})
+	assert.Equal(t, 1, d.Get("current_step_number"))
+	assert.Equal(t, []interface{}{"user-1", "user-2", "user-4"}, d.Get("eligible_user_ids"))
+	assert.Equal(t, []interface{}{"user-1", "user-4"}, d.Get("best_match_user_ids"))
+}
+
+func TestUnitDataSourceRoutingQueueSimulationConditionalGroups(t *testing.T) {
+	queue := &platformclientv2.Queue{
+		Id:                    platformclientv2.String("queue-1"),
+		SkillEvaluationMethod: platformclientv2.String(skillEvaluationNone),
+		MemberGroups:          buildTestMemberGroups("group-1", "group-2", "group-3"),
+		ConditionalGroupRouting: &platformclientv2.Conditionalgrouprouting{Rules: &[]platformclientv2.Conditionalgrouproutingrule{
+			{
+				Operator:       platformclientv2.String("GreaterThan"),
+				ConditionValue: platformclientv2.Float64(0),
+				Groups:         buildTestMemberGroups("group-1"),
+				WaitSeconds:    platformclientv2.Int(10),
+			},
+			{
+				Queue:          &platformclientv2.Domainentityref{Id: platformclientv2.String("queue-2")},
+				Metric:         platformclientv2.String("ServiceLevel"),
+				Operator:       platformclientv2.String("LessThan"),
+				ConditionValue: platformclientv2.Float64(0.8),
+				Groups:         buildTestMemberGroups("group-2"),
+				WaitSeconds:    platformclientv2.Int(15),
+			},
+			{
+				Operator:       platformclientv2.String("GreaterThan"),
+				ConditionValue: platformclientv2.Float64(60),
+				Groups:         buildTestMemberGroups("group-3"),
+			},
+		}},
+	}
+	members := []platformclientv2.Queuemember{
+		buildTestMember("user-1", "group", 1),
+		buildTestMember("user-2", "group", 1),
+		buildTestMember("user-3", "group", 1),
+		buildTestMember("user-4", "user", 1),
+	}
+	groupUsers := map[string][]string{
+		"group-1": {"user-1"},
+		"group-2": {"user-2"},
+		"group-3": {"user-3"},
+	}
+
+	// The service level of queue-2 is above the condition, so rule 2 activates no group and rule 3 is evaluated at once
+	d := runTestSimulation(t, queue, members, groupUsers, map[string]interface{}{
+		"elapsed_seconds": 12.0,
+		"queue_metrics": []interface{}{
+			map[string]interface{}{"queue_id": "queue-2", "metric": "ServiceLevel", "value": 0.9},
+		},
+	})
+	assert.Equal(t, stageConditionalGroup, d.Get("routing_method"))
+	assert.Equal(t, 0, d.Get("bullseye_rings.#"))
+	assert.Equal(t, true, d.Get("conditional_group_steps.0.condition_met"))
+	assert.Equal(t, "queue-1", d.Get("conditional_group_steps.0.queue_id"))
+	assert.Equal(t, false, d.Get("conditional_group_steps.1.condition_met"))
+	assert.Equal(t, "queue-2", d.Get("conditional_group_steps.1.queue_id"))
+	assert.Equal(t, 10.0, d.Get("conditional_group_steps.2.starts_at_seconds"))
+	assert.Equal(t, true, d.Get("conditional_group_steps.2.condition_met"), "a metric with no value is assumed to meet the condition")
+
+	assert.Equal(t, 3, d.Get("current_step_number"))
+	assert.Equal(t, []interface{}{"group-1", "group-3"}, d.Get("eligible_group_ids"))
+	assert.Equal(t, []interface{}{"user-1", "user-3"}, d.Get("eligible_user_ids"), "only the members of activated groups are considered")
+}
@@ -0,0 +1,170 @@
+package routing_queue_simulation
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v154/platformclientv2"
+)
+
+/*
+The genesyscloud_routing_queue_simulation_proxy.go file contains the proxy structures and methods that interact
+with the Genesys Cloud SDK. We use composition here for each function on the proxy so individual functions can be stubbed
+out during testing.
+*/
+
+// internalProxy holds a proxy instance that can be used throughout the package
+var internalProxy *routingQueueSimulationProxy
+
+// Type definitions for each func on our proxy so we can easily mock them out later
+type getRoutingQueueFunc func(ctx context.Context, p *routingQueueSimulationProxy, queueId string) (*platformclientv2.Queue, *platformclientv2.APIResponse, error)
+type getRoutingQueueMembersFunc func(ctx context.Context, p *routingQueueSimulationProxy, queueId string) (*[]platformclientv2.Queuemember, *platformclientv2.APIResponse, error)
+type getMemberGroupUserIdsFunc func(ctx context.Context, p *routingQueueSimulationProxy, groupId, groupType string) ([]string, *platformclientv2.APIResponse, error)
+
+// routingQueueSimulationProxy contains all of the methods that call genesys cloud APIs.
+type routingQueueSimulationProxy struct {
+	clientConfig               *platformclientv2.Configuration
+	routingApi                 *platformclientv2.RoutingApi
+	groupsApi                  *platformclientv2.GroupsApi
+	teamsApi                   *platformclientv2.TeamsApi
+	getRoutingQueueAttr        getRoutingQueueFunc
+	getRoutingQueueMembersAttr getRoutingQueueMembersFunc
+	getMemberGroupUserIdsAttr  getMemberGroupUserIdsFunc
+}
+
+// newRoutingQueueSimulationProxy initializes the routing queue simulation proxy with all of the data needed to communicate with Genesys Cloud
+func newRoutingQueueSimulationProxy(clientConfig *platformclientv2.Configuration) *routingQueueSimulationProxy {
+	return &routingQueueSimulationProxy{
+		clientConfig:               clientConfig,
+		routingApi:                 platformclientv2.NewRoutingApiWithConfig(clientConfig),
+		groupsApi:                  platformclientv2.NewGroupsApiWithConfig(clientConfig),
+		teamsApi:                   platformclientv2.NewTeamsApiWithConfig(clientConfig),
+		getRoutingQueueAttr:        getRoutingQueueFn,
+		getRoutingQueueMembersAttr: getRoutingQueueMembersFn,
+		getMemberGroupUserIdsAttr:  getMemberGroupUserIdsFn,
+	}
+}
+
+// getRoutingQueueSimulationProxy acts as a singleton to for the internalProxy.  It also ensures
+// that we can still proxy our tests by directly setting internalProxy package variable
+func getRoutingQueueSimulationProxy(clientConfig *platformclientv2.Configuration) *routingQueueSimulationProxy {
+	if internalProxy == nil {
+		internalProxy = newRoutingQueueSimulationProxy(clientConfig)
+	}
+	return internalProxy
+}
+
+// getRoutingQueue returns a queue with its bullseye rings, routing rules and conditional group routing rules
+func (p *routingQueueSimulationProxy) getRoutingQueue(ctx context.Context, queueId string) (*platformclientv2.Queue, *platformclientv2.APIResponse, error) {
+	return p.getRoutingQueueAttr(ctx, p, queueId)
+}
+
+// getRoutingQueueMembers returns the joined members of a queue with their routing skills and languages
+func (p *routingQueueSimulationProxy) getRoutingQueueMembers(ctx context.Context, queueId string) (*[]platformclientv2.Queuemember, *platformclientv2.APIResponse, error) {
+	return p.getRoutingQueueMembersAttr(ctx, p, queueId)
+}
+
+// getMemberGroupUserIds returns the IDs of the users of a group, team or skill group
+func (p *routingQueueSimulationProxy) getMemberGroupUserIds(ctx context.Context, groupId, groupType string) ([]string, *platformclientv2.APIResponse, error) {
+	return p.getMemberGroupUserIdsAttr(ctx, p, groupId, groupType)
+}
+
+// getRoutingQueueFn is an implementation function for reading a queue
+func getRoutingQueueFn(_ context.Context, p *routingQueueSimulationProxy, queueId string) (*platformclientv2.Queue, *platformclientv2.APIResponse, error) {
+	queue, resp, err := p.routingApi.GetRoutingQueue(queueId)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to get queue %s: %s", queueId, err)
+	}
+	return queue, resp, nil
+}
+
+// getRoutingQueueMembersFn is an implementation function for reading the joined members of a queue
+func getRoutingQueueMembersFn(_ context.Context, p *routingQueueSimulationProxy, queueId string) (*[]platformclientv2.Queuemember, *platformclientv2.APIResponse, error) {
+	const pageSize = 100
+	var (
+		members []platformclientv2.Queuemember
+		resp    *platformclientv2.APIResponse
+	)
+	for pageNum := 1; ; pageNum++ {
+		page, apiResp, err := p.routingApi.GetRoutingQueueMembers(queueId, pageNum, pageSize, "", []string{"skills", "languages"}, "", nil, nil, nil, nil, nil, "", true)
+		resp = apiResp
+		if err != nil {
+			return nil, resp, fmt.Errorf("failed to get members of queue %s: %s", queueId, err)
+		}
+		if page.Entities == nil || len(*page.Entities) == 0 {
+			break
+		}
+		members = append(members, *page.Entities...)
+		if page.NextUri == nil || *page.NextUri == "" {
+			break
+		}
+	}
+	return &members, resp, nil
+}
+
+// getMemberGroupUserIdsFn is an implementation function for reading the users of a group, team or skill group
+func getMemberGroupUserIdsFn(_ context.Context, p *routingQueueSimulationProxy, groupId, groupType string) ([]string, *platformclientv2.APIResponse, error) {
+	const pageSize = 100
+	var userIds []string
+
+	if groupType == memberGroupTypeGroup {
+		members, resp, err := p.groupsApi.GetGroupIndividuals(groupId)
+		if err != nil {
+			return nil, resp, fmt.Errorf("failed to get members of group %s: %s", groupId, err)
+		}
+		if members.Entities != nil {
+			for _, member := range *members.Entities {
+				userIds = append(userIds, *member.Id)
+			}
+		}
+		return userIds, resp, nil
+	}
+
+	var (
+		after string
+		resp  *platformclientv2.APIResponse
+	)
+	for {
+		var (
+			entityIds []string
+			nextUri   *string
+			err       error
+		)
+		if groupType == memberGroupTypeTeam {
+			var members *platformclientv2.Teammemberentitylisting
+			members, resp, err = p.teamsApi.GetTeamMembers(groupId, pageSize, "", after, "")
+			if err == nil && members.Entities != nil {
+				for _, member := range *members.Entities {
+					entityIds = append(entityIds, *member.Id)
+				}
+				nextUri = members.NextUri
+			}
+		} else {
+			var members *platformclientv2.Skillgroupmemberentitylisting
+			members, resp, err = p.routingApi.GetRoutingSkillgroupMembers(groupId, pageSize, after, "", "")
+			if err == nil && members.Entities != nil {
+				for _, member := range *members.Entities {
+					entityIds = append(entityIds, *member.Id)
+				}
+				nextUri = members.NextUri
+			}
+		}
+		if err != nil {
+			return nil, resp, fmt.Errorf("failed to get members of %s %s: %s", groupType, groupId, err)
+		}
+		userIds = append(userIds, entityIds...)
+
+		if len(entityIds) == 0 || nextUri == nil || *nextUri == "" {
+			break
+		}
+		after, err = util.GetQueryParamValueFromUri(*nextUri, "after")
+		if err != nil {
+			return nil, resp, fmt.Errorf("unable to parse after cursor from members next uri: %v", err)
+		}
+		if after == "" {
+			break
+		}
+	}
+	return userIds, resp, nil
+}
@@ -0,0 +1,286 @@
+package routing_queue_simulation
+
+import (
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+genesyscloud_routing_queue_simulation_schema.go holds two functions within it:
+
+1.  The registration code that registers the Datasource for the package.
+2.  The datasource schema definitions for the routing_queue_simulation datasource.
+*/
+const ResourceType = "genesyscloud_routing_queue_simulation"
+
+// SetRegistrar registers all of the resources, datasources and exporters in the package
+func SetRegistrar(regInstance registrar.Registrar) {
+	regInstance.RegisterDataSource(ResourceType, DataSourceRoutingQueueSimulation())
+}
+
+var (
+	preferredAgentResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"user_id": {
+				Description: "ID of the preferred agent.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"score": {
+				Description:  "Score of the preferred agent, compared with the threshold of the routing rules.",
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+		},
+	}
+
+	queueMetricResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"queue_id": {
+				Description: "ID of the queue the metric is measured on.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"metric": {
+				Description:  "The queue metric. Valid values: EstimatedWaitTime, ServiceLevel.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"EstimatedWaitTime", "ServiceLevel"}, false),
+			},
+			"value": {
+				Description: "The value of the metric, compared with the condition value of the conditional group routing rules.",
+				Type:        schema.TypeFloat,
+				Required:    true,
+			},
+		},
+	}
+
+	preferredAgentStepResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"rule_number": {
+				Description: "Number of the routing rule, starting at 1.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"starts_at_seconds": {
+				Description: "Seconds the interaction has waited when the rule starts.",
+				Type:        schema.TypeFloat,
+				Computed:    true,
+			},
+			"reached": {
+				Description: "Whether the rule has started after elapsed_seconds.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"eligible_user_ids": {
+				Description: "IDs of the preferred agents that are members of the queue and match the rule.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+
+	ringResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"ring_number": {
+				Description: "Number of the bullseye ring, starting at 1. The last ring is the default ring of the queue.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"starts_at_seconds": {
+				Description: "Seconds the interaction has waited when the ring starts.",
+				Type:        schema.TypeFloat,
+				Computed:    true,
+			},
+			"reached": {
+				Description: "Whether the ring has started after elapsed_seconds.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"required_skill_ids": {
+				Description: "IDs of the required skills left once the skills to remove of the previous rings are removed.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"group_ids": {
+				Description: "IDs of the member groups considered in the ring.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"eligible_user_ids": {
+				Description: "IDs of the users eligible in the ring.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"best_match_user_ids": {
+				Description: "IDs of the eligible users with the most required skills and languages.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+
+	conditionalGroupStepResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"rule_number": {
+				Description: "Number of the conditional group routing rule, starting at 1.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"queue_id": {
+				Description: "ID of the queue whose metric the rule evaluates.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"metric": {
+				Description: "The queue metric the rule evaluates.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"condition_met": {
+				Description: "Whether the rule evaluates as true. A rule is assumed to evaluate as true when queue_metrics has no value for its queue and metric.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"starts_at_seconds": {
+				Description: "Seconds the interaction has waited when the rule is evaluated.",
+				Type:        schema.TypeFloat,
+				Computed:    true,
+			},
+			"reached": {
+				Description: "Whether the rule has been evaluated after elapsed_seconds.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"group_ids": {
+				Description: "IDs of the groups activated by this rule and the rules before it.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"eligible_user_ids": {
+				Description: "IDs of the users eligible once the rule is evaluated.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"best_match_user_ids": {
+				Description: "IDs of the eligible users with the most required skills and languages.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+)
+
+// DataSourceRoutingQueueSimulation registers the genesyscloud_routing_queue_simulation data source
+func DataSourceRoutingQueueSimulation() *schema.Resource {
+	return &schema.Resource{
+		Description: `Simulates locally which members of a queue are eligible for an interaction, at each routing rule, bullseye ring and conditional group routing rule, and after the interaction has waited elapsed_seconds. The simulation uses the routing configuration of the queue, its joined members, the members of its groups, teams and skill groups and the routing skills and languages of its members, so that routing design can be asserted in CI. Agent availability, utilization and live queue metrics are not simulated. Routing rules only apply when preferred_agents is set, and run before the bullseye rings or conditional group routing rules. With conditional group routing, only the members of the activated groups are considered.`,
+		ReadContext: provider.ReadWithPooledClient(dataSourceRoutingQueueSimulationRead),
+		Schema: map[string]*schema.Schema{
+			"queue_id": {
+				Description: "ID of the queue.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"skill_ids": {
+				Description: "IDs of the routing skills the interaction requires.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"language_ids": {
+				Description: "IDs of the routing languages the interaction requires.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"elapsed_seconds": {
+				Description:  "Seconds the interaction has waited in the queue. Defaults to 0.",
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.FloatAtLeast(0),
+			},
+			"preferred_agents": {
+				Description: "The preferred agents of the interaction, matched by the routing rules of the queue.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        preferredAgentResource,
+			},
+			"queue_metrics": {
+				Description: "The metric values the conditional group routing rules are evaluated against.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        queueMetricResource,
+			},
+			"skill_evaluation_method": {
+				Description: "The skill evaluation method of the queue (NONE | BEST | ALL).",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"routing_method": {
+				Description: "How the queue widens the members considered over time: bullseye or conditional_group.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"preferred_agent_steps": {
+				Description: "The result of each routing rule of the queue. Empty when preferred_agents is not set.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        preferredAgentStepResource,
+			},
+			"bullseye_rings": {
+				Description: "The result of each bullseye ring of the queue. Empty when routing_method is conditional_group.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        ringResource,
+			},
+			"conditional_group_steps": {
+				Description: "The result of each conditional group routing rule of the queue. Empty when routing_method is bullseye.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        conditionalGroupStepResource,
+			},
+			"current_stage": {
+				Description: "The stage the interaction is in after elapsed_seconds: preferred_agents, bullseye or conditional_group.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"current_step_number": {
+				Description: "The number of the routing rule, bullseye ring or conditional group routing rule the interaction is in after elapsed_seconds.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"eligible_user_ids": {
+				Description: "IDs of the users eligible after elapsed_seconds.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"best_match_user_ids": {
+				Description: "IDs of the users eligible after elapsed_seconds with the most required skills and languages.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"eligible_group_ids": {
+				Description: "IDs of the member groups considered after elapsed_seconds.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
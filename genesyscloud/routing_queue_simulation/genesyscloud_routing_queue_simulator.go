@@ -0,0 +1,380 @@
+package routing_queue_simulation
+
+import (
+	"math"
+	"sort"
+	"terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v154/platformclientv2"
+)
+
+/*
+The genesyscloud_routing_queue_simulator.go file works out locally which members of a queue are eligible for an interaction
+as it waits in the queue. It follows the routing configuration of the queue in the order the routing engine applies it:
+
+ 1. The routing rules route to the preferred agents of the interaction, one rule after the other.
+ 2. Then either the conditional group routing rules activate groups one rule after the other, or, when the queue has no
+    conditional group routing rules, the bullseye rings widen the members considered one ring after the other.
+ 3. At every ring and conditional group step, the skill evaluation method of the queue decides which of the members
+    considered are eligible given the skills and languages the interaction requires.
+
+Only memberships and skills are simulated: agent availability, utilization and live queue metrics are not.
+*/
+
+const (
+	memberGroupTypeGroup      = "GROUP"
+	memberGroupTypeTeam       = "TEAM"
+	memberGroupTypeSkillGroup = "SKILLGROUP"
+
+	skillEvaluationAll  = "ALL"
+	skillEvaluationBest = "BEST"
+	skillEvaluationNone = "NONE"
+
+	stagePreferredAgents  = "preferred_agents"
+	stageBullseye         = "bullseye"
+	stageConditionalGroup = "conditional_group"
+
+	expansionTypeTimeout   = "TIMEOUT_SECONDS"
+	routingRuleOperatorAny = "ANY"
+
+	// The default wait of a routing rule and of a conditional group routing rule when not set
+	defaultRoutingRuleWaitSeconds = 5
+	defaultConditionalWaitSeconds = 2
+)
+
+// simulatedMember is a joined member of the queue
+type simulatedMember struct {
+	// ringNumber is the ring of a user added to the queue directly, 0 for users only added through a group
+	ringNumber int
+	skills     map[string]float64
+	languages  map[string]float64
+}
+
+// queueSimulation holds the configuration of a queue and the interaction it routes
+type queueSimulation struct {
+	queue           *platformclientv2.Queue
+	members         map[string]*simulatedMember
+	groupUsers      map[string][]string
+	skillIds        []string
+	languageIds     []string
+	preferredAgents map[string]int
+	// queueMetrics holds the value of each metric of each queue, keyed by queue ID then metric
+	queueMetrics   map[string]map[string]float64
+	elapsedSeconds float64
+}
+
+// simulationStep is a routing rule, bullseye ring or conditional group routing rule of the simulation
+type simulationStep struct {
+	number           int
+	startsAtSeconds  float64
+	reached          bool
+	queueId          string
+	metric           string
+	conditionMet     bool
+	requiredSkillIds []string
+	groupIds         []string
+	eligibleUserIds  []string
+	bestMatchUserIds []string
+}
+
+// simulationResult is the outcome of a simulation
+type simulationResult struct {
+	routingMethod         string
+	preferredAgentSteps   []simulationStep
+	rings                 []simulationStep
+	conditionalGroupSteps []simulationStep
+	currentStage          string
+	currentStep           *simulationStep
+}
+
+// simulate works out the eligible members of every step and the step the interaction is in after elapsedSeconds
+func (s *queueSimulation) simulate() *simulationResult {
+	result := &simulationResult{}
+
+	// Preferred agent routing happens before the bullseye rings or conditional groups are considered
+	offset := 0.0
+	if len(s.preferredAgents) > 0 && s.queue.RoutingRules != nil {
+		for i, rule := range *s.queue.RoutingRules {
+			step := simulationStep{
+				number:          i + 1,
+				startsAtSeconds: offset,
+				reached:         s.elapsedSeconds >= offset,
+				eligibleUserIds: s.preferredAgentIds(rule),
+			}
+			step.bestMatchUserIds = step.eligibleUserIds
+			result.preferredAgentSteps = append(result.preferredAgentSteps, step)
+
+			waitSeconds := float64(defaultRoutingRuleWaitSeconds)
+			if rule.WaitSeconds != nil {
+				waitSeconds = *rule.WaitSeconds
+			}
+			offset += waitSeconds
+		}
+	}
+
+	queueElapsedSeconds := s.elapsedSeconds - offset
+	if s.queue.ConditionalGroupRouting != nil && s.queue.ConditionalGroupRouting.Rules != nil && len(*s.queue.ConditionalGroupRouting.Rules) > 0 {
+		result.routingMethod = stageConditionalGroup
+		result.conditionalGroupSteps = s.simulateConditionalGroups(offset, queueElapsedSeconds)
+	} else {
+		result.routingMethod = stageBullseye
+		result.rings = s.simulateBullseye(offset, queueElapsedSeconds)
+	}
+
+	for _, stage := range []struct {
+		name  string
+		steps []simulationStep
+	}{
+		{stagePreferredAgents, result.preferredAgentSteps},
+		{result.routingMethod, result.rings},
+		{result.routingMethod, result.conditionalGroupSteps},
+	} {
+		for i := range stage.steps {
+			if stage.steps[i].reached {
+				result.currentStage = stage.name
+				result.currentStep = &stage.steps[i]
+			}
+		}
+	}
+	return result
+}
+
+// preferredAgentIds returns the preferred agents of the interaction that are members of the queue and match the routing rule
+func (s *queueSimulation) preferredAgentIds(rule platformclientv2.Routingrule) []string {
+	userIds := make([]string, 0)
+	for userId, score := range s.preferredAgents {
+		if _, isMember := s.members[userId]; !isMember {
+			continue
+		}
+		if (rule.Operator != nil && *rule.Operator == routingRuleOperatorAny) || rule.Threshold == nil || score >= *rule.Threshold {
+			userIds = append(userIds, userId)
+		}
+	}
+	sort.Strings(userIds)
+	return userIds
+}
+
+// simulateBullseye works out the members considered at each bullseye ring. A ring adds the users added to the queue with
+// its ring number and the members of its member groups, and the skills of the rings before it are removed.
+func (s *queueSimulation) simulateBullseye(offset, elapsedSeconds float64) []simulationStep {
+	var rings []platformclientv2.Ring
+	if s.queue.Bullseye != nil && s.queue.Bullseye.Rings != nil {
+		rings = *s.queue.Bullseye.Rings
+	}
+	if len(rings) == 0 {
+		rings = []platformclientv2.Ring{{}}
+	}
+
+	// The member groups of the queue that are not in any ring are considered from the first ring
+	groupRings := make(map[string]int)
+	for i, ring := range rings {
+		if ring.MemberGroups == nil {
+			continue
+		}
+		for _, group := range *ring.MemberGroups {
+			if _, found := groupRings[*group.Id]; !found {
+				groupRings[*group.Id] = i + 1
+			}
+		}
+	}
+	if s.queue.MemberGroups != nil {
+		for _, group := range *s.queue.MemberGroups {
+			if _, found := groupRings[*group.Id]; !found {
+				groupRings[*group.Id] = 1
+			}
+		}
+	}
+
+	userRings := make(map[string]int, len(s.members))
+	for userId, member := range s.members {
+		ringNumber := math.MaxInt
+		if member.ringNumber > 0 {
+			ringNumber = min(member.ringNumber, len(rings))
+		}
+		for groupId, groupRing := range groupRings {
+			if lists.ItemInSlice(userId, s.groupUsers[groupId]) {
+				ringNumber = min(ringNumber, groupRing)
+			}
+		}
+		if ringNumber == math.MaxInt {
+			ringNumber = 1
+		}
+		userRings[userId] = ringNumber
+	}
+
+	steps := make([]simulationStep, 0, len(rings))
+	startsAt := 0.0
+	removedSkills := make(map[string]bool)
+	for i, ring := range rings {
+		number := i + 1
+		step := simulationStep{
+			number:           number,
+			startsAtSeconds:  offset + startsAt,
+			reached:          elapsedSeconds >= startsAt,
+			requiredSkillIds: make([]string, 0),
+			groupIds:         make([]string, 0),
+		}
+		for _, skillId := range s.skillIds {
+			if !removedSkills[skillId] {
+				step.requiredSkillIds = append(step.requiredSkillIds, skillId)
+			}
+		}
+		for groupId, groupRing := range groupRings {
+			if groupRing <= number {
+				step.groupIds = append(step.groupIds, groupId)
+			}
+		}
+		sort.Strings(step.groupIds)
+
+		candidates := make([]string, 0)
+		for userId, userRing := range userRings {
+			if userRing <= number {
+				candidates = append(candidates, userId)
+			}
+		}
+		step.eligibleUserIds, step.bestMatchUserIds = s.evaluateSkills(candidates, step.requiredSkillIds)
+		steps = append(steps, step)
+
+		// The skills to remove and the expansion timeout apply when the interaction leaves the ring
+		if ring.Actions != nil && ring.Actions.SkillsToRemove != nil {
+			for _, skill := range *ring.Actions.SkillsToRemove {
+				removedSkills[*skill.Id] = true
+			}
+		}
+		if ring.ExpansionCriteria != nil {
+			for _, criteria := range *ring.ExpansionCriteria {
+				if criteria.VarType != nil && *criteria.VarType == expansionTypeTimeout && criteria.Threshold != nil {
+					startsAt += *criteria.Threshold
+					break
+				}
+			}
+		}
+	}
+	return steps
+}
+
+// simulateConditionalGroups works out the groups activated at each conditional group routing rule. A rule that evaluates
+// as true activates its groups and waits before the next rule is evaluated; a rule that evaluates as false moves on to the
+// next rule at once. Activated groups stay active, and only their members are considered.
+func (s *queueSimulation) simulateConditionalGroups(offset, elapsedSeconds float64) []simulationStep {
+	rules := *s.queue.ConditionalGroupRouting.Rules
+	steps := make([]simulationStep, 0, len(rules))
+	startsAt := 0.0
+	activeGroups := make(map[string]bool)
+	for i, rule := range rules {
+		step := simulationStep{
+			number:           i + 1,
+			startsAtSeconds:  offset + startsAt,
+			reached:          elapsedSeconds >= startsAt,
+			queueId:          *s.queue.Id,
+			metric:           "EstimatedWaitTime",
+			requiredSkillIds: s.skillIds,
+			groupIds:         make([]string, 0),
+		}
+		// The first rule always evaluates the queue itself
+		if i > 0 && rule.Queue != nil && rule.Queue.Id != nil {
+			step.queueId = *rule.Queue.Id
+		}
+		if rule.Metric != nil {
+			step.metric = *rule.Metric
+		}
+		step.conditionMet = s.evaluateCondition(step.queueId, step.metric, rule.Operator, rule.ConditionValue)
+
+		if step.conditionMet {
+			if rule.Groups != nil {
+				for _, group := range *rule.Groups {
+					activeGroups[*group.Id] = true
+				}
+			}
+			waitSeconds := defaultConditionalWaitSeconds
+			if rule.WaitSeconds != nil {
+				waitSeconds = *rule.WaitSeconds
+			}
+			startsAt += float64(waitSeconds)
+		}
+
+		candidates := make([]string, 0)
+		for groupId := range activeGroups {
+			step.groupIds = append(step.groupIds, groupId)
+			for _, userId := range s.groupUsers[groupId] {
+				if _, isMember := s.members[userId]; isMember && !lists.ItemInSlice(userId, candidates) {
+					candidates = append(candidates, userId)
+				}
+			}
+		}
+		sort.Strings(step.groupIds)
+		step.eligibleUserIds, step.bestMatchUserIds = s.evaluateSkills(candidates, step.requiredSkillIds)
+		steps = append(steps, step)
+	}
+	return steps
+}
+
+// evaluateCondition compares the metric of the queue with the condition value. A metric with no value given is assumed to
+// meet the condition.
+func (s *queueSimulation) evaluateCondition(queueId, metric string, operator *string, conditionValue *float64) bool {
+	value, found := s.queueMetrics[queueId][metric]
+	if !found || operator == nil || conditionValue == nil {
+		return true
+	}
+	switch *operator {
+	case "GreaterThan":
+		return value > *conditionValue
+	case "GreaterThanOrEqualTo":
+		return value >= *conditionValue
+	case "LessThan":
+		return value < *conditionValue
+	case "LessThanOrEqualTo":
+		return value <= *conditionValue
+	}
+	return true
+}
+
+// evaluateSkills applies the skill evaluation method of the queue to the members considered. It returns the eligible users
+// and, among them, the users with the most required skills and languages.
+func (s *queueSimulation) evaluateSkills(candidates []string, skillIds []string) ([]string, []string) {
+	method := skillEvaluationAll
+	if s.queue.SkillEvaluationMethod != nil {
+		method = *s.queue.SkillEvaluationMethod
+	}
+	required := len(skillIds) + len(s.languageIds)
+
+	matches := make(map[string]int, len(candidates))
+	bestMatch := 0
+	for _, userId := range candidates {
+		member := s.members[userId]
+		for _, skillId := range skillIds {
+			if _, hasSkill := member.skills[skillId]; hasSkill {
+				matches[userId]++
+			}
+		}
+		for _, languageId := range s.languageIds {
+			if _, hasLanguage := member.languages[languageId]; hasLanguage {
+				matches[userId]++
+			}
+		}
+		bestMatch = max(bestMatch, matches[userId])
+	}
+
+	eligible := make([]string, 0, len(candidates))
+	best := make([]string, 0, len(candidates))
+	for _, userId := range candidates {
+		switch method {
+		case skillEvaluationNone:
+			eligible = append(eligible, userId)
+			best = append(best, userId)
+		case skillEvaluationBest:
+			eligible = append(eligible, userId)
+			if matches[userId] == bestMatch {
+				best = append(best, userId)
+			}
+		default:
+			if matches[userId] == required {
+				eligible = append(eligible, userId)
+				best = append(best, userId)
+			}
+		}
+	}
+	sort.Strings(eligible)
+	sort.Strings(best)
+	return eligible, best
+}
@@ -0,0 +1,230 @@
+package routing_skill_policy
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v154/platformclientv2"
+)
+
+/*
+The genesyscloud_routing_skill_policy_proxy.go file contains the proxy structures and methods that interact
+with the Genesys Cloud SDK. We use composition here for each function on the proxy so individual functions can be stubbed
+out during testing.
+*/
+
+// internalProxy holds a proxy instance that can be used throughout the package
+var internalProxy *routingSkillPolicyProxy
+
+type getGroupMemberIdsFunc func(ctx context.Context, p *routingSkillPolicyProxy, groupId string) ([]string, *platformclientv2.APIResponse, error)
+type getTeamMemberIdsFunc func(ctx context.Context, p *routingSkillPolicyProxy, teamId string) ([]string, *platformclientv2.APIResponse, error)
+type searchUserIdsFunc func(ctx context.Context, p *routingSkillPolicyProxy, departments []string, titles []string) ([]string, *platformclientv2.APIResponse, error)
+type getUserRoutingSkillsFunc func(ctx context.Context, p *routingSkillPolicyProxy, userId string) (*[]platformclientv2.Userroutingskill, *platformclientv2.APIResponse, error)
+type updateUserRoutingSkillsFunc func(ctx context.Context, p *routingSkillPolicyProxy, userId string, skills []platformclientv2.Userroutingskillpost) (*platformclientv2.APIResponse, error)
+type deleteUserRoutingSkillFunc func(ctx context.Context, p *routingSkillPolicyProxy, userId string, skillId string) (*platformclientv2.APIResponse, error)
+
+// routingSkillPolicyProxy contains all of the methods that call genesys cloud APIs.
+type routingSkillPolicyProxy struct {
+	clientConfig                *platformclientv2.Configuration
+	usersApi                    *platformclientv2.UsersApi
+	groupsApi                   *platformclientv2.GroupsApi
+	teamsApi                    *platformclientv2.TeamsApi
+	getGroupMemberIdsAttr       getGroupMemberIdsFunc
+	getTeamMemberIdsAttr        getTeamMemberIdsFunc
+	searchUserIdsAttr           searchUserIdsFunc
+	getUserRoutingSkillsAttr    getUserRoutingSkillsFunc
+	updateUserRoutingSkillsAttr updateUserRoutingSkillsFunc
+	deleteUserRoutingSkillAttr  deleteUserRoutingSkillFunc
+}
+
+// newRoutingSkillPolicyProxy initializes the routing skill policy proxy with the data needed to communicate with Genesys Cloud
+func newRoutingSkillPolicyProxy(clientConfig *platformclientv2.Configuration) *routingSkillPolicyProxy {
+	return &routingSkillPolicyProxy{
+		clientConfig:                clientConfig,
+		usersApi:                    platformclientv2.NewUsersApiWithConfig(clientConfig),
+		groupsApi:                   platformclientv2.NewGroupsApiWithConfig(clientConfig),
+		teamsApi:                    platformclientv2.NewTeamsApiWithConfig(clientConfig),
+		getGroupMemberIdsAttr:       getGroupMemberIdsFn,
+		getTeamMemberIdsAttr:        getTeamMemberIdsFn,
+		searchUserIdsAttr:           searchUserIdsFn,
+		getUserRoutingSkillsAttr:    getUserRoutingSkillsFn,
+		updateUserRoutingSkillsAttr: updateUserRoutingSkillsFn,
+		deleteUserRoutingSkillAttr:  deleteUserRoutingSkillFn,
+	}
+}
+
+// getRoutingSkillPolicyProxy acts as a singleton for the internalProxy. It also ensures
+// that we can still proxy our tests by directly setting internalProxy package variable
+func getRoutingSkillPolicyProxy(clientConfig *platformclientv2.Configuration) *routingSkillPolicyProxy {
+	if internalProxy == nil {
+		internalProxy = newRoutingSkillPolicyProxy(clientConfig)
+	}
+	return internalProxy
+}
+
+// getGroupMemberIds returns the IDs of the individual members of a group
+func (p *routingSkillPolicyProxy) getGroupMemberIds(ctx context.Context, groupId string) ([]string, *platformclientv2.APIResponse, error) {
+	return p.getGroupMemberIdsAttr(ctx, p, groupId)
+}
+
+// getTeamMemberIds returns the IDs of the members of a team
+func (p *routingSkillPolicyProxy) getTeamMemberIds(ctx context.Context, teamId string) ([]string, *platformclientv2.APIResponse, error) {
+	return p.getTeamMemberIdsAttr(ctx, p, teamId)
+}
+
+// searchUserIds returns the IDs of the active users in one of the departments and with one of the titles
+func (p *routingSkillPolicyProxy) searchUserIds(ctx context.Context, departments []string, titles []string) ([]string, *platformclientv2.APIResponse, error) {
+	return p.searchUserIdsAttr(ctx, p, departments, titles)
+}
+
+// getUserRoutingSkills returns all the routing skills of a user
+func (p *routingSkillPolicyProxy) getUserRoutingSkills(ctx context.Context, userId string) (*[]platformclientv2.Userroutingskill, *platformclientv2.APIResponse, error) {
+	return p.getUserRoutingSkillsAttr(ctx, p, userId)
+}
+
+// updateUserRoutingSkills adds or updates up to 50 routing skills of a user
+func (p *routingSkillPolicyProxy) updateUserRoutingSkills(ctx context.Context, userId string, skills []platformclientv2.Userroutingskillpost) (*platformclientv2.APIResponse, error) {
+	return p.updateUserRoutingSkillsAttr(ctx, p, userId, skills)
+}
+
+// deleteUserRoutingSkill removes a routing skill from a user
+func (p *routingSkillPolicyProxy) deleteUserRoutingSkill(ctx context.Context, userId string, skillId string) (*platformclientv2.APIResponse, error) {
+	return p.deleteUserRoutingSkillAttr(ctx, p, userId, skillId)
+}
+
+// getGroupMemberIdsFn is an implementation function for getting the individual members of a group
+func getGroupMemberIdsFn(ctx context.Context, p *routingSkillPolicyProxy, groupId string) ([]string, *platformclientv2.APIResponse, error) {
+	members, resp, err := p.groupsApi.GetGroupIndividuals(groupId)
+	if err != nil {
+		return nil, resp, fmt.Errorf("failed to get members of group %s: %s", groupId, err)
+	}
+	var memberIds []string
+	if members.Entities != nil {
+		for _, member := range *members.Entities {
+			memberIds = append(memberIds, *member.Id)
+		}
+	}
+	return memberIds, resp, nil
+}
+
+// getTeamMemberIdsFn is an implementation function for getting the members of a team
+func getTeamMemberIdsFn(ctx context.Context, p *routingSkillPolicyProxy, teamId string) ([]string, *platformclientv2.APIResponse, error) {
+	const pageSize = 100
+	var (
+		memberIds []string
+		after     string
+		resp      *platformclientv2.APIResponse
+	)
+	for {
+		members, apiResp, err := p.teamsApi.GetTeamMembers(teamId, pageSize, "", after, "")
+		resp = apiResp
+		if err != nil {
+			return nil, resp, fmt.Errorf("failed to get members of team %s: %s", teamId, err)
+		}
+		if members.Entities == nil || len(*members.Entities) == 0 {
+			break
+		}
+		for _, member := range *members.Entities {
+			memberIds = append(memberIds, *member.Id)
+		}
+		if members.NextUri == nil || *members.NextUri == "" {
+			break
+		}
+		after, err = util.GetQueryParamValueFromUri(*members.NextUri, "after")
+		if err != nil {
+			return nil, resp, fmt.Errorf("unable to parse after cursor from members next uri: %v", err)
+		}
+		if after == "" {
+			break
+		}
+	}
+	return memberIds, resp, nil
+}
+
+// searchUserIdsFn is an implementation function for searching the active users by department and title
+func searchUserIdsFn(ctx context.Context, p *routingSkillPolicyProxy, departments []string, titles []string) ([]string, *platformclientv2.APIResponse, error) {
+	const pageSize = 100
+	exactType := "EXACT"
+	query := []platformclientv2.Usersearchcriteria{
+		{
+			Fields:  &[]string{"state"},
+			Values:  &[]string{"active"},
+			VarType: &exactType,
+		},
+	}
+	if len(departments) > 0 {
+		query = append(query, platformclientv2.Usersearchcriteria{
+			Fields:  &[]string{"department"},
+			Values:  &departments,
+			VarType: &exactType,
+		})
+	}
+	if len(titles) > 0 {
+		query = append(query, platformclientv2.Usersearchcriteria{
+			Fields:  &[]string{"title"},
+			Values:  &titles,
+			VarType: &exactType,
+		})
+	}
+
+	var (
+		userIds []string
+		resp    *platformclientv2.APIResponse
+	)
+	for pageNum := 1; ; pageNum++ {
+		results, apiResp, err := p.usersApi.PostUsersSearch(platformclientv2.Usersearchrequest{
+			PageSize:   platformclientv2.Int(pageSize),
+			PageNumber: platformclientv2.Int(pageNum),
+			Query:      &query,
+		})
+		resp = apiResp
+		if err != nil {
+			return nil, resp, fmt.Errorf("failed to search users: %s", err)
+		}
+		if results.Results == nil || len(*results.Results) == 0 {
+			break
+		}
+		for _, user := range *results.Results {
+			userIds = append(userIds, *user.Id)
+		}
+		if results.PageCount == nil || pageNum >= *results.PageCount {
+			break
+		}
+	}
+	return userIds, resp, nil
+}
+
+// getUserRoutingSkillsFn is an implementation function for getting all the routing skills of a user
+func getUserRoutingSkillsFn(ctx context.Context, p *routingSkillPolicyProxy, userId string) (*[]platformclientv2.Userroutingskill, *platformclientv2.APIResponse, error) {
+	const pageSize = 50
+	var skills []platformclientv2.Userroutingskill
+	for pageNum := 1; ; pageNum++ {
+		page, resp, err := p.usersApi.GetUserRoutingskills(userId, pageSize, pageNum, "")
+		if err != nil {
+			return nil, resp, fmt.Errorf("failed to get routing skills of user %s: %s", userId, err)
+		}
+		if page == nil || page.Entities == nil || len(*page.Entities) == 0 {
+			return &skills, resp, nil
+		}
+		skills = append(skills, *page.Entities...)
+	}
+}
+
+// updateUserRoutingSkillsFn is an implementation function for adding or updating routing skills of a user
+func updateUserRoutingSkillsFn(ctx context.Context, p *routingSkillPolicyProxy, userId string, skills []platformclientv2.Userroutingskillpost) (*platformclientv2.APIResponse, error) {
+	_, resp, err := p.usersApi.PatchUserRoutingskillsBulk(userId, skills)
+	if err != nil {
+		return resp, fmt.Errorf("failed to update routing skills of user %s: %s", userId, err)
+	}
+	return resp, nil
+}
+
+// deleteUserRoutingSkillFn is an implementation function for removing a routing skill from a user
+func deleteUserRoutingSkillFn(ctx context.Context, p *routingSkillPolicyProxy, userId string, skillId string) (*platformclientv2.APIResponse, error) {
+	resp, err := p.usersApi.DeleteUserRoutingskill(userId, skillId)
+	if err != nil {
+		return resp, fmt.Errorf("failed to remove routing skill %s from user %s: %s", skillId, userId, err)
+	}
+	return resp, nil
+}
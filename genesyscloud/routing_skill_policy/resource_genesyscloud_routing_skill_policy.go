@@ -0,0 +1,103 @@
+package routing_skill_policy
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+The resource_genesyscloud_routing_skill_policy.go contains all the methods that perform the core logic for the resource.
+A policy has no Genesys Cloud object of its own: creating and updating it reconciles the skills of the targeted users,
+reading it checks whether they are still reconciled, and deleting it removes the skills it assigned.
+*/
+
+func createRoutingSkillPolicy(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(uuid.NewString())
+	log.Printf("Creating skill policy %s", d.Get("name").(string))
+	if diagErr := reconcileRoutingSkillPolicy(ctx, d, meta); diagErr != nil {
+		d.SetId("")
+		return diagErr
+	}
+	log.Printf("Created skill policy %s", d.Get("name").(string))
+	return readRoutingSkillPolicy(ctx, d, meta)
+}
+
+func readRoutingSkillPolicy(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getRoutingSkillPolicyProxy(sdkConfig)
+	name := d.Get("name").(string)
+
+	log.Printf("Reading skill policy %s", name)
+	return util.WithRetriesForRead(ctx, d, func() *retry.RetryError {
+		plan, err := buildSkillPolicyPlan(ctx, d, proxy)
+		if err != nil {
+			return retry.NonRetryableError(fmt.Errorf("failed to read skill policy %s: %s", name, err))
+		}
+
+		_ = d.Set("in_sync", plan.inSync())
+		if !plan.inSync() {
+			log.Printf("The skills of %d users do not match skill policy %s", len(plan.updates)+len(plan.removals), name)
+		}
+
+		log.Printf("Read skill policy %s", name)
+		return nil
+	})
+}
+
+func updateRoutingSkillPolicy(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("Updating skill policy %s", d.Get("name").(string))
+	if diagErr := reconcileRoutingSkillPolicy(ctx, d, meta); diagErr != nil {
+		return diagErr
+	}
+	log.Printf("Updated skill policy %s", d.Get("name").(string))
+	return readRoutingSkillPolicy(ctx, d, meta)
+}
+
+func deleteRoutingSkillPolicy(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getRoutingSkillPolicyProxy(sdkConfig)
+	name := d.Get("name").(string)
+
+	// Removing every assignment is the plan of a policy without members
+	previous := buildAssignments(d.Get("assignments").(*schema.Set))
+	current, err := getCurrentUserSkills(ctx, proxy, sortedUserIds(previous))
+	if err != nil {
+		return util.BuildDiagnosticError(ResourceType, fmt.Sprintf("failed to read the skills assigned by skill policy %s", name), err)
+	}
+	plan := planSkillPolicy(nil, nil, current, previous, d.Get("precedence").(string))
+
+	log.Printf("Removing the skills assigned by skill policy %s from %d users", name, len(plan.removals))
+	if err := applySkillPolicyPlan(ctx, proxy, plan); err != nil {
+		return util.BuildDiagnosticError(ResourceType, fmt.Sprintf("failed to remove the skills assigned by skill policy %s", name), err)
+	}
+	log.Printf("Deleted skill policy %s", name)
+	return nil
+}
+
+// reconcileRoutingSkillPolicy assigns the skills of the policy to its current members and removes them from former members
+func reconcileRoutingSkillPolicy(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getRoutingSkillPolicyProxy(sdkConfig)
+	name := d.Get("name").(string)
+
+	plan, err := buildSkillPolicyPlan(ctx, d, proxy)
+	if err != nil {
+		return util.BuildDiagnosticError(ResourceType, fmt.Sprintf("failed to resolve the users of skill policy %s", name), err)
+	}
+
+	log.Printf("Skill policy %s updates the skills of %d users and removes skills from %d users", name, len(plan.updates), len(plan.removals))
+	if err := applySkillPolicyPlan(ctx, proxy, plan); err != nil {
+		return util.BuildDiagnosticError(ResourceType, fmt.Sprintf("failed to apply skill policy %s", name), err)
+	}
+
+	_ = d.Set("assignments", flattenAssignments(plan.assignments))
+	return nil
+}
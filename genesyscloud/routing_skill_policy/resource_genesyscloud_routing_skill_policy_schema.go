@@ -0,0 +1,171 @@
+package routing_skill_policy
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+/*
+resource_genesyscloud_routing_skill_policy_schema.go holds two functions within it:
+
+1.  The registration code that registers the Resource for the package.
+2.  The resource schema definitions for the routing_skill_policy resource.
+
+A skill policy only exists in the Terraform state; the skills it assigns are exported with genesyscloud_user, so this
+resource has no exporter.
+*/
+
+const ResourceType = "genesyscloud_routing_skill_policy"
+
+const (
+	precedenceExplicit = "explicit"
+	precedencePolicy   = "policy"
+	precedenceHighest  = "highest"
+)
+
+var targetAttrs = []string{"group_ids", "team_ids", "user_filter"}
+
+// SetRegistrar registers all the resources, datasources and exporters in the package
+func SetRegistrar(regInstance registrar.Registrar) {
+	regInstance.RegisterResource(ResourceType, ResourceRoutingSkillPolicy())
+}
+
+var (
+	policySkillResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"skill_id": {
+				Description: "ID of the routing skill.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"proficiency": {
+				Description:  "Proficiency of the skill. Value must be between 0 and 5.",
+				Type:         schema.TypeFloat,
+				Required:     true,
+				ValidateFunc: validation.FloatBetween(0, 5),
+			},
+		},
+	}
+
+	userFilterResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"departments": {
+				Description: "The users in one of these departments are targeted.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"titles": {
+				Description: "The users with one of these titles are targeted. When departments is also set, the users must match both.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+
+	assignmentResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"user_id": {
+				Description: "ID of the user.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"skill_id": {
+				Description: "ID of the routing skill.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"proficiency": {
+				Description: "Proficiency assigned to the user.",
+				Type:        schema.TypeFloat,
+				Computed:    true,
+			},
+		},
+	}
+)
+
+// ResourceRoutingSkillPolicy registers the genesyscloud_routing_skill_policy resource with Terraform
+func ResourceRoutingSkillPolicy() *schema.Resource {
+	return &schema.Resource{
+		Description: `Genesys Cloud Routing Skill Policy. Assigns routing skills to every member of groups or teams and to the active users matching a department or title filter. The members are resolved again on every refresh: the skills are assigned to the new members on the next apply, and removed from the users that are no longer targeted. Destroying the policy removes the skills it assigned. Skills that a user already has when the policy is applied, for example through the routing_skills of a genesyscloud_user, are handled according to precedence. List the skills of the policy in the policy_routing_skill_ids of the genesyscloud_user resources of targeted users, so that those resources keep the skills assigned by the policy.`,
+
+		CreateContext: provider.CreateWithPooledClient(createRoutingSkillPolicy),
+		ReadContext:   provider.ReadWithPooledClient(readRoutingSkillPolicy),
+		UpdateContext: provider.UpdateWithPooledClient(updateRoutingSkillPolicy),
+		DeleteContext: provider.DeleteWithPooledClient(deleteRoutingSkillPolicy),
+		SchemaVersion: 1,
+		CustomizeDiff: customdiff.All(
+			customdiff.ComputedIf("assignments", policyOutOfSync),
+			customdiff.ComputedIf("in_sync", policyOutOfSync),
+			customdiff.ComputedIf("assignments", func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+				return d.HasChanges("skills", "group_ids", "team_ids", "user_filter", "precedence")
+			}),
+		),
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "Name of the policy.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"skills": {
+				Description: "Skills assigned to the targeted users.",
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Elem:        policySkillResource,
+			},
+			"group_ids": {
+				Description:  "IDs of the groups whose individual members are targeted.",
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				AtLeastOneOf: targetAttrs,
+			},
+			"team_ids": {
+				Description:  "IDs of the teams whose members are targeted.",
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				AtLeastOneOf: targetAttrs,
+			},
+			"user_filter": {
+				Description:  "Filter on the active users of the organization. At least one of departments and titles must be set.",
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				Elem:         userFilterResource,
+				AtLeastOneOf: targetAttrs,
+			},
+			"precedence": {
+				Description:  fmt.Sprintf(`How a skill that a targeted user already has, and that was not assigned by this policy, is handled. %s keeps the proficiency the user has. %s sets the proficiency of the policy, and the skill is then removed with the policy. %s keeps the higher of the two proficiencies.`, precedenceExplicit, precedencePolicy, precedenceHighest),
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      precedenceExplicit,
+				ValidateFunc: validation.StringInSlice([]string{precedenceExplicit, precedencePolicy, precedenceHighest}, false),
+			},
+			"assignments": {
+				Description: "The skills assigned by the policy to each user.",
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        assignmentResource,
+			},
+			"in_sync": {
+				Description: "Whether the skills of the targeted users matched the policy when last refreshed. When false, the next apply reconciles them.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// policyOutOfSync is true when the last refresh found users whose skills do not match the policy
+func policyOutOfSync(ctx context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+	return d.Id() != "" && !d.Get("in_sync").(bool)
+}
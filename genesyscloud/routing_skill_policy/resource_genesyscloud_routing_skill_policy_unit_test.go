@@ -0,0 +1,132 @@
+package routing_skill_policy
+
+import (
+	"context"
+	"net/http"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v154/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+// testSkillOrg stubs the group, team, search and user skill APIs
+type testSkillOrg struct {
+	groupMembers map[string][]string
+	teamMembers  map[string][]string
+	searchResult []string
+	skills       userSkills
+}
+
+func (o *testSkillOrg) buildProxy() *routingSkillPolicyProxy {
+	ok := &platformclientv2.APIResponse{StatusCode: http.StatusOK}
+	proxy := newRoutingSkillPolicyProxy(&platformclientv2.Configuration{})
+	proxy.getGroupMemberIdsAttr = func(ctx context.Context, p *routingSkillPolicyProxy, groupId string) ([]string, *platformclientv2.APIResponse, error) {
+		members, found := o.groupMembers[groupId]
+		if !found {
+			return nil, &platformclientv2.APIResponse{StatusCode: http.StatusNotFound}, assert.AnError
+		}
+		return members, ok, nil
+	}
+	proxy.getTeamMemberIdsAttr = func(ctx context.Context, p *routingSkillPolicyProxy, teamId string) ([]string, *platformclientv2.APIResponse, error) {
+		return o.teamMembers[teamId], ok, nil
+	}
+	proxy.searchUserIdsAttr = func(ctx context.Context, p *routingSkillPolicyProxy, departments []string, titles []string) ([]string, *platformclientv2.APIResponse, error) {
+		return o.searchResult, ok, nil
+	}
+	proxy.getUserRoutingSkillsAttr = func(ctx context.Context, p *routingSkillPolicyProxy, userId string) (*[]platformclientv2.Userroutingskill, *platformclientv2.APIResponse, error) {
+		skills := make([]platformclientv2.Userroutingskill, 0)
+		for skillId, proficiency := range o.skills[userId] {
+			skills = append(skills, platformclientv2.Userroutingskill{Id: platformclientv2.String(skillId), Proficiency: platformclientv2.Float64(proficiency)})
+		}
+		return &skills, ok, nil
+	}
+	proxy.updateUserRoutingSkillsAttr = func(ctx context.Context, p *routingSkillPolicyProxy, userId string, skills []platformclientv2.Userroutingskillpost) (*platformclientv2.APIResponse, error) {
+		for _, skill := range skills {
+			setUserSkill(o.skills, userId, *skill.Id, *skill.Proficiency)
+		}
+		return ok, nil
+	}
+	proxy.deleteUserRoutingSkillAttr = func(ctx context.Context, p *routingSkillPolicyProxy, userId string, skillId string) (*platformclientv2.APIResponse, error) {
+		delete(o.skills[userId], skillId)
+		return ok, nil
+	}
+	return proxy
+}
+
+func TestUnitPlanSkillPolicyPrecedence(t *testing.T) {
+	policySkills := map[string]float64{"skill-1": 3}
+	members := []string{"explicit-low", "explicit-high", "assigned", "new"}
+	current := userSkills{
+		"explicit-low":  {"skill-1": 1},
+		"explicit-high": {"skill-1": 5},
+		"assigned":      {"skill-1": 2},
+		"former":        {"skill-1": 3, "skill-2": 4},
+	}
+	previous := userSkills{
+		"assigned": {"skill-1": 3},
+		"former":   {"skill-1": 3},
+	}
+
+	plan := planSkillPolicy(policySkills, members, current, previous, precedenceExplicit)
+	assert.Equal(t, userSkills{"assigned": {"skill-1": 3}, "new": {"skill-1": 3}}, plan.assignments)
+	assert.Equal(t, userSkills{"assigned": {"skill-1": 3}, "new": {"skill-1": 3}}, plan.updates)
+	assert.Equal(t, map[string][]string{"former": {"skill-1"}}, plan.removals, "only the skills the policy assigned are removed")
+
+	plan = planSkillPolicy(policySkills, members, current, previous, precedencePolicy)
+	assert.Len(t, plan.assignments, 4)
+	assert.Equal(t, 3.0, plan.updates["explicit-high"]["skill-1"])
+
+	plan = planSkillPolicy(policySkills, members, current, previous, precedenceHighest)
+	assert.Contains(t, plan.updates, "explicit-low")
+	assert.NotContains(t, plan.assignments, "explicit-high")
+}
+
+func TestUnitRoutingSkillPolicyReconcile(t *testing.T) {
+	org := &testSkillOrg{
+		groupMembers: map[string][]string{"group-1": {"user-1", "user-2"}},
+		teamMembers:  map[string][]string{"team-1": {"user-2", "user-3"}},
+		skills:       userSkills{"user-1": {"skill-1": 5}},
+	}
+	internalProxy = org.buildProxy()
+	defer func() { internalProxy = nil }()
+
+	meta := &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}
+	d := schema.TestResourceDataRaw(t, ResourceRoutingSkillPolicy().Schema, map[string]interface{}{
+		"name":      "Support skills",
+		"group_ids": []interface{}{"group-1"},
+		"team_ids":  []interface{}{"team-1"},
+		"skills": []interface{}{
+			map[string]interface{}{"skill_id": "skill-1", "proficiency": 3.0},
+			map[string]interface{}{"skill_id": "skill-2", "proficiency": 4.0},
+		},
+	})
+
+	diags := createRoutingSkillPolicy(context.Background(), d, meta)
+	assert.False(t, diags.HasError(), diags)
+	assert.NotEmpty(t, d.Id())
+	assert.Equal(t, true, d.Get("in_sync"))
+	assert.Equal(t, 5, d.Get("assignments").(*schema.Set).Len())
+	assert.Equal(t, map[string]float64{"skill-1": 5, "skill-2": 4}, org.skills["user-1"], "the explicit skill is kept")
+	assert.Equal(t, map[string]float64{"skill-1": 3, "skill-2": 4}, org.skills["user-3"])
+
+	// A user leaving the group and a new team member are reconciled on the next apply
+	org.groupMembers["group-1"] = []string{"user-1"}
+	org.teamMembers["team-1"] = []string{"user-4"}
+	diags = readRoutingSkillPolicy(context.Background(), d, meta)
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, false, d.Get("in_sync"))
+
+	diags = updateRoutingSkillPolicy(context.Background(), d, meta)
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, true, d.Get("in_sync"))
+	assert.Empty(t, org.skills["user-2"])
+	assert.Equal(t, map[string]float64{"skill-1": 3, "skill-2": 4}, org.skills["user-4"])
+
+	// Deleting the policy removes the skills it assigned and keeps the explicit ones
+	diags = deleteRoutingSkillPolicy(context.Background(), d, meta)
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, map[string]float64{"skill-1": 5}, org.skills["user-1"])
+	assert.Empty(t, org.skills["user-4"])
+}
@@ -0,0 +1,262 @@
+package routing_skill_policy
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	chunksProcess "terraform-provider-genesyscloud/genesyscloud/util/chunks"
+	"terraform-provider-genesyscloud/genesyscloud/util/lists"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v154/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_routing_skill_policy_utils.go file contains the helpers of the routing_skill_policy resource.
+
+Reconciling a policy resolves the targeted users, reads their skills and compares them with the skills the policy
+assigned on the previous apply, which are kept in the assignments attribute. A skill a user has that the policy did not
+assign is an explicit skill, and precedence decides whether the policy overrides it.
+*/
+
+// userSkills maps the ID of a user to the proficiency of each of its skills
+type userSkills map[string]map[string]float64
+
+// skillPolicyPlan holds the skills the policy assigns and the changes needed to get there
+type skillPolicyPlan struct {
+	assignments userSkills
+	updates     userSkills
+	removals    map[string][]string
+}
+
+func (p *skillPolicyPlan) inSync() bool {
+	return len(p.updates) == 0 && len(p.removals) == 0
+}
+
+// resolvePolicyMembers returns the sorted IDs of the users targeted by the policy. Groups and teams that no longer
+// exist have no members.
+func resolvePolicyMembers(ctx context.Context, d *schema.ResourceData, proxy *routingSkillPolicyProxy) ([]string, error) {
+	members := make(map[string]bool)
+
+	for _, groupId := range *lists.SetToStringList(d.Get("group_ids").(*schema.Set)) {
+		memberIds, resp, err := proxy.getGroupMemberIds(ctx, groupId)
+		if err != nil {
+			if util.IsStatus404(resp) {
+				log.Printf("Group %s no longer exists, it has no members", groupId)
+				continue
+			}
+			return nil, err
+		}
+		for _, id := range memberIds {
+			members[id] = true
+		}
+	}
+
+	for _, teamId := range *lists.SetToStringList(d.Get("team_ids").(*schema.Set)) {
+		memberIds, resp, err := proxy.getTeamMemberIds(ctx, teamId)
+		if err != nil {
+			if util.IsStatus404(resp) {
+				log.Printf("Team %s no longer exists, it has no members", teamId)
+				continue
+			}
+			return nil, err
+		}
+		for _, id := range memberIds {
+			members[id] = true
+		}
+	}
+
+	if filters := d.Get("user_filter").([]interface{}); len(filters) > 0 && filters[0] != nil {
+		filter := filters[0].(map[string]interface{})
+		departments := *lists.SetToStringList(filter["departments"].(*schema.Set))
+		titles := *lists.SetToStringList(filter["titles"].(*schema.Set))
+		if len(departments) == 0 && len(titles) == 0 {
+			return nil, fmt.Errorf("user_filter must set at least one of departments and titles")
+		}
+		memberIds, _, err := proxy.searchUserIds(ctx, departments, titles)
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range memberIds {
+			members[id] = true
+		}
+	}
+
+	memberIds := make([]string, 0, len(members))
+	for id := range members {
+		memberIds = append(memberIds, id)
+	}
+	sort.Strings(memberIds)
+	return memberIds, nil
+}
+
+// getCurrentUserSkills reads the skills of the users. Users that no longer exist have no skills.
+func getCurrentUserSkills(ctx context.Context, proxy *routingSkillPolicyProxy, userIds []string) (userSkills, error) {
+	current := make(userSkills, len(userIds))
+	for _, userId := range userIds {
+		skills, resp, err := proxy.getUserRoutingSkills(ctx, userId)
+		if err != nil {
+			if util.IsStatus404(resp) {
+				current[userId] = map[string]float64{}
+				continue
+			}
+			return nil, err
+		}
+		current[userId] = make(map[string]float64, len(*skills))
+		for _, skill := range *skills {
+			if skill.Id != nil && skill.Proficiency != nil {
+				current[userId][*skill.Id] = *skill.Proficiency
+			}
+		}
+	}
+	return current, nil
+}
+
+// planSkillPolicy computes the skills the policy assigns to the members, the skills to add or update, and the skills
+// the policy assigned before that must be removed
+func planSkillPolicy(policySkills map[string]float64, members []string, current userSkills, previous userSkills, precedence string) *skillPolicyPlan {
+	plan := &skillPolicyPlan{
+		assignments: make(userSkills),
+		updates:     make(userSkills),
+		removals:    make(map[string][]string),
+	}
+
+	for _, userId := range members {
+		for skillId, proficiency := range policySkills {
+			currentProficiency, hasSkill := current[userId][skillId]
+			_, assigned := previous[userId][skillId]
+			if hasSkill && !assigned {
+				// The user has an explicit skill
+				if precedence == precedenceExplicit || (precedence == precedenceHighest && currentProficiency >= proficiency) {
+					continue
+				}
+			}
+			setUserSkill(plan.assignments, userId, skillId, proficiency)
+			if !hasSkill || currentProficiency != proficiency {
+				setUserSkill(plan.updates, userId, skillId, proficiency)
+			}
+		}
+	}
+
+	for userId, skills := range previous {
+		for skillId := range skills {
+			if _, kept := plan.assignments[userId][skillId]; kept {
+				continue
+			}
+			if _, hasSkill := current[userId][skillId]; hasSkill {
+				plan.removals[userId] = append(plan.removals[userId], skillId)
+			}
+		}
+		sort.Strings(plan.removals[userId])
+	}
+	return plan
+}
+
+func setUserSkill(skills userSkills, userId, skillId string, proficiency float64) {
+	if skills[userId] == nil {
+		skills[userId] = make(map[string]float64)
+	}
+	skills[userId][skillId] = proficiency
+}
+
+// buildSkillPolicyPlan resolves the members of the policy and plans the changes to their skills
+func buildSkillPolicyPlan(ctx context.Context, d *schema.ResourceData, proxy *routingSkillPolicyProxy) (*skillPolicyPlan, error) {
+	members, err := resolvePolicyMembers(ctx, d, proxy)
+	if err != nil {
+		return nil, err
+	}
+
+	previous := buildAssignments(d.Get("assignments").(*schema.Set))
+	userIds := append([]string{}, members...)
+	for userId := range previous {
+		if !lists.ItemInSlice(userId, members) {
+			userIds = append(userIds, userId)
+		}
+	}
+	current, err := getCurrentUserSkills(ctx, proxy, userIds)
+	if err != nil {
+		return nil, err
+	}
+
+	return planSkillPolicy(buildPolicySkills(d.Get("skills").(*schema.Set)), members, current, previous, d.Get("precedence").(string)), nil
+}
+
+// applySkillPolicyPlan removes and updates the skills of the users, up to 50 skills per call
+func applySkillPolicyPlan(ctx context.Context, proxy *routingSkillPolicyProxy, plan *skillPolicyPlan) error {
+	// The bulk API accepts up to 50 skills per call
+	const maxBatchSize = 50
+
+	for _, userId := range sortedUserIds(plan.removals) {
+		for _, skillId := range plan.removals[userId] {
+			resp, err := proxy.deleteUserRoutingSkill(ctx, userId, skillId)
+			if err != nil && !util.IsStatus404(resp) {
+				return err
+			}
+		}
+	}
+
+	for _, userId := range sortedUserIds(plan.updates) {
+		skills := plan.updates[userId]
+		skillIds := make([]string, 0, len(skills))
+		for skillId := range skills {
+			skillIds = append(skillIds, skillId)
+		}
+		sort.Strings(skillIds)
+
+		chunks := chunksProcess.ChunkItems(skillIds, func(skillId string) platformclientv2.Userroutingskillpost {
+			return platformclientv2.Userroutingskillpost{
+				Id:          platformclientv2.String(skillId),
+				Proficiency: platformclientv2.Float64(skills[skillId]),
+			}
+		}, maxBatchSize)
+		for _, chunk := range chunks {
+			if _, err := proxy.updateUserRoutingSkills(ctx, userId, chunk); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func sortedUserIds[T any](users map[string]T) []string {
+	userIds := make([]string, 0, len(users))
+	for userId := range users {
+		userIds = append(userIds, userId)
+	}
+	sort.Strings(userIds)
+	return userIds
+}
+
+func buildPolicySkills(skills *schema.Set) map[string]float64 {
+	policySkills := make(map[string]float64)
+	for _, skill := range skills.List() {
+		skillMap := skill.(map[string]interface{})
+		policySkills[skillMap["skill_id"].(string)] = skillMap["proficiency"].(float64)
+	}
+	return policySkills
+}
+
+func buildAssignments(assignments *schema.Set) userSkills {
+	skills := make(userSkills)
+	for _, assignment := range assignments.List() {
+		assignmentMap := assignment.(map[string]interface{})
+		setUserSkill(skills, assignmentMap["user_id"].(string), assignmentMap["skill_id"].(string), assignmentMap["proficiency"].(float64))
+	}
+	return skills
+}
+
+func flattenAssignments(assignments userSkills) *schema.Set {
+	set := schema.NewSet(schema.HashResource(assignmentResource), []interface{}{})
+	for userId, skills := range assignments {
+		for skillId, proficiency := range skills {
+			set.Add(map[string]interface{}{
+				"user_id":     userId,
+				"skill_id":    skillId,
+				"proficiency": proficiency,
+			})
+		}
+	}
+	return set
+}
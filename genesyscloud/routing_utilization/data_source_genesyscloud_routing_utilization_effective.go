@@ -0,0 +1,134 @@
+package routing_utilization
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v154/platformclientv2"
+)
+
+/*
+   The data_source_genesyscloud_routing_utilization_effective.go contains the data source implementation
+   for the utilization settings that apply to a user once they are inherited from the org utilization.
+*/
+
+const (
+	utilizationLevelOrganization = "Organization"
+	utilizationLevelAgent        = "Agent"
+)
+
+// dataSourceRoutingUtilizationEffectiveRead reads the org and user utilization and merges the user settings over the org settings
+func dataSourceRoutingUtilizationEffectiveRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := getRoutingUtilizationProxy(sdkConfig)
+	userId := d.Get("user_id").(string)
+
+	orgUtilization, resp, err := proxy.getRoutingUtilization(ctx)
+	if err != nil {
+		return util.BuildAPIDiagnosticError(EffectiveDataSourceType, fmt.Sprintf("failed to read Routing Utilization | error: %s", err), resp)
+	}
+
+	userUtilization, resp, err := proxy.getRoutingUserUtilization(ctx, userId)
+	if err != nil {
+		return util.BuildAPIDiagnosticError(EffectiveDataSourceType, fmt.Sprintf("failed to read routing utilization of user %s | error: %s", userId, err), resp)
+	}
+
+	level := utilizationLevelOrganization
+	if userUtilization.Level != nil && *userUtilization.Level != "" {
+		level = *userUtilization.Level
+	}
+
+	mediaUtilizations := make(map[string]map[string]interface{})
+	labelUtilizations := make(map[string]map[string]interface{})
+	if orgUtilization.Utilization != nil {
+		mergeMediaUtilizations(mediaUtilizations, *orgUtilization.Utilization, utilizationLevelOrganization)
+	}
+	if orgUtilization.LabelUtilizations != nil {
+		mergeLabelUtilizations(labelUtilizations, *orgUtilization.LabelUtilizations, utilizationLevelOrganization)
+	}
+	// A user at the Organization level returns the org settings, which are only overridden at the Agent level
+	if level != utilizationLevelOrganization {
+		if userUtilization.Utilization != nil {
+			mergeMediaUtilizations(mediaUtilizations, *userUtilization.Utilization, level)
+		}
+		if userUtilization.LabelUtilizations != nil {
+			mergeLabelUtilizations(labelUtilizations, *userUtilization.LabelUtilizations, level)
+		}
+	}
+
+	d.SetId(userId)
+	_ = d.Set("level", level)
+	_ = d.Set("media_utilizations", sortedUtilizations(mediaUtilizations))
+	_ = d.Set("label_utilizations", sortedUtilizations(labelUtilizations))
+	return nil
+}
+
+func mergeMediaUtilizations(merged map[string]map[string]interface{}, mediaUtilizations map[string]platformclientv2.Mediautilization, source string) {
+	for mediaType, mediaUtilization := range mediaUtilizations {
+		interruptibleMediaTypes := make([]string, 0)
+		if mediaUtilization.InterruptableMediaTypes != nil {
+			interruptibleMediaTypes = append(interruptibleMediaTypes, *mediaUtilization.InterruptableMediaTypes...)
+		}
+		sort.Strings(interruptibleMediaTypes)
+
+		merged[mediaType] = map[string]interface{}{
+			"media_type":                mediaType,
+			"maximum_capacity":          intValue(mediaUtilization.MaximumCapacity),
+			"interruptible_media_types": interruptibleMediaTypes,
+			"include_non_acd":           mediaUtilization.IncludeNonAcd != nil && *mediaUtilization.IncludeNonAcd,
+			"source":                    source,
+		}
+	}
+}
+
+func mergeLabelUtilizations(merged map[string]map[string]interface{}, labelUtilizations map[string]platformclientv2.Labelutilizationresponse, source string) {
+	for labelId, labelUtilization := range labelUtilizations {
+		interruptingLabelIds := make([]string, 0)
+		if labelUtilization.InterruptingLabelIds != nil {
+			interruptingLabelIds = append(interruptingLabelIds, *labelUtilization.InterruptingLabelIds...)
+		}
+		sort.Strings(interruptingLabelIds)
+
+		labelName := ""
+		if labelUtilization.LabelName != nil {
+			labelName = *labelUtilization.LabelName
+		} else if inherited, ok := merged[labelId]; ok {
+			labelName = inherited["label_name"].(string)
+		}
+
+		merged[labelId] = map[string]interface{}{
+			"label_id":               labelId,
+			"label_name":             labelName,
+			"maximum_capacity":       intValue(labelUtilization.MaximumCapacity),
+			"interrupting_label_ids": interruptingLabelIds,
+			"source":                 source,
+		}
+	}
+}
+
+// sortedUtilizations returns the merged settings sorted by media type or label ID
+func sortedUtilizations(merged map[string]map[string]interface{}) []interface{} {
+	keys := make([]string, 0, len(merged))
+	for key := range merged {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	utilizations := make([]interface{}, 0, len(keys))
+	for _, key := range keys {
+		utilizations = append(utilizations, merged[key])
+	}
+	return utilizations
+}
+
+func intValue(value *int) int {
+	if value == nil {
+		return 0
+	}
+	return *value
+}
@@ -11,23 +11,26 @@ var internalProxy *routingUtilizationProxy
 type getRoutingUtilizationFunc func(ctx context.Context, p *routingUtilizationProxy) (*platformclientv2.Utilizationresponse, *platformclientv2.APIResponse, error)
 type updateRoutingUtilizationFunc func(ctx context.Context, p *routingUtilizationProxy, request *platformclientv2.Utilizationrequest) (*platformclientv2.Utilizationresponse, *platformclientv2.APIResponse, error)
 type deleteRoutingUtilizationFunc func(ctx context.Context, p *routingUtilizationProxy) (*platformclientv2.APIResponse, error)
+type getRoutingUserUtilizationFunc func(ctx context.Context, p *routingUtilizationProxy, userId string) (*platformclientv2.Agentmaxutilizationresponse, *platformclientv2.APIResponse, error)
 
 type routingUtilizationProxy struct {
-	clientConfig                 *platformclientv2.Configuration
-	routingApi                   *platformclientv2.RoutingApi
-	getRoutingUtilizationAttr    getRoutingUtilizationFunc
-	updateRoutingUtilizationAttr updateRoutingUtilizationFunc
-	deleteRoutingUtilizationAttr deleteRoutingUtilizationFunc
+	clientConfig                  *platformclientv2.Configuration
+	routingApi                    *platformclientv2.RoutingApi
+	getRoutingUtilizationAttr     getRoutingUtilizationFunc
+	updateRoutingUtilizationAttr  updateRoutingUtilizationFunc
+	deleteRoutingUtilizationAttr  deleteRoutingUtilizationFunc
+	getRoutingUserUtilizationAttr getRoutingUserUtilizationFunc
 }
 
 func newRoutingUtilizationProxy(clientConfig *platformclientv2.Configuration) *routingUtilizationProxy {
 	api := platformclientv2.NewRoutingApiWithConfig(clientConfig)
 	return &routingUtilizationProxy{
-		clientConfig:                 clientConfig,
-		routingApi:                   api,
-		getRoutingUtilizationAttr:    getRoutingUtilizationFn,
-		updateRoutingUtilizationAttr: updateRoutingUtilizationFn,
-		deleteRoutingUtilizationAttr: deleteRoutingUtilizationFn,
+		clientConfig:                  clientConfig,
+		routingApi:                    api,
+		getRoutingUtilizationAttr:     getRoutingUtilizationFn,
+		updateRoutingUtilizationAttr:  updateRoutingUtilizationFn,
+		deleteRoutingUtilizationAttr:  deleteRoutingUtilizationFn,
+		getRoutingUserUtilizationAttr: getRoutingUserUtilizationFn,
 	}
 }
 
@@ -50,6 +53,10 @@ func (p *routingUtilizationProxy) deleteRoutingUtilization(ctx context.Context)
 	return p.deleteRoutingUtilizationAttr(ctx, p)
 }
 
+func (p *routingUtilizationProxy) getRoutingUserUtilization(ctx context.Context, userId string) (*platformclientv2.Agentmaxutilizationresponse, *platformclientv2.APIResponse, error) {
+	return p.getRoutingUserUtilizationAttr(ctx, p, userId)
+}
+
 func getRoutingUtilizationFn(ctx context.Context, p *routingUtilizationProxy) (*platformclientv2.Utilizationresponse, *platformclientv2.APIResponse, error) {
 	return p.routingApi.GetRoutingUtilization()
 }
@@ -61,3 +68,7 @@ func updateRoutingUtilizationFn(ctx context.Context, p *routingUtilizationProxy,
 func deleteRoutingUtilizationFn(ctx context.Context, p *routingUtilizationProxy) (*platformclientv2.APIResponse, error) {
 	return p.routingApi.DeleteRoutingUtilization()
 }
+
+func getRoutingUserUtilizationFn(ctx context.Context, p *routingUtilizationProxy, userId string) (*platformclientv2.Agentmaxutilizationresponse, *platformclientv2.APIResponse, error) {
+	return p.routingApi.GetRoutingUserUtilization(userId)
+}
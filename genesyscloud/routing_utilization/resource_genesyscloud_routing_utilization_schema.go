@@ -13,10 +13,12 @@ import (
 )
 
 const ResourceType = "genesyscloud_routing_utilization"
+const EffectiveDataSourceType = "genesyscloud_routing_utilization_effective"
 
-// SetRegistrar registers all the resources and exporters in the package
+// SetRegistrar registers all the resources, datasources and exporters in the package
 func SetRegistrar(regInstance registrar.Registrar) {
 	regInstance.RegisterResource(ResourceType, ResourceRoutingUtilization())
+	regInstance.RegisterDataSource(EffectiveDataSourceType, DataSourceRoutingUtilizationEffective())
 	regInstance.RegisterExporter(ResourceType, RoutingUtilizationExporter())
 }
 
@@ -74,6 +76,68 @@ var (
 			},
 		},
 	}
+
+	effectiveMediaUtilizationResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"media_type": {
+				Description: "The media type.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"maximum_capacity": {
+				Description: "Maximum capacity of conversations of this media type.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"interruptible_media_types": {
+				Description: "Other media types that can interrupt this media type.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"include_non_acd": {
+				Description: "Whether this media type is blocked when on a non-ACD conversation.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"source": {
+				Description: "Where the settings are inherited from (Organization | Agent).",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+
+	effectiveLabelUtilizationResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"label_id": {
+				Description: "Id of the label.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"label_name": {
+				Description: "Name of the label.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"maximum_capacity": {
+				Description: "Maximum capacity of conversations with this label.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"interrupting_label_ids": {
+				Description: "Other labels that can interrupt this label.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"source": {
+				Description: "Where the settings are inherited from (Organization | Agent).",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
 )
 
 func ResourceRoutingUtilization() *schema.Resource {
@@ -88,6 +152,7 @@ func ResourceRoutingUtilization() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		SchemaVersion: 1,
+		CustomizeDiff: validateRoutingUtilizationDiff,
 		Timeouts: &schema.ResourceTimeout{
 			Update: schema.DefaultTimeout(8 * time.Minute),
 			Read:   schema.DefaultTimeout(8 * time.Minute),
@@ -144,6 +209,38 @@ func ResourceRoutingUtilization() *schema.Resource {
 	}
 }
 
+// DataSourceRoutingUtilizationEffective registers the genesyscloud_routing_utilization_effective data source
+func DataSourceRoutingUtilizationEffective() *schema.Resource {
+	return &schema.Resource{
+		Description: "The utilization settings that apply to a user once the settings of the user are inherited from the org utilization. Settings configured on the user with genesyscloud_user.routing_utilization override the org settings of the same media type or label.",
+		ReadContext: provider.ReadWithPooledClient(dataSourceRoutingUtilizationEffectiveRead),
+		Schema: map[string]*schema.Schema{
+			"user_id": {
+				Description: "ID of the user.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"level": {
+				Description: "The level the utilization settings of the user are defined at (Organization | Agent).",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"media_utilizations": {
+				Description: "The effective settings of each media type, sorted by media type.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        effectiveMediaUtilizationResource,
+			},
+			"label_utilizations": {
+				Description: "The effective settings of each label, sorted by label ID.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        effectiveLabelUtilizationResource,
+			},
+		},
+	}
+}
+
 func RoutingUtilizationExporter() *resourceExporter.ResourceExporter {
 	return &resourceExporter.ResourceExporter{
 		GetResourcesFunc: provider.GetAllWithPooledClient(getAllRoutingUtilization),
@@ -0,0 +1,129 @@
+package routing_utilization
+
+import (
+	"context"
+	"net/http"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v154/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func buildTestMediaSettings(maxCapacity int, interruptibleMediaTypes ...interface{}) []interface{} {
+	return []interface{}{map[string]interface{}{
+		"maximum_capacity":          maxCapacity,
+		"include_non_acd":           false,
+		"interruptible_media_types": schema.NewSet(schema.HashString, interruptibleMediaTypes),
+	}}
+}
+
+func buildTestLabelSettings(labelId string, maxCapacity int, interruptingLabelIds ...interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"label_id":               labelId,
+		"maximum_capacity":       maxCapacity,
+		"interrupting_label_ids": schema.NewSet(schema.HashString, interruptingLabelIds),
+	}
+}
+
+func TestUnitValidateUtilization(t *testing.T) {
+	valid := map[string]interface{}{
+		"call":  buildTestMediaSettings(1, "email", "chat"),
+		"chat":  buildTestMediaSettings(2),
+		"email": buildTestMediaSettings(3, "message"),
+		"label_utilizations": []interface{}{
+			buildTestLabelSettings("label-a", 1, "label-b"),
+			buildTestLabelSettings("label-b", 2, "label-c"),
+			buildTestLabelSettings("label-d", 0, "label-a"),
+		},
+	}
+	assert.NoError(t, ValidateUtilization(valid), "media types and labels that are not configured are not checked, and labels with no capacity can still be interrupted")
+
+	err := ValidateUtilization(map[string]interface{}{
+		"call":    buildTestMediaSettings(1, "call", "email", "video"),
+		"email":   buildTestMediaSettings(0),
+		"message": buildTestMediaSettings(1),
+		"label_utilizations": []interface{}{
+			buildTestLabelSettings("label-a", 1, "label-a", "label-b"),
+			buildTestLabelSettings("label-b", 2, "label-c"),
+			buildTestLabelSettings("label-c", 0, "label-a"),
+			buildTestLabelSettings("label-c", 1),
+		},
+	})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "call.interruptible_media_types: call cannot interrupt itself")
+		assert.Contains(t, err.Error(), `call.interruptible_media_types: unknown media type "video"`)
+		assert.Contains(t, err.Error(), "call.interruptible_media_types: email has a maximum_capacity of 0")
+		assert.Contains(t, err.Error(), "label_utilizations: label label-c is configured more than once")
+		assert.Contains(t, err.Error(), "label_utilizations: label label-a cannot interrupt itself")
+		assert.Contains(t, err.Error(), "label_utilizations: label label-c has a maximum_capacity of 0 and can never interrupt label label-b")
+		assert.Contains(t, err.Error(), "label_utilizations: interrupting_label_ids form a cycle: label-a -> label-b -> label-c -> label-a")
+	}
+}
+
+func TestUnitDataSourceRoutingUtilizationEffective(t *testing.T) {
+	ok := &platformclientv2.APIResponse{StatusCode: http.StatusOK}
+	orgUtilization := &platformclientv2.Utilizationresponse{
+		Utilization: &map[string]platformclientv2.Mediautilization{
+			"call": {MaximumCapacity: platformclientv2.Int(1), IncludeNonAcd: platformclientv2.Bool(true)},
+			"chat": {MaximumCapacity: platformclientv2.Int(3), InterruptableMediaTypes: &[]string{"email", "call"}},
+		},
+		LabelUtilizations: &map[string]platformclientv2.Labelutilizationresponse{
+			"label-a": {LabelName: platformclientv2.String("Label A"), MaximumCapacity: platformclientv2.Int(2)},
+			"label-b": {LabelName: platformclientv2.String("Label B"), MaximumCapacity: platformclientv2.Int(4)},
+		},
+	}
+	userUtilization := &platformclientv2.Agentmaxutilizationresponse{
+		Level: platformclientv2.String(utilizationLevelAgent),
+		Utilization: &map[string]platformclientv2.Mediautilization{
+			"chat": {MaximumCapacity: platformclientv2.Int(5)},
+		},
+		LabelUtilizations: &map[string]platformclientv2.Labelutilizationresponse{
+			"label-b": {MaximumCapacity: platformclientv2.Int(1), InterruptingLabelIds: &[]string{"label-a"}},
+		},
+	}
+
+	testProxy := &routingUtilizationProxy{}
+	testProxy.getRoutingUtilizationAttr = func(ctx context.Context, p *routingUtilizationProxy) (*platformclientv2.Utilizationresponse, *platformclientv2.APIResponse, error) {
+		return orgUtilization, ok, nil
+	}
+	testProxy.getRoutingUserUtilizationAttr = func(ctx context.Context, p *routingUtilizationProxy, userId string) (*platformclientv2.Agentmaxutilizationresponse, *platformclientv2.APIResponse, error) {
+		assert.Equal(t, "user-1", userId)
+		return userUtilization, ok, nil
+	}
+	internalProxy = testProxy
+	defer func() { internalProxy = nil }()
+
+	d := schema.TestResourceDataRaw(t, DataSourceRoutingUtilizationEffective().Schema, map[string]interface{}{"user_id": "user-1"})
+	diags := dataSourceRoutingUtilizationEffectiveRead(context.Background(), d, &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}})
+	assert.False(t, diags.HasError(), diags)
+
+	assert.Equal(t, "user-1", d.Id())
+	assert.Equal(t, utilizationLevelAgent, d.Get("level"))
+	assert.Equal(t, 2, d.Get("media_utilizations.#"))
+	assert.Equal(t, "call", d.Get("media_utilizations.0.media_type"))
+	assert.Equal(t, true, d.Get("media_utilizations.0.include_non_acd"))
+	assert.Equal(t, utilizationLevelOrganization, d.Get("media_utilizations.0.source"))
+	assert.Equal(t, "chat", d.Get("media_utilizations.1.media_type"))
+	assert.Equal(t, 5, d.Get("media_utilizations.1.maximum_capacity"))
+	assert.Equal(t, 0, d.Get("media_utilizations.1.interruptible_media_types.#"))
+	assert.Equal(t, utilizationLevelAgent, d.Get("media_utilizations.1.source"))
+
+	assert.Equal(t, 2, d.Get("label_utilizations.#"))
+	assert.Equal(t, utilizationLevelOrganization, d.Get("label_utilizations.0.source"))
+	assert.Equal(t, "label-b", d.Get("label_utilizations.1.label_id"))
+	assert.Equal(t, "Label B", d.Get("label_utilizations.1.label_name"))
+	assert.Equal(t, 1, d.Get("label_utilizations.1.maximum_capacity"))
+	assert.Equal(t, []interface{}{"label-a"}, d.Get("label_utilizations.1.interrupting_label_ids"))
+	assert.Equal(t, utilizationLevelAgent, d.Get("label_utilizations.1.source"))
+
+	// A user at the Organization level gets the org settings
+	userUtilization.Level = platformclientv2.String(utilizationLevelOrganization)
+	d = schema.TestResourceDataRaw(t, DataSourceRoutingUtilizationEffective().Schema, map[string]interface{}{"user_id": "user-1"})
+	diags = dataSourceRoutingUtilizationEffectiveRead(context.Background(), d, &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}})
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, 3, d.Get("media_utilizations.1.maximum_capacity"))
+	assert.Equal(t, []interface{}{"call", "email"}, d.Get("media_utilizations.1.interruptible_media_types"))
+	assert.Equal(t, 4, d.Get("label_utilizations.1.maximum_capacity"))
+}
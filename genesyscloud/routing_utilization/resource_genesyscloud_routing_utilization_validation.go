@@ -0,0 +1,183 @@
+package routing_utilization
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+The resource_genesyscloud_routing_utilization_validation.go file validates at plan time the combinations of media type
+and label utilization settings that the API rejects. The same rules apply to the org utilization and to the
+routing_utilization of a user, so ValidateUtilization works on the settings map shared by both schemas.
+*/
+
+// validateRoutingUtilizationDiff validates the utilization settings of the org
+func validateRoutingUtilizationDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	// Label IDs referencing labels that are not created yet are only known at apply time
+	rawConfig := diff.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsWhollyKnown() {
+		return nil
+	}
+
+	settings := map[string]interface{}{
+		"label_utilizations": diff.Get("label_utilizations"),
+	}
+	for _, schemaType := range UtilizationMediaTypes {
+		settings[schemaType] = diff.Get(schemaType)
+	}
+	return ValidateUtilization(settings)
+}
+
+// ValidateUtilization checks the media type settings and label_utilizations of a utilization settings map:
+// media types can only be interrupted by other known media types with a capacity, labels can only be
+// interrupted by other labels with a capacity, and interrupting_label_ids must not form a cycle.
+func ValidateUtilization(settings map[string]interface{}) error {
+	var errs []error
+
+	mediaCapacities := make(map[string]int)
+	mediaInterruptions := make(map[string][]string)
+	for _, schemaType := range getSdkUtilizationTypes() {
+		mediaSettings, ok := utilizationSettingsMap(settings[schemaType])
+		if !ok {
+			continue
+		}
+		mediaCapacities[schemaType], _ = mediaSettings["maximum_capacity"].(int)
+		mediaInterruptions[schemaType] = setToSortedStrings(mediaSettings["interruptible_media_types"])
+	}
+
+	for _, schemaType := range getSdkUtilizationTypes() {
+		for _, interruptingType := range mediaInterruptions[schemaType] {
+			capacity, configured := mediaCapacities[interruptingType]
+			switch {
+			case interruptingType == schemaType:
+				errs = append(errs, fmt.Errorf("%s.interruptible_media_types: %s cannot interrupt itself", schemaType, schemaType))
+			case UtilizationMediaTypes[interruptingType] == "":
+				errs = append(errs, fmt.Errorf("%s.interruptible_media_types: unknown media type %q, expected one of %s", schemaType, interruptingType, strings.Join(getSdkUtilizationTypes(), ", ")))
+			case configured && capacity == 0:
+				errs = append(errs, fmt.Errorf("%s.interruptible_media_types: %s has a maximum_capacity of 0 and can never interrupt %s", schemaType, interruptingType, schemaType))
+			}
+		}
+	}
+
+	labelUtilizations, _ := settings["label_utilizations"].([]interface{})
+	labelCapacities := make(map[string]int)
+	labelInterruptions := make(map[string][]string)
+	for _, labelUtilization := range labelUtilizations {
+		labelMap, ok := labelUtilization.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		labelId, _ := labelMap["label_id"].(string)
+		if labelId == "" {
+			continue
+		}
+		if _, duplicate := labelCapacities[labelId]; duplicate {
+			errs = append(errs, fmt.Errorf("label_utilizations: label %s is configured more than once", labelId))
+			continue
+		}
+		labelCapacities[labelId], _ = labelMap["maximum_capacity"].(int)
+		labelInterruptions[labelId] = setToSortedStrings(labelMap["interrupting_label_ids"])
+	}
+
+	labelIds := make([]string, 0, len(labelCapacities))
+	for labelId := range labelCapacities {
+		labelIds = append(labelIds, labelId)
+	}
+	sort.Strings(labelIds)
+
+	for _, labelId := range labelIds {
+		for _, interruptingId := range labelInterruptions[labelId] {
+			if interruptingId == labelId {
+				errs = append(errs, fmt.Errorf("label_utilizations: label %s cannot interrupt itself", labelId))
+			} else if capacity, configured := labelCapacities[interruptingId]; configured && capacity == 0 {
+				errs = append(errs, fmt.Errorf("label_utilizations: label %s has a maximum_capacity of 0 and can never interrupt label %s", interruptingId, labelId))
+			}
+		}
+	}
+
+	for _, cycle := range findInterruptionCycles(labelIds, labelInterruptions) {
+		errs = append(errs, fmt.Errorf("label_utilizations: interrupting_label_ids form a cycle: %s", strings.Join(cycle, " -> ")))
+	}
+
+	return errors.Join(errs...)
+}
+
+// findInterruptionCycles returns the cycles of the interrupting labels graph, each starting and ending with the same label.
+// Labels interrupting themselves are reported separately and are not part of the cycles.
+func findInterruptionCycles(labelIds []string, interruptions map[string][]string) [][]string {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	var (
+		cycles [][]string
+		path   []string
+		state  = make(map[string]int, len(labelIds))
+	)
+
+	var visit func(labelId string)
+	visit = func(labelId string) {
+		state[labelId] = visiting
+		path = append(path, labelId)
+		for _, interruptingId := range interruptions[labelId] {
+			if interruptingId == labelId {
+				continue
+			}
+			if _, configured := interruptions[interruptingId]; !configured {
+				continue
+			}
+			switch state[interruptingId] {
+			case unvisited:
+				visit(interruptingId)
+			case visiting:
+				for i := len(path) - 1; i >= 0; i-- {
+					if path[i] == interruptingId {
+						cycle := append(append([]string{}, path[i:]...), interruptingId)
+						cycles = append(cycles, cycle)
+						break
+					}
+				}
+			}
+		}
+		path = path[:len(path)-1]
+		state[labelId] = visited
+	}
+
+	for _, labelId := range labelIds {
+		if state[labelId] == unvisited {
+			visit(labelId)
+		}
+	}
+	return cycles
+}
+
+// utilizationSettingsMap returns the settings of a media type block when it is configured
+func utilizationSettingsMap(value interface{}) (map[string]interface{}, bool) {
+	settings, ok := value.([]interface{})
+	if !ok || len(settings) == 0 || settings[0] == nil {
+		return nil, false
+	}
+	settingsMap, ok := settings[0].(map[string]interface{})
+	return settingsMap, ok
+}
+
+func setToSortedStrings(value interface{}) []string {
+	set, ok := value.(*schema.Set)
+	if !ok || set == nil {
+		return nil
+	}
+	values := make([]string, 0, set.Len())
+	for _, item := range set.List() {
+		if s, ok := item.(string); ok && s != "" {
+			values = append(values, s)
+		}
+	}
+	sort.Strings(values)
+	return values
+}
@@ -0,0 +1,79 @@
+package telephony_providers_edges_did_pool
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v154/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_telephony_providers_edges_did_pool_ranges.go file exposes the DID pool ranges
+so that other resources (e.g. genesyscloud_architect_ivr) can verify that their numbers belong to a DID pool.
+*/
+
+// DidPoolRange is an inclusive range of E.164 numbers
+type DidPoolRange struct {
+	Start string
+	End   string
+}
+
+// Contains returns true if the E.164 number falls within the range
+func (r DidPoolRange) Contains(number string) bool {
+	// Numbers of the same length in E.164 format sort lexically in numeric order
+	if len(number) != len(r.Start) || len(number) != len(r.End) {
+		return false
+	}
+	return number >= r.Start && number <= r.End
+}
+
+// GetDidPoolRanges returns the ranges of the DID pools with the given IDs, or of every DID pool in the org when no ID
+// is given. The DID pools are read from the API on every call.
+func GetDidPoolRanges(ctx context.Context, clientConfig *platformclientv2.Configuration, didPoolIds []string) ([]DidPoolRange, error) {
+	proxy := getTelephonyDidPoolProxy(clientConfig)
+
+	var didPools []platformclientv2.Didpool
+	if len(didPoolIds) == 0 {
+		allDidPools, _, err := proxy.getAllTelephonyDidPools(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read DID pools: %s", err)
+		}
+		didPools = *allDidPools
+	}
+	for _, id := range didPoolIds {
+		didPool, _, err := proxy.getTelephonyDidPoolById(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read DID pool %s: %s", id, err)
+		}
+		didPools = append(didPools, *didPool)
+	}
+
+	ranges := make([]DidPoolRange, 0, len(didPools))
+	for _, didPool := range didPools {
+		if didPool.StartPhoneNumber == nil || didPool.EndPhoneNumber == nil {
+			continue
+		}
+		didPoolRange, err := newDidPoolRange(*didPool.StartPhoneNumber, *didPool.EndPhoneNumber)
+		if err != nil {
+			log.Printf("Skipping DID pool %s: %s", *didPool.Id, err)
+			continue
+		}
+		ranges = append(ranges, didPoolRange)
+	}
+	return ranges, nil
+}
+
+func newDidPoolRange(start, end string) (DidPoolRange, error) {
+	utilE164 := util.NewUtilE164Service()
+	startE164, diagErr := utilE164.FormatAsValidE164Number(start)
+	if diagErr != nil {
+		return DidPoolRange{}, fmt.Errorf("invalid start_phone_number %s", start)
+	}
+	endE164, diagErr := utilE164.FormatAsValidE164Number(end)
+	if diagErr != nil {
+		return DidPoolRange{}, fmt.Errorf("invalid end_phone_number %s", end)
+	}
+	return DidPoolRange{Start: startE164, End: endE164}, nil
+}
@@ -36,6 +36,21 @@ type getUserByNameFunc func(ctx context.Context, p *userProxy, searchUser platfo
 type updateVoicemailUserpoliciesFunc func(ctx context.Context, p *userProxy, id string, policy *platformclientv2.Voicemailuserpolicy) (*platformclientv2.Voicemailuserpolicy, *platformclientv2.APIResponse, error)
 type getVoicemailUserpoliciesByIdFunc func(ctx context.Context, p *userProxy, id string) (*platformclientv2.Voicemailuserpolicy, *platformclientv2.APIResponse, error)
 type updatePasswordFunc func(ctx context.Context, p *userProxy, id string, password string) (*platformclientv2.APIResponse, error)
+type getUserRoleGrantsFunc func(ctx context.Context, p *userProxy, id string) (*[]platformclientv2.Authzgrant, *platformclientv2.APIResponse, error)
+type deleteUserRoleGrantFunc func(ctx context.Context, p *userProxy, id string, divisionId string, roleId string) (*platformclientv2.APIResponse, error)
+type getUserQueueIdsFunc func(ctx context.Context, p *userProxy, id string) ([]string, *platformclientv2.APIResponse, error)
+type deleteUserQueueMemberFunc func(ctx context.Context, p *userProxy, id string, queueId string) (*platformclientv2.APIResponse, error)
+type getUserSkillIdsFunc func(ctx context.Context, p *userProxy, id string) ([]string, *platformclientv2.APIResponse, error)
+type deleteUserSkillFunc func(ctx context.Context, p *userProxy, id string, skillId string) (*platformclientv2.APIResponse, error)
+type getUserStationsFunc func(ctx context.Context, p *userProxy, id string) (*platformclientv2.Userstations, *platformclientv2.APIResponse, error)
+type deleteUserAssociatedStationFunc func(ctx context.Context, p *userProxy, id string) (*platformclientv2.APIResponse, error)
+type deleteUserDefaultStationFunc func(ctx context.Context, p *userProxy, id string) (*platformclientv2.APIResponse, error)
+type createRetentionGroupFunc func(ctx context.Context, p *userProxy, name string) (*platformclientv2.Group, *platformclientv2.APIResponse, error)
+type getRetentionGroupFunc func(ctx context.Context, p *userProxy, id string) (*platformclientv2.Group, *platformclientv2.APIResponse, error)
+type updateRetentionGroupFunc func(ctx context.Context, p *userProxy, id string, name string) (*platformclientv2.Group, *platformclientv2.APIResponse, error)
+type deleteRetentionGroupFunc func(ctx context.Context, p *userProxy, id string) (*platformclientv2.APIResponse, error)
+type getRetentionGroupMembersFunc func(ctx context.Context, p *userProxy, id string) ([]platformclientv2.User, *platformclientv2.APIResponse, error)
+type addRetentionGroupMemberFunc func(ctx context.Context, p *userProxy, id string, userId string) (*platformclientv2.APIResponse, error)
 
 /*
 The userProxy struct holds all the methods responsible for making calls to
@@ -49,6 +64,8 @@ type userProxy struct {
 	userApi                           *platformclientv2.UsersApi
 	routingApi                        *platformclientv2.RoutingApi
 	voicemailApi                      *platformclientv2.VoicemailApi
+	authorizationApi                  *platformclientv2.AuthorizationApi
+	groupsApi                         *platformclientv2.GroupsApi
 	createUserAttr                    createUserFunc
 	GetAllUserAttr                    GetAllUserFunc
 	getUserIdByNameAttr               getUserIdByNameFunc
@@ -61,6 +78,21 @@ type userProxy struct {
 	updateVoicemailUserpoliciesAttr   updateVoicemailUserpoliciesFunc
 	getVoicemailUserpolicicesByIdAttr getVoicemailUserpoliciesByIdFunc
 	updatePasswordAttr                updatePasswordFunc
+	getUserRoleGrantsAttr             getUserRoleGrantsFunc
+	deleteUserRoleGrantAttr           deleteUserRoleGrantFunc
+	getUserQueueIdsAttr               getUserQueueIdsFunc
+	deleteUserQueueMemberAttr         deleteUserQueueMemberFunc
+	getUserSkillIdsAttr               getUserSkillIdsFunc
+	deleteUserSkillAttr               deleteUserSkillFunc
+	getUserStationsAttr               getUserStationsFunc
+	deleteUserAssociatedStationAttr   deleteUserAssociatedStationFunc
+	deleteUserDefaultStationAttr      deleteUserDefaultStationFunc
+	createRetentionGroupAttr          createRetentionGroupFunc
+	getRetentionGroupAttr             getRetentionGroupFunc
+	updateRetentionGroupAttr          updateRetentionGroupFunc
+	deleteRetentionGroupAttr          deleteRetentionGroupFunc
+	getRetentionGroupMembersAttr      getRetentionGroupMembersFunc
+	addRetentionGroupMemberAttr       addRetentionGroupMemberFunc
 	userCache                         rc.CacheInterface[platformclientv2.User] //Define the cache for user resource
 }
 
@@ -74,12 +106,16 @@ func newUserProxy(clientConfig *platformclientv2.Configuration) *userProxy {
 	userApi := platformclientv2.NewUsersApiWithConfig(clientConfig)      // NewUsersApiWithConfig creates an Genesyc Cloud API instance using the provided configuration
 	routingApi := platformclientv2.NewRoutingApiWithConfig(clientConfig) // NewRoutingApiWithConfig creates an Genesyc Cloud API instance using the provided configuration
 	voicemailApi := platformclientv2.NewVoicemailApiWithConfig(clientConfig)
+	authorizationApi := platformclientv2.NewAuthorizationApiWithConfig(clientConfig)
+	groupsApi := platformclientv2.NewGroupsApiWithConfig(clientConfig)
 	userCache := rc.NewResourceCache[platformclientv2.User]() // Create Cache for User resource
 	return &userProxy{
 		clientConfig:                      clientConfig,
 		userApi:                           userApi,
 		routingApi:                        routingApi,
 		voicemailApi:                      voicemailApi,
+		authorizationApi:                  authorizationApi,
+		groupsApi:                         groupsApi,
 		userCache:                         userCache,
 		createUserAttr:                    createUserFn,
 		GetAllUserAttr:                    GetAllUserFn,
@@ -93,6 +129,21 @@ func newUserProxy(clientConfig *platformclientv2.Configuration) *userProxy {
 		updateVoicemailUserpoliciesAttr:   updateVoicemailUserpoliciesFn,
 		getVoicemailUserpolicicesByIdAttr: getVoicemailUserpoliciesByUserIdFn,
 		updatePasswordAttr:                updatePasswordFn,
+		getUserRoleGrantsAttr:             getUserRoleGrantsFn,
+		deleteUserRoleGrantAttr:           deleteUserRoleGrantFn,
+		getUserQueueIdsAttr:               getUserQueueIdsFn,
+		deleteUserQueueMemberAttr:         deleteUserQueueMemberFn,
+		getUserSkillIdsAttr:               getUserSkillIdsFn,
+		deleteUserSkillAttr:               deleteUserSkillFn,
+		getUserStationsAttr:               getUserStationsFn,
+		deleteUserAssociatedStationAttr:   deleteUserAssociatedStationFn,
+		deleteUserDefaultStationAttr:      deleteUserDefaultStationFn,
+		createRetentionGroupAttr:          createRetentionGroupFn,
+		getRetentionGroupAttr:             getRetentionGroupFn,
+		updateRetentionGroupAttr:          updateRetentionGroupFn,
+		deleteRetentionGroupAttr:          deleteRetentionGroupFn,
+		getRetentionGroupMembersAttr:      getRetentionGroupMembersFn,
+		addRetentionGroupMemberAttr:       addRetentionGroupMemberFn,
 	}
 }
 
@@ -173,6 +224,81 @@ func (p *userProxy) updatePassword(ctx context.Context, userId string, newPasswo
 	return p.updatePasswordAttr(ctx, p, userId, newPassword)
 }
 
+// getUserRoleGrants returns the roles granted directly to a user
+func (p *userProxy) getUserRoleGrants(ctx context.Context, userId string) (*[]platformclientv2.Authzgrant, *platformclientv2.APIResponse, error) {
+	return p.getUserRoleGrantsAttr(ctx, p, userId)
+}
+
+// deleteUserRoleGrant removes a role granted to a user in a division
+func (p *userProxy) deleteUserRoleGrant(ctx context.Context, userId string, divisionId string, roleId string) (*platformclientv2.APIResponse, error) {
+	return p.deleteUserRoleGrantAttr(ctx, p, userId, divisionId, roleId)
+}
+
+// getUserQueueIds returns the IDs of the queues a user is a member of, joined or not
+func (p *userProxy) getUserQueueIds(ctx context.Context, userId string) ([]string, *platformclientv2.APIResponse, error) {
+	return p.getUserQueueIdsAttr(ctx, p, userId)
+}
+
+// deleteUserQueueMember removes a user from the members of a queue
+func (p *userProxy) deleteUserQueueMember(ctx context.Context, userId string, queueId string) (*platformclientv2.APIResponse, error) {
+	return p.deleteUserQueueMemberAttr(ctx, p, userId, queueId)
+}
+
+// getUserSkillIds returns the IDs of the routing skills of a user
+func (p *userProxy) getUserSkillIds(ctx context.Context, userId string) ([]string, *platformclientv2.APIResponse, error) {
+	return p.getUserSkillIdsAttr(ctx, p, userId)
+}
+
+// deleteUserSkill removes a routing skill from a user
+func (p *userProxy) deleteUserSkill(ctx context.Context, userId string, skillId string) (*platformclientv2.APIResponse, error) {
+	return p.deleteUserSkillAttr(ctx, p, userId, skillId)
+}
+
+// getUserStations returns the stations associated with a user
+func (p *userProxy) getUserStations(ctx context.Context, userId string) (*platformclientv2.Userstations, *platformclientv2.APIResponse, error) {
+	return p.getUserStationsAttr(ctx, p, userId)
+}
+
+// deleteUserAssociatedStation disassociates a user from the station they are logged in to
+func (p *userProxy) deleteUserAssociatedStation(ctx context.Context, userId string) (*platformclientv2.APIResponse, error) {
+	return p.deleteUserAssociatedStationAttr(ctx, p, userId)
+}
+
+// deleteUserDefaultStation clears the default station, the phone assigned to a user
+func (p *userProxy) deleteUserDefaultStation(ctx context.Context, userId string) (*platformclientv2.APIResponse, error) {
+	return p.deleteUserDefaultStationAttr(ctx, p, userId)
+}
+
+// createRetentionGroup creates the group holding the users kept by an offboarding retention
+func (p *userProxy) createRetentionGroup(ctx context.Context, name string) (*platformclientv2.Group, *platformclientv2.APIResponse, error) {
+	return p.createRetentionGroupAttr(ctx, p, name)
+}
+
+// getRetentionGroup retrieves the group of an offboarding retention
+func (p *userProxy) getRetentionGroup(ctx context.Context, id string) (*platformclientv2.Group, *platformclientv2.APIResponse, error) {
+	return p.getRetentionGroupAttr(ctx, p, id)
+}
+
+// updateRetentionGroup renames the group of an offboarding retention
+func (p *userProxy) updateRetentionGroup(ctx context.Context, id string, name string) (*platformclientv2.Group, *platformclientv2.APIResponse, error) {
+	return p.updateRetentionGroupAttr(ctx, p, id, name)
+}
+
+// deleteRetentionGroup deletes the group of an offboarding retention
+func (p *userProxy) deleteRetentionGroup(ctx context.Context, id string) (*platformclientv2.APIResponse, error) {
+	return p.deleteRetentionGroupAttr(ctx, p, id)
+}
+
+// getRetentionGroupMembers retrieves the users kept by an offboarding retention
+func (p *userProxy) getRetentionGroupMembers(ctx context.Context, id string) ([]platformclientv2.User, *platformclientv2.APIResponse, error) {
+	return p.getRetentionGroupMembersAttr(ctx, p, id)
+}
+
+// addRetentionGroupMember adds a user to the users kept by an offboarding retention
+func (p *userProxy) addRetentionGroupMember(ctx context.Context, id string, userId string) (*platformclientv2.APIResponse, error) {
+	return p.addRetentionGroupMemberAttr(ctx, p, id, userId)
+}
+
 // createUserFn is an implementation function for creating a Genesys Cloud user
 func createUserFn(ctx context.Context, p *userProxy, createUser *platformclientv2.Createuser) (*platformclientv2.User, *platformclientv2.APIResponse, error) {
 	return p.userApi.PostUsers(*createUser)
@@ -314,3 +440,144 @@ func updatePasswordFn(ctx context.Context, p *userProxy, userId string, newPassw
 		NewPassword: &newPassword,
 	})
 }
+
+// getUserRoleGrantsFn is an implementation of the function to get the roles granted directly to a user. Grants inherited from groups are left out.
+func getUserRoleGrantsFn(ctx context.Context, p *userProxy, userId string) (*[]platformclientv2.Authzgrant, *platformclientv2.APIResponse, error) {
+	subject, resp, err := p.authorizationApi.GetAuthorizationSubject(userId, true)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	grants := make([]platformclientv2.Authzgrant, 0)
+	if subject.Grants != nil {
+		for _, grant := range *subject.Grants {
+			if grant.SubjectId != nil && *grant.SubjectId == userId {
+				grants = append(grants, grant)
+			}
+		}
+	}
+	return &grants, resp, nil
+}
+
+func deleteUserRoleGrantFn(ctx context.Context, p *userProxy, userId string, divisionId string, roleId string) (*platformclientv2.APIResponse, error) {
+	return p.authorizationApi.DeleteAuthorizationSubjectDivisionRole(userId, divisionId, roleId)
+}
+
+// getUserQueueIdsFn is an implementation of the function to get the queues of a user. The API only returns the queues the user
+// has joined or the queues they have not joined, so both are read.
+func getUserQueueIdsFn(ctx context.Context, p *userProxy, userId string) ([]string, *platformclientv2.APIResponse, error) {
+	const pageSize = 100
+	var queueIds []string
+	var resp *platformclientv2.APIResponse
+	for _, joined := range []bool{true, false} {
+		for pageNum := 1; ; pageNum++ {
+			queues, apiResponse, err := p.userApi.GetUserQueues(userId, pageSize, pageNum, joined, nil)
+			resp = apiResponse
+			if err != nil {
+				return nil, resp, err
+			}
+			if queues.Entities == nil || len(*queues.Entities) == 0 {
+				break
+			}
+			for _, queue := range *queues.Entities {
+				queueIds = append(queueIds, *queue.Id)
+			}
+			if queues.PageCount == nil || pageNum >= *queues.PageCount {
+				break
+			}
+		}
+	}
+	return queueIds, resp, nil
+}
+
+func deleteUserQueueMemberFn(ctx context.Context, p *userProxy, userId string, queueId string) (*platformclientv2.APIResponse, error) {
+	return p.routingApi.DeleteRoutingQueueMember(queueId, userId)
+}
+
+func getUserSkillIdsFn(ctx context.Context, p *userProxy, userId string) ([]string, *platformclientv2.APIResponse, error) {
+	skills, diagErr := getUserRoutingSkills(userId, p)
+	if diagErr != nil {
+		return nil, nil, fmt.Errorf("%v", diagErr)
+	}
+	skillIds := make([]string, 0, len(skills))
+	for _, skill := range skills {
+		skillIds = append(skillIds, *skill.Id)
+	}
+	return skillIds, nil, nil
+}
+
+func deleteUserSkillFn(ctx context.Context, p *userProxy, userId string, skillId string) (*platformclientv2.APIResponse, error) {
+	return p.userApi.DeleteUserRoutingskill(userId, skillId)
+}
+
+func getUserStationsFn(ctx context.Context, p *userProxy, userId string) (*platformclientv2.Userstations, *platformclientv2.APIResponse, error) {
+	return p.userApi.GetUserStation(userId)
+}
+
+func deleteUserAssociatedStationFn(ctx context.Context, p *userProxy, userId string) (*platformclientv2.APIResponse, error) {
+	return p.userApi.DeleteUserStationAssociatedstation(userId)
+}
+
+func deleteUserDefaultStationFn(ctx context.Context, p *userProxy, userId string) (*platformclientv2.APIResponse, error) {
+	return p.userApi.DeleteUserStationDefaultstation(userId)
+}
+
+func createRetentionGroupFn(_ context.Context, p *userProxy, name string) (*platformclientv2.Group, *platformclientv2.APIResponse, error) {
+	return p.groupsApi.PostGroups(platformclientv2.Groupcreate{
+		Name:         &name,
+		VarType:      platformclientv2.String("official"),
+		Visibility:   platformclientv2.String("owners"),
+		RulesVisible: platformclientv2.Bool(false),
+	})
+}
+
+func getRetentionGroupFn(_ context.Context, p *userProxy, id string) (*platformclientv2.Group, *platformclientv2.APIResponse, error) {
+	return p.groupsApi.GetGroup(id)
+}
+
+func updateRetentionGroupFn(_ context.Context, p *userProxy, id string, name string) (*platformclientv2.Group, *platformclientv2.APIResponse, error) {
+	group, resp, err := p.groupsApi.GetGroup(id)
+	if err != nil {
+		return nil, resp, err
+	}
+	return p.groupsApi.PutGroup(id, platformclientv2.Groupupdate{
+		Name:         &name,
+		Version:      group.Version,
+		Visibility:   group.Visibility,
+		RulesVisible: group.RulesVisible,
+	})
+}
+
+func deleteRetentionGroupFn(_ context.Context, p *userProxy, id string) (*platformclientv2.APIResponse, error) {
+	return p.groupsApi.DeleteGroup(id)
+}
+
+func getRetentionGroupMembersFn(_ context.Context, p *userProxy, id string) ([]platformclientv2.User, *platformclientv2.APIResponse, error) {
+	const pageSize = 100
+	var members []platformclientv2.User
+	for pageNum := 1; ; pageNum++ {
+		users, resp, err := p.groupsApi.GetGroupMembers(id, pageSize, pageNum, "", nil)
+		if err != nil {
+			return nil, resp, err
+		}
+		if users.Entities == nil || len(*users.Entities) == 0 {
+			return members, resp, nil
+		}
+		members = append(members, *users.Entities...)
+		if users.PageCount == nil || pageNum >= *users.PageCount {
+			return members, resp, nil
+		}
+	}
+}
+
+func addRetentionGroupMemberFn(_ context.Context, p *userProxy, id string, userId string) (*platformclientv2.APIResponse, error) {
+	group, resp, err := p.groupsApi.GetGroup(id)
+	if err != nil {
+		return resp, err
+	}
+	_, resp, err = p.groupsApi.PostGroupMembers(id, platformclientv2.Groupmembersupdate{
+		MemberIds: &[]string{userId},
+		Version:   group.Version,
+	})
+	return resp, err
+}
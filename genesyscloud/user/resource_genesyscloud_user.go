@@ -126,6 +126,12 @@ func createUser(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 		return diagErr
 	}
 
+	if d.Get("offboarded").(bool) {
+		if diagErr := recordUserOffboarding(ctx, d, proxy); diagErr != nil {
+			return diagErr
+		}
+	}
+
 	log.Printf("Created user %s %s", email, *userResponse.Id)
 	return readUser(ctx, d, meta)
 }
@@ -169,7 +175,7 @@ func readUser(ctx context.Context, d *schema.ResourceData, meta interface{}) dia
 			d.Set("manager", nil)
 		}
 		d.Set("addresses", flattenUserAddresses(d, currentUser.Addresses))
-		d.Set("routing_skills", flattenUserSkills(currentUser.Skills))
+		d.Set("routing_skills", flattenUserSkillsWithoutPolicySkills(d, currentUser.Skills))
 		d.Set("routing_languages", flattenUserLanguages(currentUser.Languages))
 		d.Set("locations", flattenUserLocations(currentUser.Locations))
 		d.Set("profile_skills", flattenUserData(currentUser.ProfileSkills))
@@ -208,8 +214,15 @@ func updateUser(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 
 	log.Printf("Updating user %s", email)
 
+	offboarded := d.Get("offboarded").(bool)
+	if !offboarded && d.HasChange("offboarded") {
+		if diagErr := reactivateUser(ctx, d, proxy); diagErr != nil {
+			return diagErr
+		}
+	}
+
 	// If state changes, it is the only modifiable field, so it must be updated separately
-	if d.HasChange("state") {
+	if d.HasChange("state") && !offboarded {
 		log.Printf("Updating state for user %s", email)
 		updateUser := platformclientv2.Updateuser{
 			State: platformclientv2.String(d.Get("state").(string)),
@@ -237,6 +250,16 @@ func updateUser(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 		return diagErr
 	}
 
+	if offboarded {
+		if d.HasChange("offboarded") {
+			if diagErr := recordUserOffboarding(ctx, d, proxy); diagErr != nil {
+				return diagErr
+			}
+		}
+		log.Printf("Finished updating offboarded user %s", email)
+		return readUser(ctx, d, meta)
+	}
+
 	diagErr = executeAllUpdates(ctx, d, proxy, sdkConfig, true)
 	if diagErr != nil {
 		return diagErr
@@ -252,6 +275,27 @@ func deleteUser(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 
 	email := d.Get("email").(string)
 
+	if settings, ok := getOffboardingSettings(d); ok {
+		// The user is handed to the retention first, as an inactive user can still be added to its group
+		if settings.retentionId != "" {
+			if diagErr := retainOffboardedUser(ctx, proxy, settings.retentionId, d.Id()); diagErr != nil {
+				return diagErr
+			}
+		}
+		if !d.Get("offboarded").(bool) {
+			// The audit of an offboarding run on destroy cannot be saved to the state, so it is logged instead
+			audit, diagErr := offboardUser(ctx, proxy, d.Id(), settings)
+			log.Printf("Offboarding audit of user %s:\n%s", email, formatOffboardingAudit(audit))
+			if diagErr != nil {
+				return diagErr
+			}
+		}
+		if settings.retentionId != "" {
+			log.Printf("User %s is offboarded and kept by user offboarding retention %s", email, settings.retentionId)
+			return nil
+		}
+	}
+
 	log.Printf("Deleting user %s", email)
 	err := util.RetryWhen(util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
 		// Directory occasionally returns version errors on deletes if an object was updated at the same time.
@@ -0,0 +1,302 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v154/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_user_offboarding.go file contains the offboarding lifecycle of genesyscloud_user. Offboarding a user
+strips the access and routing set up for them (role grants, queue memberships, skills, station and phone) and deactivates
+them, so their history is kept rather than deleted. A user is offboarded in place by setting offboarded, or when the resource
+is destroyed with an offboarding block, in which case the user is deleted right away or handed to the retention of the block.
+Every step of an offboarding is recorded in the offboarding_audit attribute.
+*/
+
+const (
+	offboardingStepRemoveRoles            = "remove_roles"
+	offboardingStepRemoveQueueMemberships = "remove_queue_memberships"
+	offboardingStepRemoveSkills           = "remove_skills"
+	offboardingStepRemoveStation          = "remove_station"
+	offboardingStepRemovePhones           = "remove_phones"
+	offboardingStepDeactivate             = "deactivate"
+	offboardingStepReactivate             = "reactivate"
+
+	offboardingStatusCompleted = "completed"
+	offboardingStatusSkipped   = "skipped"
+	offboardingStatusFailed    = "failed"
+)
+
+// offboardingSteps are the steps of an offboarding in the order they run. The user is deactivated last so that a failed
+// offboarding can be retried while they can still be managed.
+var offboardingSteps = []string{
+	offboardingStepRemoveRoles,
+	offboardingStepRemoveQueueMemberships,
+	offboardingStepRemoveSkills,
+	offboardingStepRemoveStation,
+	offboardingStepRemovePhones,
+	offboardingStepDeactivate,
+}
+
+type offboardingStepFunc func(ctx context.Context, proxy *userProxy, userId string) (string, error)
+
+var offboardingStepFuncs = map[string]offboardingStepFunc{
+	offboardingStepRemoveRoles:            removeUserRoleGrants,
+	offboardingStepRemoveQueueMemberships: removeUserQueueMemberships,
+	offboardingStepRemoveSkills:           removeUserSkills,
+	offboardingStepRemoveStation:          removeUserAssociatedStation,
+	offboardingStepRemovePhones:           removeUserDefaultStation,
+	offboardingStepDeactivate:             deactivateUser,
+}
+
+// offboardingNow returns the time recorded in the audit of an offboarding
+var offboardingNow = func() time.Time { return time.Now().UTC() }
+
+// offboardingSettings are the steps enabled by the offboarding block of a user and the retention keeping them once destroyed
+type offboardingSettings struct {
+	steps       map[string]bool
+	retentionId string
+}
+
+// offboardingAuditEntry is an item of the offboarding_audit attribute
+type offboardingAuditEntry struct {
+	step   string
+	status string
+	time   string
+	detail string
+}
+
+// getOffboardingSettings returns the offboarding settings of the user and whether the offboarding block is set.
+// Every step is enabled when it is not set.
+func getOffboardingSettings(d *schema.ResourceData) (offboardingSettings, bool) {
+	settings := offboardingSettings{steps: make(map[string]bool)}
+	for _, step := range offboardingSteps {
+		settings.steps[step] = true
+	}
+
+	offboarding, _ := d.Get("offboarding").([]interface{})
+	if len(offboarding) == 0 || offboarding[0] == nil {
+		return settings, false
+	}
+	offboardingMap := offboarding[0].(map[string]interface{})
+	for _, step := range offboardingSteps {
+		settings.steps[step] = offboardingMap[step].(bool)
+	}
+	settings.retentionId = offboardingMap["retention_id"].(string)
+	return settings, true
+}
+
+// offboardUser runs the enabled offboarding steps and returns the audit of every step. It stops at the first step that fails.
+func offboardUser(ctx context.Context, proxy *userProxy, userId string, settings offboardingSettings) ([]offboardingAuditEntry, diag.Diagnostics) {
+	log.Printf("Offboarding user %s", userId)
+	var audit []offboardingAuditEntry
+	for _, step := range offboardingSteps {
+		if !settings.steps[step] {
+			audit = append(audit, newOffboardingAuditEntry(step, offboardingStatusSkipped, "disabled in the offboarding settings"))
+			continue
+		}
+
+		detail, err := offboardingStepFuncs[step](ctx, proxy, userId)
+		if err != nil {
+			audit = append(audit, newOffboardingAuditEntry(step, offboardingStatusFailed, err.Error()))
+			return audit, util.BuildDiagnosticError(ResourceType, fmt.Sprintf("Failed to offboard user %s at step %s", userId, step), err)
+		}
+		audit = append(audit, newOffboardingAuditEntry(step, offboardingStatusCompleted, detail))
+	}
+	log.Printf("Offboarded user %s", userId)
+	return audit, nil
+}
+
+// recordUserOffboarding offboards the user in place and appends the steps to its offboarding_audit
+func recordUserOffboarding(ctx context.Context, d *schema.ResourceData, proxy *userProxy) diag.Diagnostics {
+	settings, _ := getOffboardingSettings(d)
+	audit, diagErr := offboardUser(ctx, proxy, d.Id(), settings)
+	_ = d.Set("offboarding_audit", appendOffboardingAudit(d, audit))
+	if diagErr != nil {
+		return diagErr
+	}
+	_ = d.Set("offboarded_at", offboardingNow().Format(time.RFC3339))
+	return nil
+}
+
+// reactivateUser sets the configured state of an offboarded user back, restores the skills of the configuration
+// and records the reactivation in its offboarding_audit
+func reactivateUser(ctx context.Context, d *schema.ResourceData, proxy *userProxy) diag.Diagnostics {
+	state := d.Get("state").(string)
+	log.Printf("Reactivating offboarded user %s", d.Id())
+
+	diagErr := setUserState(ctx, proxy, d.Id(), state)
+	if diagErr == nil {
+		diagErr = syncConfiguredUserSkills(d, proxy)
+	}
+	if diagErr != nil {
+		_ = d.Set("offboarding_audit", appendOffboardingAudit(d, []offboardingAuditEntry{newOffboardingAuditEntry(offboardingStepReactivate, offboardingStatusFailed, fmt.Sprintf("%v", diagErr))}))
+		return diagErr
+	}
+
+	_ = d.Set("offboarding_audit", appendOffboardingAudit(d, []offboardingAuditEntry{newOffboardingAuditEntry(offboardingStepReactivate, offboardingStatusCompleted, "state set to "+state)}))
+	_ = d.Set("offboarded_at", "")
+	return nil
+}
+
+// formatOffboardingAudit renders the audit of an offboarding run on destroy, as it can no longer be saved to the state
+func formatOffboardingAudit(audit []offboardingAuditEntry) string {
+	lines := make([]string, 0, len(audit))
+	for _, entry := range audit {
+		line := fmt.Sprintf("%s %s: %s", entry.time, entry.step, entry.status)
+		if entry.detail != "" {
+			line += " (" + entry.detail + ")"
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func newOffboardingAuditEntry(step string, status string, detail string) offboardingAuditEntry {
+	return offboardingAuditEntry{
+		step:   step,
+		status: status,
+		time:   offboardingNow().Format(time.RFC3339),
+		detail: detail,
+	}
+}
+
+func appendOffboardingAudit(d *schema.ResourceData, audit []offboardingAuditEntry) []interface{} {
+	entries, _ := d.Get("offboarding_audit").([]interface{})
+	for _, entry := range audit {
+		entries = append(entries, map[string]interface{}{
+			"step":   entry.step,
+			"status": entry.status,
+			"time":   entry.time,
+			"detail": entry.detail,
+		})
+	}
+	return entries
+}
+
+func removeUserRoleGrants(ctx context.Context, proxy *userProxy, userId string) (string, error) {
+	grants, _, err := proxy.getUserRoleGrants(ctx, userId)
+	if err != nil {
+		return "", fmt.Errorf("failed to read the role grants: %s", err)
+	}
+	// Grants removed since they were read return a 404, which can be ignored
+	for _, grant := range *grants {
+		if grant.Role == nil || grant.Division == nil {
+			continue
+		}
+		resp, err := proxy.deleteUserRoleGrant(ctx, userId, *grant.Division.Id, *grant.Role.Id)
+		if err != nil && !util.IsStatus404(resp) {
+			return "", fmt.Errorf("failed to remove role %s in division %s: %s", *grant.Role.Id, *grant.Division.Id, err)
+		}
+	}
+	return fmt.Sprintf("removed %d role grants", len(*grants)), nil
+}
+
+func removeUserQueueMemberships(ctx context.Context, proxy *userProxy, userId string) (string, error) {
+	queueIds, _, err := proxy.getUserQueueIds(ctx, userId)
+	if err != nil {
+		return "", fmt.Errorf("failed to read the queue memberships: %s", err)
+	}
+	for _, queueId := range queueIds {
+		resp, err := proxy.deleteUserQueueMember(ctx, userId, queueId)
+		if err != nil && !util.IsStatus404(resp) {
+			return "", fmt.Errorf("failed to remove the user from queue %s: %s", queueId, err)
+		}
+	}
+	return fmt.Sprintf("removed from %d queues", len(queueIds)), nil
+}
+
+func removeUserSkills(ctx context.Context, proxy *userProxy, userId string) (string, error) {
+	skillIds, _, err := proxy.getUserSkillIds(ctx, userId)
+	if err != nil {
+		return "", fmt.Errorf("failed to read the skills: %s", err)
+	}
+	for _, skillId := range skillIds {
+		diagErr := util.RetryWhen(util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+			resp, err := proxy.deleteUserSkill(ctx, userId, skillId)
+			if err != nil && !util.IsStatus404(resp) {
+				return resp, util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("failed to remove skill %s: %s", skillId, err), resp)
+			}
+			return nil, nil
+		})
+		if diagErr != nil {
+			return "", fmt.Errorf("%v", diagErr)
+		}
+	}
+	return fmt.Sprintf("removed %d skills", len(skillIds)), nil
+}
+
+func removeUserAssociatedStation(ctx context.Context, proxy *userProxy, userId string) (string, error) {
+	stations, _, err := proxy.getUserStations(ctx, userId)
+	if err != nil {
+		return "", fmt.Errorf("failed to read the stations: %s", err)
+	}
+	if stations.AssociatedStation == nil || stations.AssociatedStation.Id == nil {
+		return "no station associated", nil
+	}
+	resp, err := proxy.deleteUserAssociatedStation(ctx, userId)
+	if err != nil && !util.IsStatus404(resp) {
+		return "", fmt.Errorf("failed to disassociate station %s: %s", *stations.AssociatedStation.Id, err)
+	}
+	return "disassociated from station " + *stations.AssociatedStation.Id, nil
+}
+
+func removeUserDefaultStation(ctx context.Context, proxy *userProxy, userId string) (string, error) {
+	stations, _, err := proxy.getUserStations(ctx, userId)
+	if err != nil {
+		return "", fmt.Errorf("failed to read the stations: %s", err)
+	}
+	if stations.DefaultStation == nil || stations.DefaultStation.Id == nil {
+		return "no phone assigned", nil
+	}
+	resp, err := proxy.deleteUserDefaultStation(ctx, userId)
+	if err != nil && !util.IsStatus404(resp) {
+		return "", fmt.Errorf("failed to clear default station %s: %s", *stations.DefaultStation.Id, err)
+	}
+	return "cleared default station " + *stations.DefaultStation.Id, nil
+}
+
+func deactivateUser(ctx context.Context, proxy *userProxy, userId string) (string, error) {
+	if diagErr := setUserState(ctx, proxy, userId, "inactive"); diagErr != nil {
+		return "", fmt.Errorf("%v", diagErr)
+	}
+	return "state set to inactive", nil
+}
+
+// setUserState patches the state of a user, which can only be updated on its own
+func setUserState(ctx context.Context, proxy *userProxy, userId string, state string) diag.Diagnostics {
+	return util.RetryWhen(util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+		currentUser, resp, err := proxy.getUserById(ctx, userId, nil, "")
+		if err != nil {
+			return resp, util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to read user %s error: %s", userId, err), resp)
+		}
+
+		_, resp, err = proxy.patchUserWithState(ctx, userId, &platformclientv2.Updateuser{
+			State:   &state,
+			Version: currentUser.Version,
+		})
+		if err != nil {
+			return resp, util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to set the state of user %s to %s error: %s", userId, state, err), resp)
+		}
+		return resp, nil
+	})
+}
+
+// userOffboardedChanged is true when the user is being offboarded or reactivated, which adds to its offboarding_audit
+func userOffboardedChanged(_ context.Context, d *schema.ResourceDiff, _ interface{}) bool {
+	return d.Id() != "" && d.HasChange("offboarded")
+}
+
+// suppressWhileOffboarded keeps the state and skills removed from an offboarded user from being planned back, as they are
+// only applied again when the user is reactivated
+func suppressWhileOffboarded(_, _, _ string, d *schema.ResourceData) bool {
+	return d.Id() != "" && d.Get("offboarded").(bool)
+}
@@ -0,0 +1,250 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v154/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_user_offboarding_retention.go file contains the methods of the genesyscloud_user_offboarding_retention
+resource. A retention keeps the users destroyed with an offboarding block referencing it in a group it owns, inactive, and
+deletes them on the first apply after their retention period is over. A user's retention period starts when the retention
+first reads them as a member of its group.
+*/
+
+// retainedUser is an item of the retained_users attribute
+type retainedUser struct {
+	userId      string
+	email       string
+	retainedAt  time.Time
+	deleteAfter time.Time
+}
+
+func createUserOffboardingRetention(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := GetUserProxy(sdkConfig)
+	name := d.Get("name").(string)
+
+	log.Printf("Creating user offboarding retention %s", name)
+	group, resp, err := proxy.createRetentionGroup(ctx, name)
+	if err != nil {
+		return util.BuildAPIDiagnosticError(RetentionResourceType, fmt.Sprintf("Failed to create user offboarding retention %s error: %s", name, err), resp)
+	}
+
+	d.SetId(*group.Id)
+	log.Printf("Created user offboarding retention %s %s", name, d.Id())
+	return readUserOffboardingRetention(ctx, d, meta)
+}
+
+func readUserOffboardingRetention(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := GetUserProxy(sdkConfig)
+
+	log.Printf("Reading user offboarding retention %s", d.Id())
+	return util.WithRetriesForRead(ctx, d, func() *retry.RetryError {
+		group, resp, getErr := proxy.getRetentionGroup(ctx, d.Id())
+		if getErr != nil {
+			if util.IsStatus404(resp) {
+				return retry.RetryableError(util.BuildWithRetriesApiDiagnosticError(RetentionResourceType, fmt.Sprintf("Failed to read user offboarding retention %s | error: %s", d.Id(), getErr), resp))
+			}
+			return retry.NonRetryableError(util.BuildWithRetriesApiDiagnosticError(RetentionResourceType, fmt.Sprintf("Failed to read user offboarding retention %s | error: %s", d.Id(), getErr), resp))
+		}
+
+		members, resp, getErr := proxy.getRetentionGroupMembers(ctx, d.Id())
+		if getErr != nil {
+			return retry.NonRetryableError(util.BuildWithRetriesApiDiagnosticError(RetentionResourceType, fmt.Sprintf("Failed to read the users of user offboarding retention %s | error: %s", d.Id(), getErr), resp))
+		}
+
+		if group.Name != nil {
+			_ = d.Set("name", *group.Name)
+		}
+		retained := buildRetainedUsers(members, getRetainedUsers(d), d.Get("retention_days").(int), offboardingNow())
+		_ = d.Set("retained_users", flattenRetainedUsers(retained))
+
+		log.Printf("Read user offboarding retention %s", d.Id())
+		return nil
+	})
+}
+
+func updateUserOffboardingRetention(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := GetUserProxy(sdkConfig)
+
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		log.Printf("Updating user offboarding retention %s", name)
+		diagErr := util.RetryWhen(util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+			_, resp, err := proxy.updateRetentionGroup(ctx, d.Id(), name)
+			if err != nil {
+				return resp, util.BuildAPIDiagnosticError(RetentionResourceType, fmt.Sprintf("Failed to update user offboarding retention %s error: %s", name, err), resp)
+			}
+			return resp, nil
+		})
+		if diagErr != nil {
+			return diagErr
+		}
+	}
+
+	// The retained users are those of the state before the plan, with the retention days of the configuration. The users
+	// that are kept are set back so that the read keeps the start of their retention period.
+	oldRetained, _ := d.GetChange("retained_users")
+	retained := make([]retainedUser, 0)
+	for _, user := range expandRetainedUsers(oldRetained.([]interface{})) {
+		user.deleteAfter = user.retainedAt.AddDate(0, 0, d.Get("retention_days").(int))
+		retained = append(retained, user)
+	}
+	kept, diagErr := deleteExpiredRetainedUsers(ctx, proxy, retained, offboardingNow())
+	_ = d.Set("retained_users", flattenRetainedUsers(kept))
+	if diagErr != nil {
+		return diagErr
+	}
+
+	log.Printf("Updated user offboarding retention %s", d.Id())
+	return readUserOffboardingRetention(ctx, d, meta)
+}
+
+func deleteUserOffboardingRetention(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := GetUserProxy(sdkConfig)
+
+	// The retained users are left inactive, only the group holding them is deleted
+	log.Printf("Deleting user offboarding retention %s", d.Id())
+	resp, err := proxy.deleteRetentionGroup(ctx, d.Id())
+	if err != nil && !util.IsStatus404(resp) {
+		return util.BuildAPIDiagnosticError(RetentionResourceType, fmt.Sprintf("Failed to delete user offboarding retention %s error: %s", d.Id(), err), resp)
+	}
+	log.Printf("Deleted user offboarding retention %s", d.Id())
+	return nil
+}
+
+// customizeUserOffboardingRetentionDiff plans an update when the retention period of a retained user is over, as the
+// update deletes them
+func customizeUserOffboardingRetentionDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+	if diff.HasChange("retention_days") {
+		return diff.SetNewComputed("retained_users")
+	}
+	retained, _ := diff.Get("retained_users").([]interface{})
+	now := offboardingNow()
+	for _, user := range expandRetainedUsers(retained) {
+		if !now.Before(user.deleteAfter) {
+			return diff.SetNewComputed("retained_users")
+		}
+	}
+	return nil
+}
+
+// deleteExpiredRetainedUsers deletes the retained users whose retention period is over and returns the users that are
+// kept. Users deleted since they were read are ignored.
+func deleteExpiredRetainedUsers(ctx context.Context, proxy *userProxy, retained []retainedUser, now time.Time) ([]retainedUser, diag.Diagnostics) {
+	kept := make([]retainedUser, 0, len(retained))
+	for i, user := range retained {
+		if now.Before(user.deleteAfter) {
+			kept = append(kept, user)
+			continue
+		}
+		log.Printf("Deleting user %s at the end of its retention period", user.userId)
+		diagErr := util.RetryWhen(util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+			_, resp, err := proxy.deleteUser(ctx, user.userId)
+			if err != nil && !util.IsStatus404(resp) {
+				return resp, util.BuildAPIDiagnosticError(RetentionResourceType, fmt.Sprintf("Failed to delete retained user %s error: %s", user.userId, err), resp)
+			}
+			return nil, nil
+		})
+		if diagErr != nil {
+			return append(kept, retained[i:]...), diagErr
+		}
+	}
+	return kept, nil
+}
+
+// buildRetainedUsers returns the inactive members of the retention group. Members already retained keep the time they
+// were first read, the others are retained from now. Members reactivated outside of Terraform are no longer retained.
+func buildRetainedUsers(members []platformclientv2.User, previous []retainedUser, retentionDays int, now time.Time) []retainedUser {
+	retainedAt := make(map[string]time.Time, len(previous))
+	for _, user := range previous {
+		retainedAt[user.userId] = user.retainedAt
+	}
+
+	retained := make([]retainedUser, 0, len(members))
+	for _, member := range members {
+		if member.Id == nil {
+			continue
+		}
+		if member.State != nil && *member.State != "inactive" {
+			log.Printf("User %s is %s and is not retained", *member.Id, *member.State)
+			continue
+		}
+		user := retainedUser{userId: *member.Id, retainedAt: now}
+		if member.Email != nil {
+			user.email = *member.Email
+		}
+		if at, ok := retainedAt[user.userId]; ok {
+			user.retainedAt = at
+		}
+		user.deleteAfter = user.retainedAt.AddDate(0, 0, retentionDays)
+		retained = append(retained, user)
+	}
+	sort.Slice(retained, func(i, j int) bool { return retained[i].userId < retained[j].userId })
+	return retained
+}
+
+func getRetainedUsers(d *schema.ResourceData) []retainedUser {
+	retained, _ := d.Get("retained_users").([]interface{})
+	return expandRetainedUsers(retained)
+}
+
+func expandRetainedUsers(retained []interface{}) []retainedUser {
+	users := make([]retainedUser, 0, len(retained))
+	for _, item := range retained {
+		userMap, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		user := retainedUser{
+			userId: userMap["user_id"].(string),
+			email:  userMap["email"].(string),
+		}
+		user.retainedAt, _ = time.Parse(time.RFC3339, userMap["retained_at"].(string))
+		user.deleteAfter, _ = time.Parse(time.RFC3339, userMap["delete_after"].(string))
+		users = append(users, user)
+	}
+	return users
+}
+
+func flattenRetainedUsers(retained []retainedUser) []interface{} {
+	users := make([]interface{}, 0, len(retained))
+	for _, user := range retained {
+		users = append(users, map[string]interface{}{
+			"user_id":      user.userId,
+			"email":        user.email,
+			"retained_at":  user.retainedAt.Format(time.RFC3339),
+			"delete_after": user.deleteAfter.Format(time.RFC3339),
+		})
+	}
+	return users
+}
+
+// retainOffboardedUser adds a destroyed user to the users kept by the retention, which deletes them once their
+// retention period is over
+func retainOffboardedUser(ctx context.Context, proxy *userProxy, retentionId string, userId string) diag.Diagnostics {
+	return util.RetryWhen(util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+		resp, err := proxy.addRetentionGroupMember(ctx, retentionId, userId)
+		if err != nil {
+			return resp, util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to add user %s to user offboarding retention %s error: %s", userId, retentionId, err), resp)
+		}
+		return resp, nil
+	})
+}
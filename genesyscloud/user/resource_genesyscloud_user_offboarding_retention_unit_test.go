@@ -0,0 +1,100 @@
+package user
+
+import (
+	"context"
+	"net/http"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v154/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitBuildRetainedUsers(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	retainedAt := now.AddDate(0, 0, -10)
+	members := []platformclientv2.User{
+		{Id: platformclientv2.String("user-2"), Email: platformclientv2.String("new@example.com"), State: platformclientv2.String("inactive")},
+		{Id: platformclientv2.String("user-1"), Email: platformclientv2.String("old@example.com"), State: platformclientv2.String("inactive")},
+		{Id: platformclientv2.String("user-3"), State: platformclientv2.String("active")},
+	}
+	previous := []retainedUser{{userId: "user-1", retainedAt: retainedAt}}
+
+	retained := buildRetainedUsers(members, previous, 30, now)
+	assert.Equal(t, []retainedUser{
+		{userId: "user-1", email: "old@example.com", retainedAt: retainedAt, deleteAfter: retainedAt.AddDate(0, 0, 30)},
+		{userId: "user-2", email: "new@example.com", retainedAt: now, deleteAfter: now.AddDate(0, 0, 30)},
+	}, retained)
+}
+
+func TestUnitUpdateUserOffboardingRetention(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	offboardingNow = func() time.Time { return now }
+	defer func() { offboardingNow = func() time.Time { return time.Now().UTC() } }()
+	defer func() { internalProxy = nil }()
+
+	ok := &platformclientv2.APIResponse{StatusCode: http.StatusOK}
+	members := []platformclientv2.User{
+		{Id: platformclientv2.String("expired"), Email: platformclientv2.String("expired@example.com"), State: platformclientv2.String("inactive")},
+		{Id: platformclientv2.String("kept"), Email: platformclientv2.String("kept@example.com"), State: platformclientv2.String("inactive")},
+	}
+	var deleted []string
+	tp := &userProxy{}
+	tp.getRetentionGroupAttr = func(ctx context.Context, p *userProxy, id string) (*platformclientv2.Group, *platformclientv2.APIResponse, error) {
+		return &platformclientv2.Group{Id: &id, Name: platformclientv2.String("Offboarded users")}, ok, nil
+	}
+	tp.getRetentionGroupMembersAttr = func(ctx context.Context, p *userProxy, id string) ([]platformclientv2.User, *platformclientv2.APIResponse, error) {
+		return members, ok, nil
+	}
+	tp.deleteUserAttr = func(ctx context.Context, p *userProxy, id string) (*interface{}, *platformclientv2.APIResponse, error) {
+		deleted = append(deleted, id)
+		members = members[1:]
+		return nil, ok, nil
+	}
+	internalProxy = tp
+
+	resourceSchema := ResourceUserOffboardingRetention().Schema
+	state := &terraform.InstanceState{
+		ID: "retention-1",
+		Attributes: map[string]string{
+			"id":                            "retention-1",
+			"name":                          "Offboarded users",
+			"retention_days":                "30",
+			"retained_users.#":              "2",
+			"retained_users.0.user_id":      "expired",
+			"retained_users.0.email":        "expired@example.com",
+			"retained_users.0.retained_at":  now.AddDate(0, 0, -31).Format(time.RFC3339),
+			"retained_users.0.delete_after": now.AddDate(0, 0, -1).Format(time.RFC3339),
+			"retained_users.1.user_id":      "kept",
+			"retained_users.1.email":        "kept@example.com",
+			"retained_users.1.retained_at":  now.AddDate(0, 0, -10).Format(time.RFC3339),
+			"retained_users.1.delete_after": now.AddDate(0, 0, 20).Format(time.RFC3339),
+		},
+	}
+	resource := ResourceUserOffboardingRetention()
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{"name": "Offboarded users", "retention_days": 30})
+
+	// The expired user plans an update, which deletes them
+	diff, err := resource.SimpleDiff(context.Background(), state, config, nil)
+	assert.NoError(t, err)
+	assert.NotNil(t, diff)
+	assert.True(t, diff.Attributes["retained_users.#"].NewComputed)
+
+	d, err := schema.InternalMap(resourceSchema).Data(state, diff)
+	assert.NoError(t, err)
+	diags := updateUserOffboardingRetention(context.Background(), d, &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}})
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, []string{"expired"}, deleted)
+	assert.Equal(t, 1, d.Get("retained_users.#"))
+	assert.Equal(t, "kept", d.Get("retained_users.0.user_id"))
+	assert.Equal(t, now.AddDate(0, 0, -10).Format(time.RFC3339), d.Get("retained_users.0.retained_at"))
+
+	// Nothing is planned while no retention period is over
+	state.Attributes["retained_users.0.delete_after"] = now.AddDate(0, 0, 1).Format(time.RFC3339)
+	diff, err = resource.SimpleDiff(context.Background(), state, config, nil)
+	assert.NoError(t, err)
+	assert.True(t, diff == nil || diff.Empty())
+}
@@ -0,0 +1,229 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/mypurecloud/platform-client-sdk-go/v154/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+// testOffboardingProxy records the calls made to offboard a user
+type testOffboardingProxy struct {
+	userProxy
+	removedGrants []string
+	removedQueues []string
+	removedSkills []string
+	stationCalls  []string
+	states        []string
+	deleted       bool
+}
+
+func newTestOffboardingProxy() *testOffboardingProxy {
+	ok := &platformclientv2.APIResponse{StatusCode: http.StatusOK}
+	notFound := &platformclientv2.APIResponse{StatusCode: http.StatusNotFound}
+	tp := &testOffboardingProxy{}
+	tp.getUserRoleGrantsAttr = func(ctx context.Context, p *userProxy, id string) (*[]platformclientv2.Authzgrant, *platformclientv2.APIResponse, error) {
+		return &[]platformclientv2.Authzgrant{
+			{Role: &platformclientv2.Authzgrantrole{Id: platformclientv2.String("role-1")}, Division: &platformclientv2.Authzdivision{Id: platformclientv2.String("division-1")}},
+			{Role: &platformclientv2.Authzgrantrole{Id: platformclientv2.String("role-2")}, Division: &platformclientv2.Authzdivision{Id: platformclientv2.String("division-1")}},
+		}, ok, nil
+	}
+	tp.deleteUserRoleGrantAttr = func(ctx context.Context, p *userProxy, id string, divisionId string, roleId string) (*platformclientv2.APIResponse, error) {
+		tp.removedGrants = append(tp.removedGrants, divisionId+"/"+roleId)
+		if roleId == "role-2" {
+			// Grants removed since they were read are ignored
+			return notFound, errors.New("not found")
+		}
+		return ok, nil
+	}
+	tp.getUserQueueIdsAttr = func(ctx context.Context, p *userProxy, id string) ([]string, *platformclientv2.APIResponse, error) {
+		return []string{"queue-1", "queue-2"}, ok, nil
+	}
+	tp.deleteUserQueueMemberAttr = func(ctx context.Context, p *userProxy, id string, queueId string) (*platformclientv2.APIResponse, error) {
+		tp.removedQueues = append(tp.removedQueues, queueId)
+		return ok, nil
+	}
+	tp.getUserSkillIdsAttr = func(ctx context.Context, p *userProxy, id string) ([]string, *platformclientv2.APIResponse, error) {
+		return []string{"skill-1"}, ok, nil
+	}
+	tp.deleteUserSkillAttr = func(ctx context.Context, p *userProxy, id string, skillId string) (*platformclientv2.APIResponse, error) {
+		tp.removedSkills = append(tp.removedSkills, skillId)
+		return ok, nil
+	}
+	tp.getUserStationsAttr = func(ctx context.Context, p *userProxy, id string) (*platformclientv2.Userstations, *platformclientv2.APIResponse, error) {
+		return &platformclientv2.Userstations{
+			AssociatedStation: &platformclientv2.Userstation{Id: platformclientv2.String("station-1")},
+			DefaultStation:    &platformclientv2.Userstation{Id: platformclientv2.String("station-2")},
+		}, ok, nil
+	}
+	tp.deleteUserAssociatedStationAttr = func(ctx context.Context, p *userProxy, id string) (*platformclientv2.APIResponse, error) {
+		tp.stationCalls = append(tp.stationCalls, "associated")
+		return ok, nil
+	}
+	tp.deleteUserDefaultStationAttr = func(ctx context.Context, p *userProxy, id string) (*platformclientv2.APIResponse, error) {
+		tp.stationCalls = append(tp.stationCalls, "default")
+		return ok, nil
+	}
+	tp.getUserByIdAttr = func(ctx context.Context, p *userProxy, id string, expand []string, state string) (*platformclientv2.User, *platformclientv2.APIResponse, error) {
+		return &platformclientv2.User{Id: &id, Version: platformclientv2.Int(1)}, ok, nil
+	}
+	tp.patchUserWithStateAttr = func(ctx context.Context, p *userProxy, id string, updateUser *platformclientv2.Updateuser) (*platformclientv2.User, *platformclientv2.APIResponse, error) {
+		tp.states = append(tp.states, *updateUser.State)
+		return &platformclientv2.User{Id: &id}, ok, nil
+	}
+	tp.deleteUserAttr = func(ctx context.Context, p *userProxy, id string) (*interface{}, *platformclientv2.APIResponse, error) {
+		tp.deleted = true
+		return nil, ok, nil
+	}
+	tp.getUserByNameAttr = func(ctx context.Context, p *userProxy, searchUser platformclientv2.Usersearchrequest) (*platformclientv2.Userssearchresponse, *platformclientv2.APIResponse, error) {
+		return &platformclientv2.Userssearchresponse{Results: &[]platformclientv2.User{{Id: platformclientv2.String("user-1")}}}, ok, nil
+	}
+	return tp
+}
+
+func TestUnitOffboardUser(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	offboardingNow = func() time.Time { return now }
+	defer func() { offboardingNow = func() time.Time { return time.Now().UTC() } }()
+
+	tp := newTestOffboardingProxy()
+	d := schema.TestResourceDataRaw(t, ResourceUser().Schema, map[string]interface{}{
+		"email":       "agent@example.com",
+		"name":        "Agent",
+		"offboarded":  true,
+		"offboarding": []interface{}{map[string]interface{}{"remove_skills": false}},
+	})
+	d.SetId("user-1")
+
+	diags := recordUserOffboarding(context.Background(), d, &tp.userProxy)
+	assert.False(t, diags.HasError(), diags)
+
+	assert.Equal(t, []string{"division-1/role-1", "division-1/role-2"}, tp.removedGrants)
+	assert.Equal(t, []string{"queue-1", "queue-2"}, tp.removedQueues)
+	assert.Empty(t, tp.removedSkills, "disabled steps are skipped")
+	assert.Equal(t, []string{"associated", "default"}, tp.stationCalls)
+	assert.Equal(t, []string{"inactive"}, tp.states)
+
+	assert.Equal(t, now.Format(time.RFC3339), d.Get("offboarded_at"))
+	assert.Equal(t, len(offboardingSteps), d.Get("offboarding_audit.#"))
+	assert.Equal(t, offboardingStepRemoveRoles, d.Get("offboarding_audit.0.step"))
+	assert.Equal(t, offboardingStatusCompleted, d.Get("offboarding_audit.0.status"))
+	assert.Equal(t, "removed 2 role grants", d.Get("offboarding_audit.0.detail"))
+	assert.Equal(t, offboardingStepRemoveSkills, d.Get("offboarding_audit.2.step"))
+	assert.Equal(t, offboardingStatusSkipped, d.Get("offboarding_audit.2.status"))
+	assert.Equal(t, "cleared default station station-2", d.Get("offboarding_audit.4.detail"))
+	assert.Equal(t, offboardingStepDeactivate, d.Get("offboarding_audit.5.step"))
+	assert.Equal(t, now.Format(time.RFC3339), d.Get("offboarding_audit.5.time"))
+
+	// A failed step stops the offboarding before the user is deactivated
+	tp = newTestOffboardingProxy()
+	tp.deleteUserQueueMemberAttr = func(ctx context.Context, p *userProxy, id string, queueId string) (*platformclientv2.APIResponse, error) {
+		return &platformclientv2.APIResponse{StatusCode: http.StatusForbidden}, errors.New("forbidden")
+	}
+	audit, diags := offboardUser(context.Background(), &tp.userProxy, "user-1", offboardingSettings{steps: map[string]bool{
+		offboardingStepRemoveRoles:            true,
+		offboardingStepRemoveQueueMemberships: true,
+		offboardingStepDeactivate:             true,
+	}})
+	assert.True(t, diags.HasError())
+	assert.Len(t, audit, 2)
+	assert.Equal(t, offboardingStatusFailed, audit[1].status)
+	assert.Contains(t, audit[1].detail, "queue-1")
+	assert.Empty(t, tp.states)
+}
+
+func TestUnitDeleteUserOffboarding(t *testing.T) {
+	meta := &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}
+	defer func() { internalProxy = nil }()
+
+	// With a retention the user is handed to it and offboarded, and the resource leaves the state without deleting them
+	tp := newTestOffboardingProxy()
+	var retainedIn []string
+	tp.addRetentionGroupMemberAttr = func(ctx context.Context, p *userProxy, id string, userId string) (*platformclientv2.APIResponse, error) {
+		assert.Empty(t, tp.states, "the user is retained before being deactivated")
+		retainedIn = append(retainedIn, id+"/"+userId)
+		return &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	internalProxy = &tp.userProxy
+	d := schema.TestResourceDataRaw(t, ResourceUser().Schema, map[string]interface{}{
+		"email":       "agent@example.com",
+		"name":        "Agent",
+		"offboarding": []interface{}{map[string]interface{}{"retention_id": "retention-1"}},
+	})
+	d.SetId("user-1")
+
+	diags := deleteUser(context.Background(), d, meta)
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, []string{"retention-1/user-1"}, retainedIn)
+	assert.Equal(t, []string{"inactive"}, tp.states)
+	assert.False(t, tp.deleted)
+
+	// A user offboarded in place is handed to the retention without being offboarded again
+	tp.states = nil
+	retainedIn = nil
+	_ = d.Set("offboarded", true)
+	diags = deleteUser(context.Background(), d, meta)
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, []string{"retention-1/user-1"}, retainedIn)
+	assert.Empty(t, tp.states)
+	assert.False(t, tp.deleted)
+
+	// Without a retention the user is offboarded and deleted right away
+	tp = newTestOffboardingProxy()
+	internalProxy = &tp.userProxy
+	d = schema.TestResourceDataRaw(t, ResourceUser().Schema, map[string]interface{}{
+		"email":       "agent@example.com",
+		"name":        "Agent",
+		"offboarding": []interface{}{map[string]interface{}{}},
+	})
+	d.SetId("user-1")
+
+	diags = deleteUser(context.Background(), d, meta)
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, []string{"inactive"}, tp.states)
+	assert.True(t, tp.deleted)
+}
+
+func TestUnitUserOffboardedDiff(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "user-1",
+		Attributes: map[string]string{
+			"id":               "user-1",
+			"email":            "agent@example.com",
+			"name":             "Agent",
+			"state":            "inactive",
+			"offboarded":       "true",
+			"routing_skills.#": "0",
+		},
+	}
+	config := map[string]interface{}{
+		"email":          "agent@example.com",
+		"name":           "Agent",
+		"offboarded":     true,
+		"routing_skills": []interface{}{map[string]interface{}{"skill_id": "skill-1", "proficiency": 2}},
+	}
+
+	// The state and skills removed by the offboarding are not planned back while the user is offboarded
+	diff, err := ResourceUser().Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), nil)
+	assert.NoError(t, err)
+	if diff != nil {
+		assert.NotContains(t, diff.Attributes, "state")
+		assert.NotContains(t, diff.Attributes, "routing_skills.#")
+	}
+
+	// Reactivating the user plans them back
+	config["offboarded"] = false
+	diff, err = ResourceUser().Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), nil)
+	assert.NoError(t, err)
+	if assert.NotNil(t, diff) {
+		assert.Equal(t, "active", diff.Attributes["state"].New)
+		assert.Equal(t, "1", diff.Attributes["routing_skills.#"].New)
+	}
+}
@@ -8,17 +8,22 @@ import (
 	registrar "terraform-provider-genesyscloud/genesyscloud/resource_register"
 	"terraform-provider-genesyscloud/genesyscloud/validators"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 const ResourceType = "genesyscloud_user"
+const BulkResourceType = "genesyscloud_users_bulk"
+const RetentionResourceType = "genesyscloud_user_offboarding_retention"
 
 // SetRegistrar registers all the resources and exporters in the package
 func SetRegistrar(l registrar.Registrar) {
 	l.RegisterDataSource(ResourceType, DataSourceUser())
 	l.RegisterResource(ResourceType, ResourceUser())
 	l.RegisterExporter(ResourceType, UserExporter())
+	l.RegisterResource(BulkResourceType, ResourceUsersBulk())
+	l.RegisterResource(RetentionResourceType, ResourceUserOffboardingRetention())
 }
 
 var (
@@ -99,6 +104,77 @@ var (
 		},
 	}
 
+	userOffboardingResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			offboardingStepDeactivate: {
+				Description: "Deactivate the user.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			offboardingStepRemoveRoles: {
+				Description: "Remove the roles granted directly to the user. Roles granted through groups are kept.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			offboardingStepRemoveQueueMemberships: {
+				Description: "Remove the user from the members of every queue.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			offboardingStepRemoveSkills: {
+				Description: "Remove every routing skill of the user.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			offboardingStepRemoveStation: {
+				Description: "Disassociate the user from the station they are logged in to.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			offboardingStepRemovePhones: {
+				Description: "Clear the default station of the user, the phone assigned to them.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"retention_id": {
+				Description: "ID of the genesyscloud_user_offboarding_retention keeping the user once the resource is destroyed. Destroying the resource then offboards the user and hands them to the retention, which deletes them on the first apply after their retention period. If not set, destroying the resource offboards and deletes the user right away.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+		},
+	}
+
+	userOffboardingAuditResource = &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"step": {
+				Description: "Offboarding step (deactivate | remove_roles | remove_queue_memberships | remove_skills | remove_station | remove_phones | reactivate).",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"status": {
+				Description: "Outcome of the step (completed | skipped | failed).",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"time": {
+				Description: "Time the step ran, in ISO-8601 format.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"detail": {
+				Description: "What the step changed, or why it was skipped or failed.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+
 	otherEmailResource = &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"address": {
@@ -188,6 +264,11 @@ func ResourceUser() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		SchemaVersion: 1,
+		CustomizeDiff: customdiff.All(
+			validateUserRoutingUtilization,
+			customdiff.ComputedIf("offboarding_audit", userOffboardedChanged),
+			customdiff.ComputedIf("offboarded_at", userOffboardedChanged),
+		),
 		Schema: map[string]*schema.Schema{
 			"email": {
 				Description: "User's primary email and username.",
@@ -206,11 +287,12 @@ func ResourceUser() *schema.Resource {
 				Sensitive:   true,
 			},
 			"state": {
-				Description:  "User's state (active | inactive). Default is 'active'.",
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "active",
-				ValidateFunc: validation.StringInSlice([]string{"active", "inactive"}, false),
+				Description:      "User's state (active | inactive). Default is 'active'. Changes are not planned while the user is offboarded.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "active",
+				ValidateFunc:     validation.StringInSlice([]string{"active", "inactive"}, false),
+				DiffSuppressFunc: suppressWhileOffboarded,
 			},
 			"division_id": {
 				Description: "The division to which this user will belong. If not set, the home division will be used.",
@@ -240,12 +322,19 @@ func ResourceUser() *schema.Resource {
 				Default:     false,
 			},
 			"routing_skills": {
-				Description: "Skills and proficiencies for this user. If not set, this resource will not manage user skills.",
+				Description:      "Skills and proficiencies for this user. If not set, this resource will not manage user skills. Changes are not planned while the user is offboarded.",
+				Type:             schema.TypeSet,
+				Optional:         true,
+				Computed:         true,
+				ConfigMode:       schema.SchemaConfigModeAttr,
+				Elem:             userSkillResource,
+				DiffSuppressFunc: suppressWhileOffboarded,
+			},
+			"policy_routing_skill_ids": {
+				Description: "IDs of the routing skills assigned to this user by genesyscloud_routing_skill_policy resources. They are not read into routing_skills and are kept when they are not listed in routing_skills, so that this resource and the policies do not remove the skills of each other. A skill listed in both is an explicit skill of the user, whose proficiency the policies handle according to their precedence.",
 				Type:        schema.TypeSet,
 				Optional:    true,
-				Computed:    true,
-				ConfigMode:  schema.SchemaConfigModeAttr,
-				Elem:        userSkillResource,
+				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
 			"routing_languages": {
 				Description: "Languages and proficiencies for this user. If not set, this resource will not manage user languages.",
@@ -411,6 +500,153 @@ func ResourceUser() *schema.Resource {
 				Computed:    true,
 				Elem:        voicemailUserpoliciesResource,
 			},
+			"offboarding": {
+				Description: "Offboarding settings of the user. When set, destroying the resource offboards the user with these settings, unless they are already offboarded, and removes the resource from the state. The user is then deleted right away or, with `retention_id`, kept inactive until the retention deletes them. The settings are also used when `offboarded` is set.",
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Optional:    true,
+				Elem:        userOffboardingResource,
+			},
+			"offboarded": {
+				Description: "Offboard the user in place: their role grants, queue memberships, skills, station and phone are removed and they are deactivated, as enabled by the `offboarding` settings. While offboarded, the other settings of the user are not applied, and the state and skills removed by the offboarding are read without planning them back. Setting it back to false reactivates the user in the configured state with the configured skills.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"offboarded_at": {
+				Description: "Time the user was offboarded in place, in ISO-8601 format.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"offboarding_audit": {
+				Description: "Steps run to offboard and reactivate the user, in the order they ran.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        userOffboardingAuditResource,
+			},
+		},
+	}
+}
+
+// ResourceUsersBulk manages many users from a single CSV or JSON file instead of one genesyscloud_user resource each
+func ResourceUsersBulk() *schema.Resource {
+	return &schema.Resource{
+		Description: fmt.Sprintf(`Genesys Cloud users managed in bulk from a CSV or JSON file. Each row of the file describes a user with the %s columns. The users are matched by email with the users of the organization: the missing users are created, the users that differ from their row are updated and, when deactivate_removed_users is set, the users removed from the file are deactivated. The changes are applied in chunks and the result of every row is written to report_filepath. Changes made to the users outside of Terraform are detected on refresh and applied again. Destroying the resource leaves the users as they are.`, strings.Join(bulkUserColumns, ", ")),
+
+		CreateContext: provider.CreateWithPooledClient(createUsersBulk),
+		ReadContext:   provider.ReadWithPooledClient(readUsersBulk),
+		UpdateContext: provider.UpdateWithPooledClient(updateUsersBulk),
+		DeleteContext: provider.DeleteWithPooledClient(deleteUsersBulk),
+		SchemaVersion: 1,
+		CustomizeDiff: customdiff.All(
+			customdiff.ComputedIf("source_file_content_hash", validators.ValidateFileContentHashChanged("source_filepath", "source_file_content_hash")),
+			customdiff.ComputedIf("user_ids", validators.ValidateFileContentHashChanged("source_filepath", "source_file_content_hash")),
+			customdiff.ComputedIf("sync_counts", validators.ValidateFileContentHashChanged("source_filepath", "source_file_content_hash")),
+			validateUsersBulkSource,
+		),
+		Schema: map[string]*schema.Schema{
+			"source_filepath": {
+				Description:  "The path or URL of the CSV or JSON file describing the users. In a CSV file skills and languages are written as id:proficiency pairs separated by semicolons, e.g. `skillId1:3;skillId2:4.5`, and locations as location IDs separated by semicolons. In a JSON file, an array of objects, skills and languages are objects mapping IDs to proficiencies and locations is an array of location IDs. The manager is given as a user ID or as the email of a user of the organization or of the file. When the skills, languages or locations of a user are not set, they are left as they are in Genesys Cloud. The rows are validated at plan time.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validators.ValidatePath,
+			},
+			"source_format": {
+				Description:  fmt.Sprintf("The format of the source file (%s | %s). Defaults to the format matching the extension of source_filepath.", bulkUserFormatCsv, bulkUserFormatJson),
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{bulkUserFormatCsv, bulkUserFormatJson}, false),
+			},
+			"deactivate_removed_users": {
+				Description: "Whether the users removed from the source file are deactivated. The users of the organization that were never in the file are left alone.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"chunk_size": {
+				Description:  "The number of users changed in parallel. The chunks are applied one after the other.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      25,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+			"report_filepath": {
+				Description: "The path of a CSV file the result of every row is written to, with the row, email, action, status, user_id and message columns. The action is create, update, deactivate or unchanged and the status is succeeded or failed.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"source_file_content_hash": {
+				Description: "The hash of the source file. This is retained as a computed value in the state in order to detect when the file's contents have changed. It is cleared when a row failed to be applied or the users have drifted from the file, so that the next apply applies the file again.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"user_ids": {
+				Description: "The IDs of the users managed by the resource, keyed by email.",
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"sync_counts": {
+				Description: "The number of users created, updated, deactivated, unchanged and failed by the last apply, keyed by create, update, deactivate, unchanged and failed.",
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+		},
+	}
+}
+
+func ResourceUserOffboardingRetention() *schema.Resource {
+	return &schema.Resource{
+		Description: "Genesys Cloud retention of offboarded users. Users destroyed with an `offboarding` block whose `retention_id` is the ID of the retention are kept inactive in a group owned by the retention, and are deleted on the first apply after their retention period is over. A user's retention period starts when the retention first reads them. Destroying the retention leaves the users it keeps inactive.",
+
+		CreateContext: provider.CreateWithPooledClient(createUserOffboardingRetention),
+		ReadContext:   provider.ReadWithPooledClient(readUserOffboardingRetention),
+		UpdateContext: provider.UpdateWithPooledClient(updateUserOffboardingRetention),
+		DeleteContext: provider.DeleteWithPooledClient(deleteUserOffboardingRetention),
+		CustomizeDiff: customizeUserOffboardingRetentionDiff,
+		SchemaVersion: 1,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "Name of the group keeping the retained users.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"retention_days": {
+				Description:  "Number of days a user is kept before being deleted.",
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"retained_users": {
+				Description: "Users kept by the retention.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"user_id": {
+							Description: "ID of the user.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"email": {
+							Description: "Email of the user.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"retained_at": {
+							Description: "Time the retention period of the user started, in ISO-8601 format.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"delete_after": {
+							Description: "Time after which the next apply deletes the user, in ISO-8601 format.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
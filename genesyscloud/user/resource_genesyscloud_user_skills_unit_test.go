@@ -0,0 +1,35 @@
+package user
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v154/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitFlattenUserSkillsWithoutPolicySkills(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, ResourceUser().Schema, map[string]interface{}{
+		"email": "user@example.com",
+		"name":  "User",
+		"routing_skills": []interface{}{
+			map[string]interface{}{"skill_id": "skill-explicit", "proficiency": 4.0},
+			map[string]interface{}{"skill_id": "skill-user", "proficiency": 1.0},
+		},
+		"policy_routing_skill_ids": []interface{}{"skill-explicit", "skill-policy"},
+	})
+
+	skills := flattenUserSkillsWithoutPolicySkills(d, &[]platformclientv2.Userroutingskill{
+		{Id: platformclientv2.String("skill-explicit"), Proficiency: platformclientv2.Float64(4)},
+		{Id: platformclientv2.String("skill-user"), Proficiency: platformclientv2.Float64(1)},
+		{Id: platformclientv2.String("skill-policy"), Proficiency: platformclientv2.Float64(3)},
+		{Id: platformclientv2.String("skill-other"), Proficiency: platformclientv2.Float64(2)},
+	})
+
+	// Skills assigned by a policy are left out unless they are listed in routing_skills
+	var skillIds []string
+	for _, skill := range skills.List() {
+		skillIds = append(skillIds, skill.(map[string]interface{})["skill_id"].(string))
+	}
+	assert.ElementsMatch(t, []string{"skill-explicit", "skill-user", "skill-other"}, skillIds)
+}
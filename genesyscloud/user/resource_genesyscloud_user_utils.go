@@ -5,9 +5,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"maps"
 	"net/mail"
+	"slices"
 	"sort"
 	"strings"
+	routingUtilization "terraform-provider-genesyscloud/genesyscloud/routing_utilization"
 	"terraform-provider-genesyscloud/genesyscloud/util"
 	chunksProcess "terraform-provider-genesyscloud/genesyscloud/util/chunks"
 	"terraform-provider-genesyscloud/genesyscloud/util/lists"
@@ -130,62 +133,77 @@ func executeAllUpdates(ctx context.Context, d *schema.ResourceData, proxy *userP
 
 func updateUserSkills(d *schema.ResourceData, proxy *userProxy) diag.Diagnostics {
 	if d.HasChange("routing_skills") {
-		if skillsConfig := d.Get("routing_skills"); skillsConfig != nil {
-			log.Printf("Updating skills for user %s", d.Get("email"))
-			newSkillProfs := make(map[string]float64)
-			skillList := skillsConfig.(*schema.Set).List()
-			newSkillIds := make([]string, len(skillList))
-			for i, skill := range skillList {
-				skillMap := skill.(map[string]interface{})
-				newSkillIds[i] = skillMap["skill_id"].(string)
-				newSkillProfs[newSkillIds[i]] = skillMap["proficiency"].(float64)
-			}
+		return syncConfiguredUserSkills(d, proxy)
+	}
+	return nil
+}
 
-			oldSdkSkills, err := getUserRoutingSkills(d.Id(), proxy)
-			if err != nil {
-				return err
-			}
+// syncConfiguredUserSkills makes the skills of the user the routing_skills of the resource
+func syncConfiguredUserSkills(d *schema.ResourceData, proxy *userProxy) diag.Diagnostics {
+	if skillsConfig := d.Get("routing_skills"); skillsConfig != nil {
+		log.Printf("Updating skills for user %s", d.Get("email"))
+		newSkillProfs := make(map[string]float64)
+		for _, skill := range skillsConfig.(*schema.Set).List() {
+			skillMap := skill.(map[string]interface{})
+			newSkillProfs[skillMap["skill_id"].(string)] = skillMap["proficiency"].(float64)
+		}
 
-			oldSkillIds := make([]string, len(oldSdkSkills))
-			oldSkillProfs := make(map[string]float64)
-			for i, skill := range oldSdkSkills {
-				oldSkillIds[i] = *skill.Id
-				oldSkillProfs[oldSkillIds[i]] = *skill.Proficiency
-			}
+		oldSdkSkills, err := getUserRoutingSkills(d.Id(), proxy)
+		if err != nil {
+			return err
+		}
 
-			if len(oldSkillIds) > 0 {
-				skillsToRemove := lists.SliceDifference(oldSkillIds, newSkillIds)
-				for _, skillId := range skillsToRemove {
-					diagErr := util.RetryWhen(util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
-						resp, err := proxy.userApi.DeleteUserRoutingskill(d.Id(), skillId)
-						if err != nil {
-							return resp, util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to remove skill from user %s error: %s", d.Id(), err), resp)
-						}
-						return nil, nil
-					})
-					if diagErr != nil {
-						return diagErr
-					}
-				}
+		// Skills assigned by a skill policy are only removed when they were listed in routing_skills
+		oldSkillProfs := buildSkillProficiencies(oldSdkSkills)
+		oldSkillsConfig, _ := d.GetChange("routing_skills")
+		for _, skillId := range getPolicyRoutingSkillIds(d) {
+			if !hasUserSkill(oldSkillsConfig, skillId) {
+				delete(oldSkillProfs, skillId)
 			}
+		}
 
-			if len(newSkillIds) > 0 {
-				// skills to add
-				skillsToAddOrUpdate := lists.SliceDifference(newSkillIds, oldSkillIds)
-				// Check for existing proficiencies to update which can be done with the same API
-				for langID, newNum := range newSkillProfs {
-					if oldNum, found := oldSkillProfs[langID]; found {
-						if newNum != oldNum {
-							skillsToAddOrUpdate = append(skillsToAddOrUpdate, langID)
-						}
-					}
+		return syncUserRoutingSkills(d.Id(), newSkillProfs, oldSkillProfs, proxy)
+	}
+	return nil
+}
+
+// syncUserRoutingSkills removes the skills of a user that are not in newSkillProfs and adds or updates the others
+func syncUserRoutingSkills(userID string, newSkillProfs map[string]float64, oldSkillProfs map[string]float64, proxy *userProxy) diag.Diagnostics {
+	newSkillIds := slices.Collect(maps.Keys(newSkillProfs))
+	oldSkillIds := slices.Collect(maps.Keys(oldSkillProfs))
+
+	if len(oldSkillIds) > 0 {
+		skillsToRemove := lists.SliceDifference(oldSkillIds, newSkillIds)
+		for _, skillId := range skillsToRemove {
+			diagErr := util.RetryWhen(util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+				resp, err := proxy.userApi.DeleteUserRoutingskill(userID, skillId)
+				if err != nil {
+					return resp, util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to remove skill from user %s error: %s", userID, err), resp)
 				}
+				return nil, nil
+			})
+			if diagErr != nil {
+				return diagErr
+			}
+		}
+	}
 
-				if diagErr := updateUserRoutingSkills(d.Id(), skillsToAddOrUpdate, newSkillProfs, proxy); diagErr != nil {
-					return diagErr
+	if len(newSkillIds) > 0 {
+		// skills to add
+		skillsToAddOrUpdate := lists.SliceDifference(newSkillIds, oldSkillIds)
+		// Check for existing proficiencies to update which can be done with the same API
+		for skillID, newNum := range newSkillProfs {
+			if oldNum, found := oldSkillProfs[skillID]; found {
+				if newNum != oldNum {
+					skillsToAddOrUpdate = append(skillsToAddOrUpdate, skillID)
 				}
 			}
+		}
 
+		if len(skillsToAddOrUpdate) > 0 {
+			if diagErr := updateUserRoutingSkills(userID, skillsToAddOrUpdate, newSkillProfs, proxy); diagErr != nil {
+				return diagErr
+			}
 		}
 	}
 	return nil
@@ -196,12 +214,9 @@ func updateUserLanguages(d *schema.ResourceData, proxy *userProxy) diag.Diagnost
 		if languages := d.Get("routing_languages"); languages != nil {
 			log.Printf("Updating languages for user %s", d.Get("email"))
 			newLangProfs := make(map[string]int)
-			langList := languages.(*schema.Set).List()
-			newLangIds := make([]string, len(langList))
-			for i, lang := range langList {
+			for _, lang := range languages.(*schema.Set).List() {
 				langMap := lang.(map[string]interface{})
-				newLangIds[i] = langMap["language_id"].(string)
-				newLangProfs[newLangIds[i]] = langMap["proficiency"].(int)
+				newLangProfs[langMap["language_id"].(string)] = langMap["proficiency"].(int)
 			}
 
 			oldSdkLangs, err := getUserRoutingLanguages(d.Id(), proxy)
@@ -209,51 +224,80 @@ func updateUserLanguages(d *schema.ResourceData, proxy *userProxy) diag.Diagnost
 				return err
 			}
 
-			oldLangIds := make([]string, len(oldSdkLangs))
-			oldLangProfs := make(map[string]int)
-			for i, lang := range oldSdkLangs {
-				oldLangIds[i] = *lang.Id
-				oldLangProfs[oldLangIds[i]] = int(*lang.Proficiency)
+			if diagErr := syncUserRoutingLanguages(d.Id(), newLangProfs, buildLanguageProficiencies(oldSdkLangs), proxy); diagErr != nil {
+				return diagErr
 			}
+			log.Printf("Languages updated for user %s", d.Get("email"))
+		}
+	}
+	return nil
+}
 
-			if len(oldLangIds) > 0 {
-				langsToRemove := lists.SliceDifference(oldLangIds, newLangIds)
-				for _, langID := range langsToRemove {
-					diagErr := util.RetryWhen(util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
-						resp, err := proxy.userApi.DeleteUserRoutinglanguage(d.Id(), langID)
-						if err != nil {
-							return resp, util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to remove language from user %s error: %s", d.Id(), err), resp)
-						}
-						return nil, nil
-					})
-					if diagErr != nil {
-						return diagErr
-					}
+// syncUserRoutingLanguages removes the languages of a user that are not in newLangProfs and adds or updates the others
+func syncUserRoutingLanguages(userID string, newLangProfs map[string]int, oldLangProfs map[string]int, proxy *userProxy) diag.Diagnostics {
+	newLangIds := slices.Collect(maps.Keys(newLangProfs))
+	oldLangIds := slices.Collect(maps.Keys(oldLangProfs))
+
+	if len(oldLangIds) > 0 {
+		langsToRemove := lists.SliceDifference(oldLangIds, newLangIds)
+		for _, langID := range langsToRemove {
+			diagErr := util.RetryWhen(util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+				resp, err := proxy.userApi.DeleteUserRoutinglanguage(userID, langID)
+				if err != nil {
+					return resp, util.BuildAPIDiagnosticError(ResourceType, fmt.Sprintf("Failed to remove language from user %s error: %s", userID, err), resp)
 				}
+				return nil, nil
+			})
+			if diagErr != nil {
+				return diagErr
 			}
+		}
+	}
 
-			if len(newLangIds) > 0 {
-				// Languages to add
-				langsToAddOrUpdate := lists.SliceDifference(newLangIds, oldLangIds)
+	if len(newLangIds) > 0 {
+		// Languages to add
+		langsToAddOrUpdate := lists.SliceDifference(newLangIds, oldLangIds)
 
-				// Check for existing proficiencies to update which can be done with the same API
-				for langID, newNum := range newLangProfs {
-					if oldNum, found := oldLangProfs[langID]; found {
-						if newNum != oldNum {
-							langsToAddOrUpdate = append(langsToAddOrUpdate, langID)
-						}
-					}
-				}
-				if diagErr := updateUserRoutingLanguages(d.Id(), langsToAddOrUpdate, newLangProfs, proxy); diagErr != nil {
-					return diagErr
+		// Check for existing proficiencies to update which can be done with the same API
+		for langID, newNum := range newLangProfs {
+			if oldNum, found := oldLangProfs[langID]; found {
+				if newNum != oldNum {
+					langsToAddOrUpdate = append(langsToAddOrUpdate, langID)
 				}
 			}
-			log.Printf("Languages updated for user %s", d.Get("email"))
+		}
+
+		if len(langsToAddOrUpdate) > 0 {
+			if diagErr := updateUserRoutingLanguages(userID, langsToAddOrUpdate, newLangProfs, proxy); diagErr != nil {
+				return diagErr
+			}
 		}
 	}
 	return nil
 }
 
+// buildSkillProficiencies maps the ID of each skill of a user to its proficiency
+func buildSkillProficiencies(skills []platformclientv2.Userroutingskill) map[string]float64 {
+	skillProfs := make(map[string]float64, len(skills))
+	for _, skill := range skills {
+		if skill.Id != nil && skill.Proficiency != nil {
+			skillProfs[*skill.Id] = *skill.Proficiency
+		}
+	}
+	return skillProfs
+}
+
+// buildLanguageProficiencies maps the ID of each language of a user to its proficiency
+func buildLanguageProficiencies(languages []platformclientv2.Userroutinglanguage) map[string]int {
+	langProfs := make(map[string]int, len(languages))
+	for _, lang := range languages {
+		if lang.Id != nil && lang.Proficiency != nil {
+			langProfs[*lang.Id] = int(*lang.Proficiency)
+		}
+	}
+	return langProfs
+}
+
 func updateUserProfileSkills(d *schema.ResourceData, proxy *userProxy) diag.Diagnostics {
 	if d.HasChange("profile_skills") {
 		if profileSkills := d.Get("profile_skills"); profileSkills != nil {
@@ -470,7 +514,7 @@ func getUserRoutingSkills(userID string, proxy *userProxy) ([]platformclientv2.U
 
 func getDeletedUserId(email string, proxy *userProxy) (*string, diag.Diagnostics) {
 	exactType := "EXACT"
-	results, resp, getErr := proxy.userApi.PostUsersSearch(platformclientv2.Usersearchrequest{
+	results, resp, getErr := proxy.getUserByName(context.Background(), platformclientv2.Usersearchrequest{
 		Query: &[]platformclientv2.Usersearchcriteria{
 			{
 				Fields:  &[]string{"email"},
@@ -576,6 +620,24 @@ func readUserRoutingUtilization(d *schema.ResourceData, proxy *userProxy) diag.D
 	return nil
 }
 
+// validateUserRoutingUtilization validates the configured routing_utilization with the rules of the org utilization
+func validateUserRoutingUtilization(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	rawConfig := diff.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return nil
+	}
+	// Label IDs referencing labels that are not created yet are only known at apply time
+	if utilConfig := rawConfig.GetAttr("routing_utilization"); utilConfig.IsNull() || !utilConfig.IsWhollyKnown() {
+		return nil
+	}
+
+	utilConfig := diff.Get("routing_utilization").([]interface{})
+	if len(utilConfig) == 0 || utilConfig[0] == nil {
+		return nil
+	}
+	return routingUtilization.ValidateUtilization(utilConfig[0].(map[string]interface{}))
+}
+
 func phoneNumberHash(val interface{}) int {
 	// Copy map to avoid modifying state
 	phoneMap := make(map[string]interface{})
@@ -822,6 +884,49 @@ func flattenUserEmployerInfo(empInfo *platformclientv2.Employerinfo) []interface
 	}}
 }
 
+// flattenUserSkillsWithoutPolicySkills flattens the skills of the user, leaving out the skills assigned by a skill policy
+// unless they are already listed in routing_skills
+func flattenUserSkillsWithoutPolicySkills(d *schema.ResourceData, skills *[]platformclientv2.Userroutingskill) *schema.Set {
+	skillSet := flattenUserSkills(skills)
+	if skillSet == nil {
+		return nil
+	}
+	policySkillIds := getPolicyRoutingSkillIds(d)
+	if len(policySkillIds) == 0 {
+		return skillSet
+	}
+	managedSkills := d.Get("routing_skills")
+	for _, skill := range skillSet.List() {
+		skillId := skill.(map[string]interface{})["skill_id"].(string)
+		if lists.ItemInSlice(skillId, policySkillIds) && !hasUserSkill(managedSkills, skillId) {
+			skillSet.Remove(skill)
+		}
+	}
+	return skillSet
+}
+
+func getPolicyRoutingSkillIds(d *schema.ResourceData) []string {
+	skillIds, ok := d.Get("policy_routing_skill_ids").(*schema.Set)
+	if !ok || skillIds == nil {
+		return nil
+	}
+	return *lists.SetToStringList(skillIds)
+}
+
+// hasUserSkill returns whether a routing_skills value lists the skill
+func hasUserSkill(skills interface{}, skillId string) bool {
+	skillSet, ok := skills.(*schema.Set)
+	if !ok || skillSet == nil {
+		return false
+	}
+	for _, skill := range skillSet.List() {
+		if skill.(map[string]interface{})["skill_id"] == skillId {
+			return true
+		}
+	}
+	return false
+}
+
 func flattenUserSkills(skills *[]platformclientv2.Userroutingskill) *schema.Set {
 	if skills == nil {
 		return nil
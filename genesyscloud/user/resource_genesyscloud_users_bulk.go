@@ -0,0 +1,175 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/*
+The resource_genesyscloud_users_bulk.go contains all the methods that perform the core logic for the genesyscloud_users_bulk resource.
+
+Listing every user of the organization is the expensive part of an apply, so create and update set the state from the
+results of the changes instead of reading the users again.
+*/
+
+// maxReportedBulkUserFailures is the number of failed rows detailed in the warning of an apply
+const maxReportedBulkUserFailures = 10
+
+func createUsersBulk(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(uuid.NewString())
+	log.Printf("Creating users from %s", d.Get("source_filepath").(string))
+	return syncUsersBulk(ctx, d, meta)
+}
+
+func readUsersBulk(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := GetUserProxy(sdkConfig)
+	sourcePath := d.Get("source_filepath").(string)
+
+	rows, err := readBulkUserSource(sourcePath, d.Get("source_format").(string))
+	if err != nil {
+		// The source file is validated again when planning, so the state is kept as it is
+		log.Printf("Unable to read users from %s: %s", sourcePath, err)
+		return nil
+	}
+
+	log.Printf("Reading users managed from %s", sourcePath)
+	return util.WithRetriesForRead(ctx, d, func() *retry.RetryError {
+		orgUsers, resp, getErr := proxy.GetAllUser(ctx)
+		if getErr != nil {
+			return retry.NonRetryableError(util.BuildWithRetriesApiDiagnosticError(BulkResourceType, fmt.Sprintf("Failed to get users | error: %s", getErr), resp))
+		}
+
+		// Users deleted outside of Terraform are no longer managed, unless they are still in the file
+		orgUserIds := make(map[string]bool, len(*orgUsers))
+		for _, user := range *orgUsers {
+			if user.Id != nil {
+				orgUserIds[*user.Id] = true
+			}
+		}
+		managedIds := make(map[string]string)
+		for email, id := range buildBulkUserIdMap(d) {
+			if orgUserIds[id] {
+				managedIds[email] = id
+			}
+		}
+		_ = d.Set("user_ids", managedIds)
+
+		pendingChanges := 0
+		for _, change := range planBulkUserChanges(rows, *orgUsers, managedIds, d.Get("deactivate_removed_users").(bool)) {
+			if change.action != bulkUserActionUnchanged {
+				pendingChanges++
+			}
+		}
+		if pendingChanges > 0 {
+			// Clearing the hash makes the next plan apply the file again
+			log.Printf("%d users have drifted from %s", pendingChanges, sourcePath)
+			_ = d.Set("source_file_content_hash", "")
+		}
+
+		log.Printf("Read users managed from %s", sourcePath)
+		return nil
+	})
+}
+
+func updateUsersBulk(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("Updating users from %s", d.Get("source_filepath").(string))
+	return syncUsersBulk(ctx, d, meta)
+}
+
+func deleteUsersBulk(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Destroying the resource must not deactivate thousands of users, so they are only removed from the state
+	log.Printf("Removing users managed from %s from the state, the users are left as they are", d.Get("source_filepath").(string))
+	return nil
+}
+
+// syncUsersBulk applies the source file to the users of the organization and writes the report
+func syncUsersBulk(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sdkConfig := meta.(*provider.ProviderMeta).ClientConfig
+	proxy := GetUserProxy(sdkConfig)
+	sourcePath := d.Get("source_filepath").(string)
+
+	rows, err := readBulkUserSource(sourcePath, d.Get("source_format").(string))
+	if err != nil {
+		return util.BuildDiagnosticError(BulkResourceType, fmt.Sprintf("failed to read users from %s", sourcePath), err)
+	}
+	hash, err := files.HashFileContent(sourcePath)
+	if err != nil {
+		return util.BuildDiagnosticError(BulkResourceType, fmt.Sprintf("failed to hash %s", sourcePath), err)
+	}
+
+	orgUsers, resp, err := proxy.GetAllUser(ctx)
+	if err != nil {
+		return util.BuildAPIDiagnosticError(BulkResourceType, fmt.Sprintf("Failed to get users error: %s", err), resp)
+	}
+
+	previousIds := buildBulkUserIdMap(d)
+	changes := planBulkUserChanges(rows, *orgUsers, previousIds, d.Get("deactivate_removed_users").(bool))
+	results := applyBulkUserChanges(ctx, proxy, changes, *orgUsers, d.Get("chunk_size").(int))
+	counts := countBulkUserResults(results)
+	log.Printf("Applied %s to the users: %v", sourcePath, counts)
+
+	_ = d.Set("user_ids", buildBulkUserIds(results, previousIds))
+	_ = d.Set("sync_counts", counts)
+	if counts[bulkUserStatusFailed] > 0 {
+		hash = ""
+	}
+	_ = d.Set("source_file_content_hash", hash)
+
+	if reportPath := d.Get("report_filepath").(string); reportPath != "" {
+		if err := writeBulkUserReport(reportPath, results); err != nil {
+			return util.BuildDiagnosticError(BulkResourceType, "failed to write the report", err)
+		}
+	}
+	return buildBulkUserFailureWarning(results)
+}
+
+// buildBulkUserFailureWarning reports the failed rows as a warning so that the users that succeeded are kept in the state
+func buildBulkUserFailureWarning(results []bulkUserResult) diag.Diagnostics {
+	var failures []string
+	for _, result := range results {
+		if result.status != bulkUserStatusFailed {
+			continue
+		}
+		if len(failures) == maxReportedBulkUserFailures {
+			failures = append(failures, "...")
+			break
+		}
+		failures = append(failures, fmt.Sprintf("row %d (%s %s): %s", result.row, result.action, result.email, result.message))
+	}
+	if len(failures) == 0 {
+		return nil
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  "Some users could not be changed, they will be applied again on the next apply",
+		Detail:   strings.Join(failures, "\n"),
+	}}
+}
+
+func buildBulkUserIdMap(d *schema.ResourceData) map[string]string {
+	ids := make(map[string]string)
+	for email, id := range d.Get("user_ids").(map[string]interface{}) {
+		ids[email] = id.(string)
+	}
+	return ids
+}
+
+// validateUsersBulkSource fails the plan when the rows of the source file are invalid
+func validateUsersBulkSource(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("source_filepath") || !d.NewValueKnown("source_format") {
+		return nil
+	}
+	_, err := readBulkUserSource(d.Get("source_filepath").(string), d.Get("source_format").(string))
+	return err
+}
@@ -0,0 +1,164 @@
+package user
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"terraform-provider-genesyscloud/genesyscloud/provider"
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v154/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+const testBulkUsersCsv = `email,name,title,manager,skills,languages,locations
+ada@example.com,Ada Lovelace,Engineer,,skill-1:3;skill-2:4.5,lang-1:5,loc-1
+grace@example.com,Grace Hopper,Admiral,ada@example.com,skill-1:2,,
+alan@example.com,Alan Turing,,,,,
+`
+
+func writeTestBulkUsersFile(t *testing.T, name, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func buildTestBulkOrgUsers() []platformclientv2.User {
+	ada := platformclientv2.User{
+		Id:        platformclientv2.String("ada-id"),
+		Email:     platformclientv2.String("Ada@example.com"),
+		Name:      platformclientv2.String("Ada Lovelace"),
+		Title:     platformclientv2.String("Engineer"),
+		State:     platformclientv2.String("active"),
+		Locations: &[]platformclientv2.Location{{LocationDefinition: &platformclientv2.Locationdefinition{Id: platformclientv2.String("loc-1")}}},
+		Skills: &[]platformclientv2.Userroutingskill{
+			{Id: platformclientv2.String("skill-1"), Proficiency: platformclientv2.Float64(3)},
+			{Id: platformclientv2.String("skill-2"), Proficiency: platformclientv2.Float64(4.5)},
+		},
+		Languages: &[]platformclientv2.Userroutinglanguage{{Id: platformclientv2.String("lang-1"), Proficiency: platformclientv2.Float64(5)}},
+	}
+	adaManager := &ada
+	return []platformclientv2.User{
+		ada,
+		{
+			Id:      platformclientv2.String("grace-id"),
+			Email:   platformclientv2.String("grace@example.com"),
+			Name:    platformclientv2.String("Grace Hopper"),
+			Title:   platformclientv2.String("Rear Admiral"),
+			State:   platformclientv2.String("active"),
+			Manager: &adaManager,
+			Skills:  &[]platformclientv2.Userroutingskill{{Id: platformclientv2.String("skill-1"), Proficiency: platformclientv2.Float64(2)}},
+		},
+		{
+			Id:    platformclientv2.String("edsger-id"),
+			Email: platformclientv2.String("edsger@example.com"),
+			Name:  platformclientv2.String("Edsger Dijkstra"),
+			State: platformclientv2.String("active"),
+		},
+	}
+}
+
+func TestUnitUsersBulkParseSource(t *testing.T) {
+	rows, err := readBulkUserSource(writeTestBulkUsersFile(t, "users.csv", testBulkUsersCsv), "")
+	assert.NoError(t, err)
+	if assert.Len(t, rows, 3) {
+		assert.Equal(t, map[string]float64{"skill-1": 3, "skill-2": 4.5}, rows[0].skills)
+		assert.Equal(t, map[string]int{"lang-1": 5}, rows[0].languages)
+		assert.Equal(t, []string{"loc-1"}, rows[0].locations)
+		assert.False(t, rows[1].has("department"), "fields without a column are left alone")
+		assert.Equal(t, "ada@example.com", rows[1].manager)
+		assert.Equal(t, map[string]int{}, rows[1].languages, "an empty cell removes all the languages")
+	}
+
+	jsonPath := writeTestBulkUsersFile(t, "users.json", `[{"email": "ada@example.com", "name": "Ada Lovelace", "skills": {"skill-1": 3}}]`)
+	rows, err = readBulkUserSource(jsonPath, "")
+	assert.NoError(t, err)
+	if assert.Len(t, rows, 1) {
+		assert.Equal(t, map[string]float64{"skill-1": 3}, rows[0].skills)
+		assert.Nil(t, rows[0].languages, "languages that are not set are left alone")
+	}
+
+	invalidPath := writeTestBulkUsersFile(t, "invalid.csv", "email,name,state\nnot-an-email,,\nada@example.com,Ada,retired\nADA@example.com,Ada,\n")
+	_, err = readBulkUserSource(invalidPath, "")
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), `row 1: invalid email "not-an-email"`)
+		assert.Contains(t, err.Error(), "row 1: name is required")
+		assert.Contains(t, err.Error(), `row 2: state must be active or inactive, not "retired"`)
+		assert.Contains(t, err.Error(), "row 3: email ADA@example.com is also used by row 2")
+	}
+
+	_, err = readBulkUserSource(writeTestBulkUsersFile(t, "users.txt", testBulkUsersCsv), "")
+	assert.ErrorContains(t, err, "cannot infer the format")
+}
+
+func TestUnitUsersBulkPlanChanges(t *testing.T) {
+	rows, err := parseBulkUserCsv(strings.NewReader(testBulkUsersCsv))
+	assert.NoError(t, err)
+	assert.NoError(t, validateBulkUserRows(rows))
+
+	managedIds := map[string]string{"ada@example.com": "ada-id", "edsger@example.com": "edsger-id"}
+	changes := planBulkUserChanges(rows, buildTestBulkOrgUsers(), managedIds, true)
+	if assert.Len(t, changes, 4) {
+		assert.Equal(t, bulkUserActionUnchanged, changes[0].action)
+		assert.Equal(t, bulkUserActionUpdate, changes[1].action)
+		assert.Equal(t, []string{"title"}, changes[1].fields)
+		assert.Equal(t, bulkUserActionCreate, changes[2].action)
+		assert.Equal(t, bulkUserActionDeactivate, changes[3].action)
+		assert.Equal(t, "edsger@example.com", changes[3].email)
+	}
+
+	changes = planBulkUserChanges(rows, buildTestBulkOrgUsers(), managedIds, false)
+	assert.Len(t, changes, 3, "removed users are kept when deactivate_removed_users is not set")
+
+	results := []bulkUserResult{
+		{row: 1, email: "ada@example.com", action: bulkUserActionUnchanged, status: bulkUserStatusSucceeded, userId: "ada-id"},
+		{row: 3, email: "alan@example.com", action: bulkUserActionCreate, status: bulkUserStatusFailed},
+		{email: "edsger@example.com", action: bulkUserActionDeactivate, status: bulkUserStatusSucceeded, userId: "edsger-id"},
+	}
+	assert.Equal(t, map[string]string{"ada@example.com": "ada-id"}, buildBulkUserIds(results, managedIds))
+	assert.Equal(t, map[string]int{"create": 0, "update": 0, "deactivate": 1, "unchanged": 1, "failed": 1}, countBulkUserResults(results))
+
+	reportPath := filepath.Join(t.TempDir(), "report.csv")
+	assert.NoError(t, writeBulkUserReport(reportPath, results))
+	report, _ := os.ReadFile(reportPath)
+	assert.Equal(t, "row,email,action,status,user_id,message\n1,ada@example.com,unchanged,succeeded,ada-id,\n3,alan@example.com,create,failed,,\n,edsger@example.com,deactivate,succeeded,edsger-id,\n", string(report))
+}
+
+func TestUnitUsersBulkReadDetectsDrift(t *testing.T) {
+	sourcePath := writeTestBulkUsersFile(t, "users.csv", "email,name,title\nada@example.com,Ada Lovelace,Engineer\n")
+	hash, err := files.HashFileContent(sourcePath)
+	assert.NoError(t, err)
+
+	orgUsers := buildTestBulkOrgUsers()
+	proxy := newUserProxy(&platformclientv2.Configuration{})
+	proxy.GetAllUserAttr = func(ctx context.Context, p *userProxy) (*[]platformclientv2.User, *platformclientv2.APIResponse, error) {
+		return &orgUsers, &platformclientv2.APIResponse{StatusCode: http.StatusOK}, nil
+	}
+	internalProxy = proxy
+	defer func() { internalProxy = nil }()
+
+	meta := &provider.ProviderMeta{ClientConfig: &platformclientv2.Configuration{}}
+	d := schema.TestResourceDataRaw(t, ResourceUsersBulk().Schema, map[string]interface{}{
+		"source_filepath":          sourcePath,
+		"deactivate_removed_users": false,
+	})
+	d.SetId("bulk-id")
+	_ = d.Set("source_file_content_hash", hash)
+	_ = d.Set("user_ids", map[string]interface{}{"ada@example.com": "ada-id", "gone@example.com": "gone-id"})
+
+	diags := readUsersBulk(context.Background(), d, meta)
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, hash, d.Get("source_file_content_hash"), "the users match the file")
+	assert.Equal(t, map[string]interface{}{"ada@example.com": "ada-id"}, d.Get("user_ids"), "deleted users are no longer managed")
+
+	(*orgUsers[0].Title) = "Countess"
+	diags = readUsersBulk(context.Background(), d, meta)
+	assert.False(t, diags.HasError(), diags)
+	assert.Equal(t, "", d.Get("source_file_content_hash"), "the drift is applied again by the next apply")
+}
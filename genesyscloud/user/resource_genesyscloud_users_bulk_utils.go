@@ -0,0 +1,752 @@
+package user
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"maps"
+	"net/mail"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"terraform-provider-genesyscloud/genesyscloud/util"
+	chunksProcess "terraform-provider-genesyscloud/genesyscloud/util/chunks"
+	"terraform-provider-genesyscloud/genesyscloud/util/files"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/mypurecloud/platform-client-sdk-go/v154/platformclientv2"
+)
+
+/*
+The resource_genesyscloud_users_bulk_utils.go file contains the helpers of the genesyscloud_users_bulk resource.
+
+The source file is parsed into rows, the rows are compared with the users of the organization to plan the creates,
+updates and deactivations, and the planned changes are applied in chunks. Every row gets a result that is written
+to the report file.
+*/
+
+const (
+	bulkUserFormatCsv  = "csv"
+	bulkUserFormatJson = "json"
+
+	bulkUserActionCreate     = "create"
+	bulkUserActionUpdate     = "update"
+	bulkUserActionDeactivate = "deactivate"
+	bulkUserActionUnchanged  = "unchanged"
+
+	bulkUserStatusSucceeded = "succeeded"
+	bulkUserStatusFailed    = "failed"
+)
+
+// bulkUserColumns are the columns of a CSV source file, which are also the fields of a JSON source file
+var bulkUserColumns = []string{"email", "name", "title", "department", "state", "division_id", "manager", "skills", "languages", "locations"}
+
+// bulkUserRow is a user described by a row of the source file. The fields that the source does not set, because the
+// CSV file has no such column or the JSON object no such field, are left as they are in Genesys Cloud.
+type bulkUserRow struct {
+	row        int
+	fieldsSet  map[string]bool
+	email      string
+	name       string
+	title      string
+	department string
+	state      string
+	divisionId string
+	manager    string
+	skills     map[string]float64
+	languages  map[string]int
+	locations  []string
+}
+
+// bulkUserJsonRow is a user of a JSON source file
+type bulkUserJsonRow struct {
+	Email      string              `json:"email"`
+	Name       string              `json:"name"`
+	Title      string              `json:"title"`
+	Department string              `json:"department"`
+	State      string              `json:"state"`
+	DivisionId string              `json:"division_id"`
+	Manager    string              `json:"manager"`
+	Skills     *map[string]float64 `json:"skills"`
+	Languages  *map[string]int     `json:"languages"`
+	Locations  *[]string           `json:"locations"`
+}
+
+// bulkUserChange is a change planned for a user. row is nil for deactivations and user is nil for creates.
+type bulkUserChange struct {
+	action string
+	email  string
+	row    *bulkUserRow
+	user   *platformclientv2.User
+	fields []string
+}
+
+// bulkUserResult is the outcome of a change, reported for each row of the source file and each deactivated user
+type bulkUserResult struct {
+	row     int
+	email   string
+	action  string
+	status  string
+	userId  string
+	message string
+}
+
+// resolveBulkUserFormat returns the configured format of the source file, or the format matching its extension
+// has returns whether the source sets the field of the user
+func (r *bulkUserRow) has(field string) bool {
+	return r.fieldsSet[field]
+}
+
+func resolveBulkUserFormat(path, format string) (string, error) {
+	if format != "" {
+		return format, nil
+	}
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return bulkUserFormatCsv, nil
+	case ".json":
+		return bulkUserFormatJson, nil
+	}
+	return "", fmt.Errorf("cannot infer the format of %s from its extension, set source_format to %s or %s", path, bulkUserFormatCsv, bulkUserFormatJson)
+}
+
+// readBulkUserSource parses and validates the users of a CSV or JSON source file
+func readBulkUserSource(path, format string) ([]bulkUserRow, error) {
+	format, err := resolveBulkUserFormat(path, format)
+	if err != nil {
+		return nil, err
+	}
+
+	reader, file, err := files.DownloadOrOpenFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if file != nil {
+		defer file.Close()
+	}
+
+	var rows []bulkUserRow
+	if format == bulkUserFormatJson {
+		rows, err = parseBulkUserJson(reader)
+	} else {
+		rows, err = parseBulkUserCsv(reader)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	if err := validateBulkUserRows(rows); err != nil {
+		return nil, fmt.Errorf("invalid users in %s: %w", path, err)
+	}
+	return rows, nil
+}
+
+// parseBulkUserCsv parses a CSV file with a header row. Skills and languages are written as id:proficiency pairs
+// separated by semicolons and locations as location IDs separated by semicolons.
+func parseBulkUserCsv(reader io.Reader) ([]bulkUserRow, error) {
+	csvReader := csv.NewReader(reader)
+	header, err := csvReader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the header row: %w", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	fieldsSet := make(map[string]bool, len(header))
+	for i, column := range header {
+		column = strings.ToLower(strings.TrimSpace(column))
+		if !slices.Contains(bulkUserColumns, column) {
+			return nil, fmt.Errorf("unknown column %q, the supported columns are %s", column, strings.Join(bulkUserColumns, ", "))
+		}
+		columns[column] = i
+		fieldsSet[column] = true
+	}
+	if _, ok := columns["email"]; !ok {
+		return nil, errors.New(`the header row has no "email" column`)
+	}
+
+	var rows []bulkUserRow
+	for rowNum := 1; ; rowNum++ {
+		record, err := csvReader.Read()
+		if err == io.EOF {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		cell := func(column string) (string, bool) {
+			i, ok := columns[column]
+			if !ok {
+				return "", false
+			}
+			return strings.TrimSpace(record[i]), true
+		}
+		row := bulkUserRow{row: rowNum, fieldsSet: fieldsSet}
+		row.email, _ = cell("email")
+		row.name, _ = cell("name")
+		row.title, _ = cell("title")
+		row.department, _ = cell("department")
+		row.state, _ = cell("state")
+		row.divisionId, _ = cell("division_id")
+		row.manager, _ = cell("manager")
+
+		if value, ok := cell("skills"); ok {
+			if row.skills, err = parseBulkUserProficiencies(value, func(s string) (float64, error) { return strconv.ParseFloat(s, 64) }); err != nil {
+				return nil, fmt.Errorf("row %d: skills: %w", rowNum, err)
+			}
+		}
+		if value, ok := cell("languages"); ok {
+			if row.languages, err = parseBulkUserProficiencies(value, strconv.Atoi); err != nil {
+				return nil, fmt.Errorf("row %d: languages: %w", rowNum, err)
+			}
+		}
+		if value, ok := cell("locations"); ok {
+			row.locations = splitBulkUserList(value)
+		}
+		rows = append(rows, row)
+	}
+}
+
+// parseBulkUserProficiencies parses a list of id:proficiency pairs separated by semicolons
+func parseBulkUserProficiencies[T any](value string, parse func(string) (T, error)) (map[string]T, error) {
+	profs := make(map[string]T)
+	for _, pair := range splitBulkUserList(value) {
+		id, prof, found := strings.Cut(pair, ":")
+		if !found {
+			return nil, fmt.Errorf("%q is not an id:proficiency pair", pair)
+		}
+		parsed, err := parse(strings.TrimSpace(prof))
+		if err != nil {
+			return nil, fmt.Errorf("invalid proficiency in %q", pair)
+		}
+		profs[strings.TrimSpace(id)] = parsed
+	}
+	return profs, nil
+}
+
+func splitBulkUserList(value string) []string {
+	items := make([]string, 0)
+	for _, item := range strings.Split(value, ";") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// parseBulkUserJson parses a JSON array of users
+func parseBulkUserJson(reader io.Reader) ([]bulkUserRow, error) {
+	var rawRows []map[string]json.RawMessage
+	if err := json.NewDecoder(reader).Decode(&rawRows); err != nil {
+		return nil, err
+	}
+
+	rows := make([]bulkUserRow, len(rawRows))
+	for i, rawRow := range rawRows {
+		fieldsSet := make(map[string]bool, len(rawRow))
+		for field := range rawRow {
+			if !slices.Contains(bulkUserColumns, field) {
+				return nil, fmt.Errorf("user %d: unknown field %q, the supported fields are %s", i+1, field, strings.Join(bulkUserColumns, ", "))
+			}
+			fieldsSet[field] = true
+		}
+		encoded, _ := json.Marshal(rawRow)
+		var jsonRow bulkUserJsonRow
+		if err := json.Unmarshal(encoded, &jsonRow); err != nil {
+			return nil, fmt.Errorf("user %d: %w", i+1, err)
+		}
+
+		rows[i] = bulkUserRow{
+			row:        i + 1,
+			fieldsSet:  fieldsSet,
+			email:      strings.TrimSpace(jsonRow.Email),
+			name:       jsonRow.Name,
+			title:      jsonRow.Title,
+			department: jsonRow.Department,
+			state:      jsonRow.State,
+			divisionId: jsonRow.DivisionId,
+			manager:    jsonRow.Manager,
+		}
+		if jsonRow.Skills != nil {
+			rows[i].skills = *jsonRow.Skills
+		}
+		if jsonRow.Languages != nil {
+			rows[i].languages = *jsonRow.Languages
+		}
+		if jsonRow.Locations != nil {
+			rows[i].locations = *jsonRow.Locations
+		}
+	}
+	return rows, nil
+}
+
+// validateBulkUserRows checks the values of every row
+func validateBulkUserRows(rows []bulkUserRow) error {
+	var errs []error
+	emails := make(map[string]int, len(rows))
+	for i := range rows {
+		row := &rows[i]
+		if _, err := mail.ParseAddress(row.email); err != nil {
+			errs = append(errs, fmt.Errorf("row %d: invalid email %q", row.row, row.email))
+		} else if firstRow, found := emails[strings.ToLower(row.email)]; found {
+			errs = append(errs, fmt.Errorf("row %d: email %s is also used by row %d", row.row, row.email, firstRow))
+		} else {
+			emails[strings.ToLower(row.email)] = row.row
+		}
+		if row.name == "" {
+			errs = append(errs, fmt.Errorf("row %d: name is required", row.row))
+		}
+		if row.state != "" && row.state != "active" && row.state != "inactive" {
+			errs = append(errs, fmt.Errorf("row %d: state must be active or inactive, not %q", row.row, row.state))
+		}
+		for skillId, prof := range row.skills {
+			if prof < 0 || prof > 5 {
+				errs = append(errs, fmt.Errorf("row %d: proficiency of skill %s must be between 0 and 5", row.row, skillId))
+			}
+		}
+		for langId, prof := range row.languages {
+			if prof < 0 || prof > 5 {
+				errs = append(errs, fmt.Errorf("row %d: proficiency of language %s must be between 0 and 5", row.row, langId))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// planBulkUserChanges compares the rows with the users of the organization. Users that were managed by the resource,
+// as recorded in managedIds, and are no longer in the rows are deactivated when deactivateRemoved is set.
+func planBulkUserChanges(rows []bulkUserRow, orgUsers []platformclientv2.User, managedIds map[string]string, deactivateRemoved bool) []bulkUserChange {
+	orgUsersByEmail := make(map[string]*platformclientv2.User, len(orgUsers))
+	for i, user := range orgUsers {
+		if user.Email != nil {
+			orgUsersByEmail[strings.ToLower(*user.Email)] = &orgUsers[i]
+		}
+	}
+
+	changes := make([]bulkUserChange, 0, len(rows))
+	rowEmails := make(map[string]bool, len(rows))
+	for i := range rows {
+		row := &rows[i]
+		rowEmails[strings.ToLower(row.email)] = true
+
+		user, found := orgUsersByEmail[strings.ToLower(row.email)]
+		if !found {
+			changes = append(changes, bulkUserChange{action: bulkUserActionCreate, email: row.email, row: row})
+			continue
+		}
+
+		change := bulkUserChange{action: bulkUserActionUnchanged, email: row.email, row: row, user: user}
+		change.fields = diffBulkUser(row, user, orgUsersByEmail)
+		if len(change.fields) > 0 {
+			change.action = bulkUserActionUpdate
+		}
+		changes = append(changes, change)
+	}
+
+	if !deactivateRemoved {
+		return changes
+	}
+	removedEmails := make([]string, 0)
+	for email := range managedIds {
+		if !rowEmails[strings.ToLower(email)] {
+			removedEmails = append(removedEmails, email)
+		}
+	}
+	sort.Strings(removedEmails)
+	for _, email := range removedEmails {
+		user, found := orgUsersByEmail[strings.ToLower(email)]
+		if !found || (user.State != nil && *user.State != "active") {
+			continue
+		}
+		changes = append(changes, bulkUserChange{action: bulkUserActionDeactivate, email: email, user: user, fields: []string{"state"}})
+	}
+	return changes
+}
+
+// diffBulkUser returns the names of the fields of the row that differ from the user
+func diffBulkUser(row *bulkUserRow, user *platformclientv2.User, orgUsersByEmail map[string]*platformclientv2.User) []string {
+	var fields []string
+	compare := func(field, value string, current *string) {
+		if row.has(field) && value != stringOrEmpty(current) {
+			fields = append(fields, field)
+		}
+	}
+	compare("name", row.name, user.Name)
+	compare("title", row.title, user.Title)
+	compare("department", row.department, user.Department)
+	if row.state != "" {
+		compare("state", row.state, user.State)
+	}
+
+	if row.divisionId != "" && (user.Division == nil || stringOrEmpty(user.Division.Id) != row.divisionId) {
+		fields = append(fields, "division_id")
+	}
+
+	if row.has("manager") {
+		currentManager := ""
+		if user.Manager != nil && *user.Manager != nil {
+			currentManager = stringOrEmpty((*user.Manager).Id)
+		}
+		if managerId, resolved := resolveBulkUserManager(row.manager, orgUsersByEmail, nil); !resolved || managerId != currentManager {
+			fields = append(fields, "manager")
+		}
+	}
+
+	if row.locations != nil {
+		currentLocations := make([]string, 0)
+		if user.Locations != nil {
+			for _, location := range *user.Locations {
+				if location.LocationDefinition != nil && location.LocationDefinition.Id != nil {
+					currentLocations = append(currentLocations, *location.LocationDefinition.Id)
+				}
+			}
+		}
+		if !sameStrings(row.locations, currentLocations) {
+			fields = append(fields, "locations")
+		}
+	}
+	if row.skills != nil {
+		var currentSkills []platformclientv2.Userroutingskill
+		if user.Skills != nil {
+			currentSkills = *user.Skills
+		}
+		if !maps.Equal(row.skills, buildSkillProficiencies(currentSkills)) {
+			fields = append(fields, "skills")
+		}
+	}
+	if row.languages != nil {
+		var currentLanguages []platformclientv2.Userroutinglanguage
+		if user.Languages != nil {
+			currentLanguages = *user.Languages
+		}
+		if !maps.Equal(row.languages, buildLanguageProficiencies(currentLanguages)) {
+			fields = append(fields, "languages")
+		}
+	}
+	return fields
+}
+
+// resolveBulkUserManager returns the user ID of a manager given as an email or as a user ID. Managers given as an
+// email are looked up in the organization, then in the users created by the resource.
+func resolveBulkUserManager(manager string, orgUsersByEmail map[string]*platformclientv2.User, createdIds map[string]string) (string, bool) {
+	if !strings.Contains(manager, "@") {
+		return manager, true
+	}
+	if user, found := orgUsersByEmail[strings.ToLower(manager)]; found && user.Id != nil {
+		return *user.Id, true
+	}
+	if id, found := createdIds[strings.ToLower(manager)]; found {
+		return id, true
+	}
+	return "", false
+}
+
+// applyBulkUserChanges applies the changes in chunks. The creates are applied before the other changes so that
+// the users created can be set as the manager of other users.
+func applyBulkUserChanges(ctx context.Context, proxy *userProxy, changes []bulkUserChange, orgUsers []platformclientv2.User, chunkSize int) []bulkUserResult {
+	orgUsersByEmail := make(map[string]*platformclientv2.User, len(orgUsers))
+	for i, user := range orgUsers {
+		if user.Email != nil {
+			orgUsersByEmail[strings.ToLower(*user.Email)] = &orgUsers[i]
+		}
+	}
+
+	results := make([]bulkUserResult, len(changes))
+	createdIds := make(map[string]string)
+	var creates, others []int
+	for i, change := range changes {
+		results[i] = bulkUserResult{email: change.email, action: change.action, status: bulkUserStatusSucceeded}
+		if change.row != nil {
+			results[i].row = change.row.row
+		}
+		if change.user != nil {
+			results[i].userId = stringOrEmpty(change.user.Id)
+		}
+		switch change.action {
+		case bulkUserActionCreate:
+			creates = append(creates, i)
+		case bulkUserActionUnchanged:
+		default:
+			others = append(others, i)
+		}
+	}
+
+	var lock sync.Mutex
+	applyChunks := func(indexes []int, apply func(change *bulkUserChange, result *bulkUserResult) error) {
+		if len(indexes) == 0 {
+			return
+		}
+		_ = chunksProcess.ProcessChunks(chunksProcess.ChunkBy(indexes, chunkSize), func(chunk []int) diag.Diagnostics {
+			var wg sync.WaitGroup
+			for _, i := range chunk {
+				wg.Add(1)
+				go func(change *bulkUserChange, result *bulkUserResult) {
+					defer wg.Done()
+					if err := apply(change, result); err != nil {
+						result.status = bulkUserStatusFailed
+						result.message = err.Error()
+					}
+				}(&changes[i], &results[i])
+			}
+			wg.Wait()
+			return nil
+		})
+	}
+
+	applyChunks(creates, func(change *bulkUserChange, result *bulkUserResult) error {
+		id, restored, err := createBulkUser(ctx, proxy, change.row)
+		if err != nil {
+			return err
+		}
+		result.userId = id
+		if restored {
+			result.message = "restored a deleted user"
+		}
+		lock.Lock()
+		createdIds[strings.ToLower(change.email)] = id
+		lock.Unlock()
+		return nil
+	})
+
+	// The users created need the fields that can only be set with an update, and the restored users need all of them
+	for _, i := range creates {
+		if results[i].status != bulkUserStatusSucceeded {
+			continue
+		}
+		row := changes[i].row
+		changes[i].fields = []string{"skills", "languages"}
+		if results[i].message != "" {
+			changes[i].fields = append(changes[i].fields, "name", "title", "department", "division_id")
+		}
+		if row.manager != "" {
+			changes[i].fields = append(changes[i].fields, "manager")
+		}
+		if len(row.locations) > 0 {
+			changes[i].fields = append(changes[i].fields, "locations")
+		}
+		others = append(others, i)
+	}
+
+	applyChunks(others, func(change *bulkUserChange, result *bulkUserResult) error {
+		if change.action == bulkUserActionDeactivate {
+			return patchBulkUser(ctx, proxy, result.userId, platformclientv2.Updateuser{State: platformclientv2.String("inactive")})
+		}
+		return updateBulkUser(ctx, proxy, result.userId, change, orgUsersByEmail, createdIds)
+	})
+	return results
+}
+
+// createBulkUser creates the user of a row, or restores it if a user with the same email has been deleted
+func createBulkUser(ctx context.Context, proxy *userProxy, row *bulkUserRow) (string, bool, error) {
+	state := row.state
+	if state == "" {
+		state = "active"
+	}
+
+	deletedId, diagErr := getDeletedUserId(row.email, proxy)
+	if diagErr != nil {
+		return "", false, fmt.Errorf("%v", diagErr)
+	}
+	if deletedId != nil {
+		log.Printf("Restoring deleted user %s", row.email)
+		if err := patchBulkUserWithState(ctx, proxy, *deletedId, "deleted", platformclientv2.Updateuser{State: &state}); err != nil {
+			return "", false, err
+		}
+		return *deletedId, true, nil
+	}
+
+	createUser := platformclientv2.Createuser{
+		Email:      &row.email,
+		Name:       &row.name,
+		Title:      &row.title,
+		Department: &row.department,
+		State:      &state,
+	}
+	if row.divisionId != "" {
+		createUser.DivisionId = &row.divisionId
+	}
+	user, resp, err := proxy.createUser(ctx, &createUser)
+	if err != nil {
+		return "", false, fmt.Errorf("failed to create user %s: %v", row.email, util.BuildAPIDiagnosticError(BulkResourceType, err.Error(), resp))
+	}
+	return *user.Id, false, nil
+}
+
+// updateBulkUser applies the changed fields of a row to an existing user
+func updateBulkUser(ctx context.Context, proxy *userProxy, userId string, change *bulkUserChange, orgUsersByEmail map[string]*platformclientv2.User, createdIds map[string]string) error {
+	row := change.row
+	update := buildBulkUserUpdate(row)
+	needsPatch := false
+	for _, field := range change.fields {
+		switch field {
+		case "name", "title", "department", "state":
+			needsPatch = true
+		case "manager":
+			managerId, resolved := resolveBulkUserManager(row.manager, orgUsersByEmail, createdIds)
+			if !resolved {
+				return fmt.Errorf("manager %s is neither a user of the organization nor a row of the source file", row.manager)
+			}
+			update.Manager = &managerId
+			needsPatch = true
+		case "locations":
+			if row.locations != nil {
+				locations := make([]platformclientv2.Location, len(row.locations))
+				for i := range row.locations {
+					locations[i] = platformclientv2.Location{Id: &row.locations[i]}
+				}
+				update.Locations = &locations
+				needsPatch = true
+			}
+		}
+	}
+	if needsPatch {
+		if err := patchBulkUser(ctx, proxy, userId, update); err != nil {
+			return err
+		}
+	}
+
+	if row.divisionId != "" && slices.Contains(change.fields, "division_id") {
+		authApi := platformclientv2.NewAuthorizationApiWithConfig(proxy.clientConfig)
+		if _, err := authApi.PostAuthorizationDivisionObject(row.divisionId, "USER", []string{userId}); err != nil {
+			return fmt.Errorf("failed to move user %s to division %s: %s", row.email, row.divisionId, err)
+		}
+	}
+
+	if row.skills != nil && slices.Contains(change.fields, "skills") {
+		var currentSkills []platformclientv2.Userroutingskill
+		if change.user != nil && change.user.Skills != nil {
+			currentSkills = *change.user.Skills
+		}
+		if diagErr := syncUserRoutingSkills(userId, row.skills, buildSkillProficiencies(currentSkills), proxy); diagErr != nil {
+			return fmt.Errorf("%v", diagErr)
+		}
+	}
+	if row.languages != nil && slices.Contains(change.fields, "languages") {
+		var currentLanguages []platformclientv2.Userroutinglanguage
+		if change.user != nil && change.user.Languages != nil {
+			currentLanguages = *change.user.Languages
+		}
+		if diagErr := syncUserRoutingLanguages(userId, row.languages, buildLanguageProficiencies(currentLanguages), proxy); diagErr != nil {
+			return fmt.Errorf("%v", diagErr)
+		}
+	}
+	return nil
+}
+
+// buildBulkUserUpdate builds an update of the fields set by the row
+func buildBulkUserUpdate(row *bulkUserRow) platformclientv2.Updateuser {
+	update := platformclientv2.Updateuser{Name: &row.name}
+	if row.has("title") {
+		update.Title = &row.title
+	}
+	if row.has("department") {
+		update.Department = &row.department
+	}
+	if row.state != "" {
+		update.State = &row.state
+	}
+	return update
+}
+
+// patchBulkUser patches an active or inactive user with its current version
+func patchBulkUser(ctx context.Context, proxy *userProxy, userId string, update platformclientv2.Updateuser) error {
+	return patchBulkUserWithState(ctx, proxy, userId, "", update)
+}
+
+func patchBulkUserWithState(ctx context.Context, proxy *userProxy, userId, state string, update platformclientv2.Updateuser) error {
+	diagErr := util.RetryWhen(util.IsVersionMismatch, func() (*platformclientv2.APIResponse, diag.Diagnostics) {
+		// The cached users hydrated by the listing may hold an older version, so the user is read from the API
+		currentUser, resp, err := proxy.getUserByIdAttr(ctx, proxy, userId, nil, state)
+		if err != nil {
+			return resp, util.BuildAPIDiagnosticError(BulkResourceType, fmt.Sprintf("Failed to read user %s error: %s", userId, err), resp)
+		}
+		update.Version = currentUser.Version
+		_, resp, err = proxy.patchUserWithState(ctx, userId, &update)
+		if err != nil {
+			return resp, util.BuildAPIDiagnosticError(BulkResourceType, fmt.Sprintf("Failed to update user %s error: %s", userId, err), resp)
+		}
+		return resp, nil
+	})
+	if diagErr != nil {
+		return fmt.Errorf("%v", diagErr)
+	}
+	return nil
+}
+
+// countBulkUserResults counts the results by action, and the failed changes separately
+func countBulkUserResults(results []bulkUserResult) map[string]int {
+	counts := map[string]int{
+		bulkUserActionCreate:     0,
+		bulkUserActionUpdate:     0,
+		bulkUserActionDeactivate: 0,
+		bulkUserActionUnchanged:  0,
+		bulkUserStatusFailed:     0,
+	}
+	for _, result := range results {
+		if result.status == bulkUserStatusFailed {
+			counts[bulkUserStatusFailed]++
+			continue
+		}
+		counts[result.action]++
+	}
+	return counts
+}
+
+// buildBulkUserIds maps the email of each user managed by the resource to its ID. Users that failed to be
+// created are left out, and deactivated users are no longer managed.
+func buildBulkUserIds(results []bulkUserResult, previousIds map[string]string) map[string]string {
+	ids := make(map[string]string, len(results))
+	for _, result := range results {
+		switch {
+		case result.action == bulkUserActionDeactivate && result.status == bulkUserStatusSucceeded:
+			continue
+		case result.userId != "":
+			ids[result.email] = result.userId
+		case previousIds[result.email] != "":
+			ids[result.email] = previousIds[result.email]
+		}
+	}
+	return ids
+}
+
+// writeBulkUserReport writes the result of every change as a CSV file
+func writeBulkUserReport(path string, results []bulkUserResult) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create report %s: %w", path, err)
+	}
+	defer file.Close()
+
+	writer := csv.NewWriter(file)
+	_ = writer.Write([]string{"row", "email", "action", "status", "user_id", "message"})
+	for _, result := range results {
+		row := ""
+		if result.row > 0 {
+			row = strconv.Itoa(result.row)
+		}
+		_ = writer.Write([]string{row, result.email, result.action, result.status, result.userId, result.message})
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+func sameStrings(a, b []string) bool {
+	a, b = slices.Clone(a), slices.Clone(b)
+	slices.Sort(a)
+	slices.Sort(b)
+	return slices.Equal(a, b)
+}
+
+func stringOrEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -230,31 +230,38 @@ func downloadExportFileWithAccessToken(directory, fileName, uri, accessToken str
 	}
 
 	resp, err := client.Do(req)
-	apiResp, apiErr := platformclientv2.NewAPIResponse(resp, nil)
 	if err != nil {
-		return apiResp, err
+		return nil, err
 	}
+	defer resp.Body.Close()
+
+	apiResp, apiErr := platformclientv2.NewAPIResponse(resp, nil)
 	if apiErr != nil {
 		return apiResp, apiErr
 	}
-	defer resp.Body.Close()
+	if !apiResp.IsSuccess {
+		return apiResp, fmt.Errorf("HTTP Error downloading file: %v", resp.StatusCode)
+	}
 
 	if err := os.MkdirAll(directory, 0755); err != nil {
 		return apiResp, fmt.Errorf("failed to create directory: %w", err)
 	}
 
-	out, err := os.Create(filepath.Join(directory, fileName))
+	// Export files can be large, so the body is streamed to a temporary file that only replaces the target once complete
+	out, err := os.CreateTemp(directory, fileName+".*.tmp")
 	if err != nil {
 		return apiResp, err
 	}
-	defer func(out *os.File) {
-		if err := out.Close(); err != nil {
-			log.Printf("failed to close file: %s", err.Error())
-		}
-	}(out)
+	defer os.Remove(out.Name())
 
-	_, err = io.Copy(out, resp.Body)
-	return apiResp, err
+	if _, err := io.Copy(out, resp.Body); err != nil {
+		_ = out.Close()
+		return apiResp, err
+	}
+	if err := out.Close(); err != nil {
+		return apiResp, err
+	}
+	return apiResp, os.Rename(out.Name(), filepath.Join(directory, fileName))
 }
 
 // HashFileContent Hash file content, used in stateFunc for "filepath" type attributes
@@ -340,6 +340,51 @@ func TestHashFileContent(t *testing.T) {
 	})
 }
 
+func TestDownloadExportFileWithAccessToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer test-token" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("id,phone\n1,3175550100\n"))
+	}))
+	defer server.Close()
+
+	directory := filepath.Join(t.TempDir(), "outbound_contacts")
+
+	t.Run("successful download", func(t *testing.T) {
+		_, err := DownloadExportFileWithAccessToken(directory, "contacts.csv", server.URL, "test-token")
+		assert.NoError(t, err)
+
+		content, err := os.ReadFile(filepath.Join(directory, "contacts.csv"))
+		assert.NoError(t, err)
+		assert.Equal(t, "id,phone\n1,3175550100\n", string(content))
+
+		// No temporary files are left behind
+		entries, err := os.ReadDir(directory)
+		assert.NoError(t, err)
+		assert.Len(t, entries, 1)
+	})
+
+	t.Run("unsuccessful download keeps the existing file", func(t *testing.T) {
+		resp, err := DownloadExportFileWithAccessToken(directory, "contacts.csv", server.URL, "wrong-token")
+		assert.Error(t, err)
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+		content, err := os.ReadFile(filepath.Join(directory, "contacts.csv"))
+		assert.NoError(t, err)
+		assert.Equal(t, "id,phone\n1,3175550100\n", string(content))
+	})
+
+	t.Run("unreachable server", func(t *testing.T) {
+		_, err := DownloadExportFileWithAccessToken(directory, "other.csv", "http://127.0.0.1:0/export", "")
+		assert.Error(t, err)
+		_, err = os.Stat(filepath.Join(directory, "other.csv"))
+		assert.True(t, os.IsNotExist(err))
+	})
+}
+
 func TestGetCSVRecordCount(t *testing.T) {
 	tests := []struct {
 		name          string
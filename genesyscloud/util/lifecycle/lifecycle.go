@@ -0,0 +1,123 @@
+package lifecycle
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"terraform-provider-genesyscloud/genesyscloud/util"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/mypurecloud/platform-client-sdk-go/v154/platformclientv2"
+)
+
+/*
+The lifecycle package orchestrates the status transitions of outbound objects that contact customers, such as campaigns,
+messaging campaigns and campaign sequences. A running object cannot safely be changed, so it is turned off first, the
+provider waits for it to go from stopping to off and for its active interactions to drain, and the resource then applies
+its change and restores the status.
+*/
+
+const (
+	StatusOn       = "on"
+	StatusOff      = "off"
+	StatusStopping = "stopping"
+
+	// DefaultDrainTimeoutSeconds is the default time to wait for a running object to stop and drain
+	DefaultDrainTimeoutSeconds = 300
+)
+
+// PollInterval is the time to wait between status checks while an object is stopping
+var PollInterval = 5 * time.Second
+
+// Orchestrator holds the functions used to read and change the status of an outbound object
+type Orchestrator struct {
+	// ResourceType is used in the diagnostics
+	ResourceType string
+	// Name identifies the object in logs and diagnostics
+	Name string
+	// GetStatus returns the current status of the object
+	GetStatus func(ctx context.Context) (string, *platformclientv2.APIResponse, error)
+	// SetStatus changes the status of the object
+	SetStatus func(ctx context.Context, status string) (*platformclientv2.APIResponse, error)
+	// ActiveCount returns the number of interactions of the object still in progress. It is optional.
+	ActiveCount func(ctx context.Context) (int, error)
+	// DrainTimeout is the maximum time to wait for the object to stop and for its active interactions to finish
+	DrainTimeout time.Duration
+}
+
+// StopIfRunning turns the object off when it is on and waits until it is off and drained.
+// It returns true when the object was running, so the caller can restore its status after applying a change.
+func (o *Orchestrator) StopIfRunning(ctx context.Context) (bool, diag.Diagnostics) {
+	status, resp, err := o.GetStatus(ctx)
+	if err != nil {
+		return false, util.BuildAPIDiagnosticError(o.ResourceType, fmt.Sprintf("Failed to read the status of %s error: %s", o.Name, err), resp)
+	}
+
+	switch status {
+	case StatusOn:
+		log.Printf("Turning off %s before applying changes", o.Name)
+		if resp, err := o.SetStatus(ctx, StatusOff); err != nil {
+			return false, util.BuildAPIDiagnosticError(o.ResourceType, fmt.Sprintf("Failed to turn off %s error: %s", o.Name, err), resp)
+		}
+	case StatusStopping:
+		log.Printf("%s is already stopping", o.Name)
+	default:
+		return false, nil
+	}
+
+	if diagErr := o.waitUntilDrained(ctx); diagErr != nil {
+		return false, diagErr
+	}
+	return status == StatusOn, nil
+}
+
+// waitUntilDrained waits for the object to go from stopping to off and for its active interactions to finish
+func (o *Orchestrator) waitUntilDrained(ctx context.Context) diag.Diagnostics {
+	return util.WithRetries(ctx, o.DrainTimeout, func() *retry.RetryError {
+		time.Sleep(PollInterval)
+
+		status, resp, err := o.GetStatus(ctx)
+		if err != nil {
+			return retry.NonRetryableError(util.BuildWithRetriesApiDiagnosticError(o.ResourceType, fmt.Sprintf("failed to read the status of %s | error: %s", o.Name, err), resp))
+		}
+		if status == StatusOn || status == StatusStopping {
+			return retry.RetryableError(fmt.Errorf("%s is still %s after %s", o.Name, status, o.DrainTimeout))
+		}
+
+		if o.ActiveCount != nil {
+			activeCount, err := o.ActiveCount(ctx)
+			if err != nil {
+				return retry.NonRetryableError(fmt.Errorf("failed to read the active interactions of %s: %s", o.Name, err))
+			}
+			if activeCount > 0 {
+				return retry.RetryableError(fmt.Errorf("%s still has %d active interactions after %s", o.Name, activeCount, o.DrainTimeout))
+			}
+		}
+
+		log.Printf("%s is %s and drained", o.Name, status)
+		return nil
+	})
+}
+
+// RestoreStatus turns the object back on after a change when it was running before, unless the status was changed to off
+func (o *Orchestrator) RestoreStatus(ctx context.Context, wasRunning bool, desiredStatus string) diag.Diagnostics {
+	if !wasRunning || desiredStatus == StatusOff {
+		return nil
+	}
+	log.Printf("Turning %s back on", o.Name)
+	if resp, err := o.SetStatus(ctx, StatusOn); err != nil {
+		return util.BuildAPIDiagnosticError(o.ResourceType, fmt.Sprintf("Failed to turn %s back on error: %s", o.Name, err), resp)
+	}
+	return nil
+}
+
+// SetDefaultDrainTimeout sets drain_timeout_seconds on imported resources, which have no value for the attribute yet
+func SetDefaultDrainTimeout(d *schema.ResourceData) {
+	if _, ok := d.GetOk("drain_timeout_seconds"); !ok {
+		_ = d.Set("drain_timeout_seconds", DefaultDrainTimeoutSeconds)
+	}
+}
@@ -0,0 +1,87 @@
+package lifecycle
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/mypurecloud/platform-client-sdk-go/v154/platformclientv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitStopIfRunning(t *testing.T) {
+	origPollInterval := PollInterval
+	PollInterval = 0
+	defer func() { PollInterval = origPollInterval }()
+
+	var (
+		statuses     = []string{StatusOn, StatusStopping, StatusStopping, StatusOff}
+		activeCounts = []int{2, 0}
+		setStatuses  []string
+	)
+	orchestrator := &Orchestrator{
+		ResourceType: "genesyscloud_outbound_campaign",
+		Name:         "Outbound Campaign test",
+		GetStatus: func(_ context.Context) (string, *platformclientv2.APIResponse, error) {
+			status := statuses[0]
+			if len(statuses) > 1 {
+				statuses = statuses[1:]
+			}
+			return status, nil, nil
+		},
+		SetStatus: func(_ context.Context, status string) (*platformclientv2.APIResponse, error) {
+			setStatuses = append(setStatuses, status)
+			return nil, nil
+		},
+		ActiveCount: func(_ context.Context) (int, error) {
+			count := activeCounts[0]
+			if len(activeCounts) > 1 {
+				activeCounts = activeCounts[1:]
+			}
+			return count, nil
+		},
+		DrainTimeout: time.Minute,
+	}
+
+	wasRunning, diagErr := orchestrator.StopIfRunning(context.Background())
+	assert.False(t, diagErr.HasError())
+	assert.True(t, wasRunning)
+	assert.Equal(t, []string{StatusOff}, setStatuses)
+	assert.Empty(t, activeCounts[1:])
+
+	// The status is restored unless it was changed to off
+	assert.False(t, orchestrator.RestoreStatus(context.Background(), wasRunning, StatusOn).HasError())
+	assert.Equal(t, []string{StatusOff, StatusOn}, setStatuses)
+	assert.False(t, orchestrator.RestoreStatus(context.Background(), wasRunning, StatusOff).HasError())
+	assert.Equal(t, []string{StatusOff, StatusOn}, setStatuses)
+
+	// Objects that are not running are left alone
+	setStatuses = nil
+	wasRunning, diagErr = orchestrator.StopIfRunning(context.Background())
+	assert.False(t, diagErr.HasError())
+	assert.False(t, wasRunning)
+	assert.Empty(t, setStatuses)
+	assert.False(t, orchestrator.RestoreStatus(context.Background(), wasRunning, StatusOn).HasError())
+	assert.Empty(t, setStatuses)
+}
+
+func TestUnitStopIfRunningDrainTimeout(t *testing.T) {
+	origPollInterval := PollInterval
+	PollInterval = 0
+	defer func() { PollInterval = origPollInterval }()
+
+	orchestrator := &Orchestrator{
+		ResourceType: "genesyscloud_outbound_campaign",
+		Name:         "Outbound Campaign test",
+		GetStatus: func(_ context.Context) (string, *platformclientv2.APIResponse, error) {
+			return StatusStopping, nil, nil
+		},
+		SetStatus: func(_ context.Context, status string) (*platformclientv2.APIResponse, error) {
+			return nil, nil
+		},
+		DrainTimeout: time.Second,
+	}
+
+	_, diagErr := orchestrator.StopIfRunning(context.Background())
+	assert.True(t, diagErr.HasError())
+}
@@ -1,7 +1,10 @@
 package resourcedata
 
 import (
+	"encoding/json"
+	"fmt"
 	"log"
+	"math"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -259,3 +262,126 @@ func BuildSdkList[T interface{}](d *schema.ResourceData, key string, elementBuil
 	}
 	return nil
 }
+
+// DecodeJsonBlocks decodes a JSON encoded list of nested blocks, e.g. jsonencode() of the blocks of a resource, into the
+// []interface{} that ResourceData returns for the blocks, so that it can be passed to the build functions of the resource.
+// Missing and null attributes become the zero value of their schema type.
+func DecodeJsonBlocks(jsonBlocks string, elem *schema.Resource) ([]interface{}, error) {
+	var blocks interface{}
+	if err := json.Unmarshal([]byte(jsonBlocks), &blocks); err != nil {
+		return nil, err
+	}
+	value, err := decodeJsonValue(blocks, &schema.Schema{Type: schema.TypeList, Elem: elem}, "")
+	if err != nil {
+		return nil, err
+	}
+	return value.([]interface{}), nil
+}
+
+func decodeJsonValue(value interface{}, s *schema.Schema, path string) (interface{}, error) {
+	switch s.Type {
+	case schema.TypeString:
+		if value == nil {
+			return "", nil
+		}
+		if stringValue, ok := value.(string); ok {
+			return stringValue, nil
+		}
+	case schema.TypeBool:
+		if value == nil {
+			return false, nil
+		}
+		if boolValue, ok := value.(bool); ok {
+			return boolValue, nil
+		}
+	case schema.TypeInt:
+		if value == nil {
+			return 0, nil
+		}
+		if number, ok := value.(float64); ok && number == math.Trunc(number) {
+			return int(number), nil
+		}
+	case schema.TypeFloat:
+		if value == nil {
+			return 0.0, nil
+		}
+		if number, ok := value.(float64); ok {
+			return number, nil
+		}
+	case schema.TypeMap:
+		result := make(map[string]interface{})
+		if value == nil {
+			return result, nil
+		}
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			break
+		}
+		elemSchema, ok := s.Elem.(*schema.Schema)
+		if !ok {
+			elemSchema = &schema.Schema{Type: schema.TypeString}
+		}
+		for key, item := range object {
+			decoded, err := decodeJsonValue(item, elemSchema, path+"."+key)
+			if err != nil {
+				return nil, err
+			}
+			result[key] = decoded
+		}
+		return result, nil
+	case schema.TypeList, schema.TypeSet:
+		items := make([]interface{}, 0)
+		if value != nil {
+			list, ok := value.([]interface{})
+			if !ok {
+				break
+			}
+			for i, item := range list {
+				var (
+					decoded interface{}
+					err     error
+				)
+				if elemResource, ok := s.Elem.(*schema.Resource); ok {
+					decoded, err = decodeJsonObject(item, elemResource, fmt.Sprintf("%s.%d", path, i))
+				} else {
+					decoded, err = decodeJsonValue(item, s.Elem.(*schema.Schema), fmt.Sprintf("%s.%d", path, i))
+				}
+				if err != nil {
+					return nil, err
+				}
+				items = append(items, decoded)
+			}
+		}
+		if s.Type == schema.TypeList {
+			return items, nil
+		}
+		if elemResource, ok := s.Elem.(*schema.Resource); ok {
+			return schema.NewSet(schema.HashResource(elemResource), items), nil
+		}
+		return schema.NewSet(schema.HashSchema(s.Elem.(*schema.Schema)), items), nil
+	}
+	return nil, fmt.Errorf("%s: unexpected value %v", trimJsonPath(path), value)
+}
+
+func decodeJsonObject(value interface{}, resource *schema.Resource, path string) (map[string]interface{}, error) {
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s: expected an object, got %v", trimJsonPath(path), value)
+	}
+	result := make(map[string]interface{}, len(resource.Schema))
+	for key, attributeSchema := range resource.Schema {
+		decoded, err := decodeJsonValue(object[key], attributeSchema, path+"."+key)
+		if err != nil {
+			return nil, err
+		}
+		result[key] = decoded
+	}
+	return result, nil
+}
+
+func trimJsonPath(path string) string {
+	if len(path) > 0 && path[0] == '.' {
+		return path[1:]
+	}
+	return path
+}
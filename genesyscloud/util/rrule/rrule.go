@@ -0,0 +1,364 @@
+package rrule
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+/*
+The rrule package expands the subset of iCal recurrence rules (RFC 5545) that Genesys Cloud schedules accept:
+FREQ, INTERVAL, COUNT, UNTIL, BYDAY (with optional ordinals), BYMONTHDAY and BYMONTH.
+
+Expansion is performed on wall-clock times. Callers are expected to pass start times whose location is the
+time zone the schedule should be evaluated in so that occurrences keep their local time of day across DST changes.
+*/
+
+// maxPeriods guards against runaway expansion for rules that can never produce an occurrence
+const maxPeriods = 100000
+
+var weekdays = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+// WeekdayNum is a BYDAY entry. Ordinal is 0 when the weekday applies to every matching day in the period.
+type WeekdayNum struct {
+	Ordinal int
+	Weekday time.Weekday
+}
+
+// Rule is a parsed recurrence rule
+type Rule struct {
+	Freq       string
+	Interval   int
+	Count      int
+	Until      *time.Time
+	ByDay      []WeekdayNum
+	ByMonthDay []int
+	ByMonth    []int
+
+	// untilFloating is set when UNTIL has no UTC designator and must be read in the event's time zone
+	untilFloating bool
+}
+
+// Occurrence is a single expanded instance of a recurring event
+type Occurrence struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Parse parses an RRULE string. An empty string yields a nil rule with no error, meaning the event does not repeat.
+func Parse(input string) (*Rule, error) {
+	input = strings.TrimPrefix(strings.TrimSpace(input), "RRULE:")
+	if input == "" {
+		return nil, nil
+	}
+
+	rule := &Rule{Interval: 1}
+	for _, part := range strings.Split(input, ";") {
+		if part == "" {
+			continue
+		}
+		key, value, found := strings.Cut(part, "=")
+		if !found {
+			return nil, fmt.Errorf("invalid rrule part '%s'", part)
+		}
+		switch key {
+		case "FREQ":
+			switch value {
+			case "DAILY", "WEEKLY", "MONTHLY", "YEARLY":
+				rule.Freq = value
+			default:
+				return nil, fmt.Errorf("unsupported FREQ '%s'", value)
+			}
+		case "INTERVAL":
+			interval, err := strconv.Atoi(value)
+			if err != nil || interval < 1 {
+				return nil, fmt.Errorf("invalid INTERVAL '%s'", value)
+			}
+			rule.Interval = interval
+		case "COUNT":
+			count, err := strconv.Atoi(value)
+			if err != nil || count < 1 {
+				return nil, fmt.Errorf("invalid COUNT '%s'", value)
+			}
+			rule.Count = count
+		case "UNTIL":
+			until, err := parseUntil(value)
+			if err != nil {
+				return nil, err
+			}
+			if len(value) == len("20060102") {
+				// A date-only UNTIL includes the whole day
+				until = until.AddDate(0, 0, 1).Add(-time.Nanosecond)
+			}
+			rule.Until = &until
+			rule.untilFloating = !strings.HasSuffix(value, "Z")
+		case "BYDAY":
+			for _, day := range strings.Split(value, ",") {
+				weekdayNum, err := parseWeekdayNum(day)
+				if err != nil {
+					return nil, err
+				}
+				rule.ByDay = append(rule.ByDay, weekdayNum)
+			}
+		case "BYMONTHDAY":
+			days, err := parseIntList(value, -31, 31)
+			if err != nil {
+				return nil, fmt.Errorf("invalid BYMONTHDAY: %s", err)
+			}
+			rule.ByMonthDay = days
+		case "BYMONTH":
+			months, err := parseIntList(value, 1, 12)
+			if err != nil {
+				return nil, fmt.Errorf("invalid BYMONTH: %s", err)
+			}
+			rule.ByMonth = months
+		case "WKST":
+			// Weeks always start on Monday in Genesys Cloud schedules
+		default:
+			return nil, fmt.Errorf("unsupported rrule attribute '%s'", key)
+		}
+	}
+
+	if rule.Freq == "" {
+		return nil, fmt.Errorf("rrule '%s' is missing FREQ", input)
+	}
+	return rule, nil
+}
+
+// Expand returns the occurrences of an event starting at start and lasting duration that overlap the window [from, to).
+// A nil rule produces at most the single non-repeating occurrence.
+func Expand(rule *Rule, start time.Time, duration time.Duration, from, to time.Time) []Occurrence {
+	var occurrences []Occurrence
+	overlaps := func(occStart time.Time) bool {
+		return occStart.Before(to) && occStart.Add(duration).After(from)
+	}
+
+	if rule == nil {
+		if overlaps(start) {
+			occurrences = append(occurrences, Occurrence{Start: start, End: start.Add(duration)})
+		}
+		return occurrences
+	}
+
+	var until *time.Time
+	if rule.Until != nil {
+		u := *rule.Until
+		if rule.untilFloating {
+			u = time.Date(u.Year(), u.Month(), u.Day(), u.Hour(), u.Minute(), u.Second(), u.Nanosecond(), start.Location())
+		}
+		until = &u
+	}
+
+	emitted := 0
+	for period := 0; period < maxPeriods; period++ {
+		candidates := rule.candidates(start, period*rule.Interval)
+		if len(candidates) == 0 {
+			continue
+		}
+		for _, candidate := range candidates {
+			if candidate.Before(start) {
+				continue
+			}
+			if until != nil && candidate.After(*until) {
+				return occurrences
+			}
+			if !candidate.Before(to) {
+				return occurrences
+			}
+			emitted++
+			if overlaps(candidate) {
+				occurrences = append(occurrences, Occurrence{Start: candidate, End: candidate.Add(duration)})
+			}
+			if rule.Count > 0 && emitted >= rule.Count {
+				return occurrences
+			}
+		}
+	}
+	return occurrences
+}
+
+// candidates returns the sorted set of start times produced by the rule in the period offset frequency units from start
+func (r *Rule) candidates(start time.Time, offset int) []time.Time {
+	loc := start.Location()
+	hour, minute, second := start.Clock()
+	at := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, hour, minute, second, start.Nanosecond(), loc)
+	}
+
+	var days []time.Time
+	switch r.Freq {
+	case "DAILY":
+		day := at(start.Year(), start.Month(), start.Day()+offset)
+		if r.matchesMonth(day.Month()) && r.matchesMonthDay(day) && r.matchesWeekday(day) {
+			days = append(days, day)
+		}
+	case "WEEKLY":
+		daysSinceMonday := (int(start.Weekday()) + 6) % 7
+		monday := at(start.Year(), start.Month(), start.Day()-daysSinceMonday+offset*7)
+		for i := 0; i < 7; i++ {
+			day := at(monday.Year(), monday.Month(), monday.Day()+i)
+			if len(r.ByDay) == 0 && day.Weekday() != start.Weekday() {
+				continue
+			}
+			if r.matchesMonth(day.Month()) && r.matchesWeekday(day) {
+				days = append(days, day)
+			}
+		}
+	case "MONTHLY":
+		first := time.Date(start.Year(), start.Month()+time.Month(offset), 1, 0, 0, 0, 0, loc)
+		if r.matchesMonth(first.Month()) {
+			days = r.daysInMonth(first.Year(), first.Month(), start.Day(), at)
+		}
+	case "YEARLY":
+		year := start.Year() + offset
+		months := r.ByMonth
+		if len(months) == 0 {
+			if len(r.ByDay) > 0 && len(r.ByMonthDay) == 0 {
+				months = []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12}
+			} else {
+				months = []int{int(start.Month())}
+			}
+		}
+		for _, month := range months {
+			days = append(days, r.daysInMonth(year, time.Month(month), start.Day(), at)...)
+		}
+	}
+
+	sort.Slice(days, func(i, j int) bool { return days[i].Before(days[j]) })
+	return days
+}
+
+// daysInMonth returns the days of a month selected by BYMONTHDAY and BYDAY, falling back to defaultDay
+func (r *Rule) daysInMonth(year int, month time.Month, defaultDay int, at func(int, time.Month, int) time.Time) []time.Time {
+	lastDay := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+
+	var days []time.Time
+	if len(r.ByMonthDay) == 0 && len(r.ByDay) == 0 {
+		if defaultDay <= lastDay {
+			days = append(days, at(year, month, defaultDay))
+		}
+		return days
+	}
+
+	for dayOfMonth := 1; dayOfMonth <= lastDay; dayOfMonth++ {
+		day := at(year, month, dayOfMonth)
+		if len(r.ByMonthDay) > 0 && !r.matchesMonthDay(day) {
+			continue
+		}
+		if len(r.ByDay) > 0 && !r.matchesWeekdayInMonth(day, lastDay) {
+			continue
+		}
+		days = append(days, day)
+	}
+	return days
+}
+
+func (r *Rule) matchesMonth(month time.Month) bool {
+	if len(r.ByMonth) == 0 {
+		return true
+	}
+	for _, m := range r.ByMonth {
+		if time.Month(m) == month {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Rule) matchesMonthDay(day time.Time) bool {
+	if len(r.ByMonthDay) == 0 {
+		return true
+	}
+	lastDay := time.Date(day.Year(), day.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	for _, d := range r.ByMonthDay {
+		if d == day.Day() || (d < 0 && lastDay+d+1 == day.Day()) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Rule) matchesWeekday(day time.Time) bool {
+	if len(r.ByDay) == 0 {
+		return true
+	}
+	for _, wd := range r.ByDay {
+		if wd.Weekday == day.Weekday() {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesWeekdayInMonth checks BYDAY entries including ordinals such as 2MO or -1FR
+func (r *Rule) matchesWeekdayInMonth(day time.Time, lastDay int) bool {
+	for _, wd := range r.ByDay {
+		if wd.Weekday != day.Weekday() {
+			continue
+		}
+		if wd.Ordinal == 0 {
+			return true
+		}
+		if wd.Ordinal > 0 && (day.Day()-1)/7+1 == wd.Ordinal {
+			return true
+		}
+		if wd.Ordinal < 0 && (lastDay-day.Day())/7+1 == -wd.Ordinal {
+			return true
+		}
+	}
+	return false
+}
+
+func parseWeekdayNum(value string) (WeekdayNum, error) {
+	if len(value) < 2 {
+		return WeekdayNum{}, fmt.Errorf("invalid BYDAY value '%s'", value)
+	}
+	weekday, ok := weekdays[value[len(value)-2:]]
+	if !ok {
+		return WeekdayNum{}, fmt.Errorf("invalid BYDAY value '%s'", value)
+	}
+	weekdayNum := WeekdayNum{Weekday: weekday}
+	if prefix := value[:len(value)-2]; prefix != "" {
+		ordinal, err := strconv.Atoi(prefix)
+		if err != nil || ordinal == 0 || ordinal > 5 || ordinal < -5 {
+			return WeekdayNum{}, fmt.Errorf("invalid BYDAY ordinal in '%s'", value)
+		}
+		weekdayNum.Ordinal = ordinal
+	}
+	return weekdayNum, nil
+}
+
+func parseIntList(value string, min, max int) ([]int, error) {
+	var values []int
+	for _, s := range strings.Split(value, ",") {
+		i, err := strconv.Atoi(s)
+		if err != nil {
+			return nil, err
+		}
+		if i < min || i > max || i == 0 {
+			return nil, fmt.Errorf("value %d is out of range", i)
+		}
+		values = append(values, i)
+	}
+	return values, nil
+}
+
+// parseUntil parses an UNTIL value. UTC values ending in Z are returned as-is, floating values are returned in UTC.
+func parseUntil(value string) (time.Time, error) {
+	for _, layout := range []string{"20060102T150405Z", "20060102T150405", "20060102"} {
+		if until, err := time.Parse(layout, value); err == nil {
+			return until, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid UNTIL '%s'", value)
+}
@@ -0,0 +1,154 @@
+package rrule
+
+import (
+	"testing"
+	"time"
+)
+
+func TestUnitParseRrule(t *testing.T) {
+	testCases := []struct {
+		input       string
+		expectError bool
+	}{
+		{input: "", expectError: false},
+		{input: "FREQ=WEEKLY;INTERVAL=1;BYDAY=MO,TU,WE,TH,FR", expectError: false},
+		{input: "FREQ=MONTHLY;BYDAY=-1FR", expectError: false},
+		{input: "FREQ=YEARLY;INTERVAL=1;BYMONTH=12;BYMONTHDAY=25", expectError: false},
+		{input: "FREQ=DAILY;UNTIL=20250101T000000Z", expectError: false},
+		{input: "INTERVAL=1", expectError: true},
+		{input: "FREQ=HOURLY", expectError: true},
+		{input: "FREQ=DAILY;INTERVAL=0", expectError: true},
+		{input: "FREQ=WEEKLY;BYDAY=XX", expectError: true},
+		{input: "FREQ=YEARLY;BYMONTH=13", expectError: true},
+		{input: "FREQ=DAILY;BYSETPOS=1", expectError: true},
+	}
+
+	for _, tc := range testCases {
+		_, err := Parse(tc.input)
+		if err != nil && !tc.expectError {
+			t.Errorf("Parse(%q) returned unexpected error: %s", tc.input, err)
+		}
+		if err == nil && tc.expectError {
+			t.Errorf("Parse(%q) expected an error, got nil", tc.input)
+		}
+	}
+}
+
+func TestUnitExpandRrule(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("failed to load location: %s", err)
+	}
+
+	testCases := []struct {
+		name          string
+		rrule         string
+		start         time.Time
+		duration      time.Duration
+		from          time.Time
+		to            time.Time
+		expectedStart []time.Time
+	}{
+		{
+			name:     "non repeating",
+			rrule:    "",
+			start:    time.Date(2024, 12, 25, 0, 0, 0, 0, loc),
+			duration: 24 * time.Hour,
+			from:     time.Date(2024, 12, 1, 0, 0, 0, 0, loc),
+			to:       time.Date(2025, 1, 1, 0, 0, 0, 0, loc),
+			expectedStart: []time.Time{
+				time.Date(2024, 12, 25, 0, 0, 0, 0, loc),
+			},
+		},
+		{
+			name:     "weekdays only",
+			rrule:    "FREQ=WEEKLY;INTERVAL=1;BYDAY=MO,TU,WE,TH,FR",
+			start:    time.Date(2024, 1, 1, 9, 0, 0, 0, loc),
+			duration: 8 * time.Hour,
+			from:     time.Date(2024, 3, 8, 0, 0, 0, 0, loc),
+			to:       time.Date(2024, 3, 12, 0, 0, 0, 0, loc),
+			expectedStart: []time.Time{
+				time.Date(2024, 3, 8, 9, 0, 0, 0, loc),
+				// Daylight saving starts on 2024-03-10, local opening time is kept
+				time.Date(2024, 3, 11, 9, 0, 0, 0, loc),
+			},
+		},
+		{
+			name:     "yearly christmas",
+			rrule:    "FREQ=YEARLY;INTERVAL=1;BYMONTH=12;BYMONTHDAY=25",
+			start:    time.Date(2020, 12, 25, 0, 0, 0, 0, loc),
+			duration: 24 * time.Hour,
+			from:     time.Date(2023, 1, 1, 0, 0, 0, 0, loc),
+			to:       time.Date(2025, 1, 1, 0, 0, 0, 0, loc),
+			expectedStart: []time.Time{
+				time.Date(2023, 12, 25, 0, 0, 0, 0, loc),
+				time.Date(2024, 12, 25, 0, 0, 0, 0, loc),
+			},
+		},
+		{
+			name:     "last friday of the month",
+			rrule:    "FREQ=MONTHLY;BYDAY=-1FR",
+			start:    time.Date(2024, 1, 1, 12, 0, 0, 0, loc),
+			duration: time.Hour,
+			from:     time.Date(2024, 1, 1, 0, 0, 0, 0, loc),
+			to:       time.Date(2024, 3, 1, 0, 0, 0, 0, loc),
+			expectedStart: []time.Time{
+				time.Date(2024, 1, 26, 12, 0, 0, 0, loc),
+				time.Date(2024, 2, 23, 12, 0, 0, 0, loc),
+			},
+		},
+		{
+			name:     "count limits occurrences",
+			rrule:    "FREQ=DAILY;COUNT=2",
+			start:    time.Date(2024, 5, 1, 8, 0, 0, 0, loc),
+			duration: time.Hour,
+			from:     time.Date(2024, 5, 1, 0, 0, 0, 0, loc),
+			to:       time.Date(2024, 6, 1, 0, 0, 0, 0, loc),
+			expectedStart: []time.Time{
+				time.Date(2024, 5, 1, 8, 0, 0, 0, loc),
+				time.Date(2024, 5, 2, 8, 0, 0, 0, loc),
+			},
+		},
+		{
+			name:     "until is inclusive of a floating date",
+			rrule:    "FREQ=DAILY;INTERVAL=2;UNTIL=20240505",
+			start:    time.Date(2024, 5, 1, 8, 0, 0, 0, loc),
+			duration: time.Hour,
+			from:     time.Date(2024, 5, 1, 0, 0, 0, 0, loc),
+			to:       time.Date(2024, 6, 1, 0, 0, 0, 0, loc),
+			expectedStart: []time.Time{
+				time.Date(2024, 5, 1, 8, 0, 0, 0, loc),
+				time.Date(2024, 5, 3, 8, 0, 0, 0, loc),
+				time.Date(2024, 5, 5, 8, 0, 0, 0, loc),
+			},
+		},
+		{
+			name:     "occurrence overlapping the window start is included",
+			rrule:    "FREQ=DAILY",
+			start:    time.Date(2024, 5, 1, 22, 0, 0, 0, loc),
+			duration: 4 * time.Hour,
+			from:     time.Date(2024, 5, 3, 0, 0, 0, 0, loc),
+			to:       time.Date(2024, 5, 3, 12, 0, 0, 0, loc),
+			expectedStart: []time.Time{
+				time.Date(2024, 5, 2, 22, 0, 0, 0, loc),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		rule, err := Parse(tc.rrule)
+		if err != nil {
+			t.Fatalf("%s: failed to parse rrule %q: %s", tc.name, tc.rrule, err)
+		}
+		occurrences := Expand(rule, tc.start, tc.duration, tc.from, tc.to)
+		if len(occurrences) != len(tc.expectedStart) {
+			t.Errorf("%s: expected %d occurrences, got %d: %v", tc.name, len(tc.expectedStart), len(occurrences), occurrences)
+			continue
+		}
+		for i, occurrence := range occurrences {
+			if !occurrence.Start.Equal(tc.expectedStart[i]) {
+				t.Errorf("%s: expected occurrence %d to start at %s, got %s", tc.name, i, tc.expectedStart[i], occurrence.Start)
+			}
+		}
+	}
+}
@@ -2,9 +2,11 @@ package main
 
 import (
 	"flag"
+	architectDependencies "terraform-provider-genesyscloud/genesyscloud/architect_dependencies"
 	"terraform-provider-genesyscloud/genesyscloud/provider"
 	providerRegistrar "terraform-provider-genesyscloud/genesyscloud/provider_registrar"
 
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
 )
 
@@ -37,7 +39,12 @@ func main() {
 
 	providerResources, providerDataSources := providerRegistrar.GetProviderResources()
 
-	opts := &plugin.ServeOpts{ProviderFunc: provider.New(version, providerResources, providerDataSources)}
+	providerFunc := provider.New(version, providerResources, providerDataSources)
+
+	// The provider server warns when a plan destroys or replaces an object that flows consume
+	opts := &plugin.ServeOpts{GRPCProviderFunc: func() tfprotov5.ProviderServer {
+		return architectDependencies.NewFlowConsumersWarningServer(providerFunc())
+	}}
 
 	if debugMode {
 		opts.Debug = true